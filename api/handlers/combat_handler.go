@@ -1,7 +1,10 @@
 package handlers
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
 
 	"github.com/aether-engine/aether-engine/internal/combat/application"
 	"github.com/gin-gonic/gin"
@@ -60,6 +63,28 @@ func (h *CombatHandler) ExecuterAction(c *gin.Context) {
 	c.JSON(http.StatusOK, resultat)
 }
 
+// PrevisualiserAction simule une action sans la jouer (dégâts, chances, cases, coûts)
+// POST /api/v1/combats/:id/actions/preview
+func (h *CombatHandler) PrevisualiserAction(c *gin.Context) {
+	combatID := c.Param("id")
+
+	var cmd application.CommandeExecuterAction
+	if err := c.ShouldBindJSON(&cmd); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd.CombatID = combatID
+
+	prevision, err := h.engine.PrevisualiserAction(cmd)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, prevision)
+}
+
 // PasserTour passe au tour suivant
 // POST /api/v1/combats/:id/tour-suivant
 func (h *CombatHandler) PasserTour(c *gin.Context) {
@@ -94,6 +119,109 @@ func (h *CombatHandler) TerminerCombat(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Combat terminé"})
 }
 
+// requeteArbitrage est le corps optionnel des commandes d'arbitrage du MJ
+type requeteArbitrage struct {
+	Motif string `json:"motif"`
+}
+
+// lireMotif lit le motif d'arbitrage (corps de requête facultatif)
+func lireMotif(c *gin.Context) (string, error) {
+	var requete requeteArbitrage
+	if err := c.ShouldBindJSON(&requete); err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return requete.Motif, nil
+}
+
+// MettreEnPause suspend un combat (litige)
+// POST /api/v1/combats/:id/pause
+func (h *CombatHandler) MettreEnPause(c *gin.Context) {
+	motif, err := lireMotif(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := application.CommandeMettreEnPause{
+		CombatID: c.Param("id"),
+		Motif:    motif,
+	}
+
+	if err := h.engine.MettreEnPause(cmd); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Combat en pause"})
+}
+
+// ReprendreCombat relance un combat en pause
+// POST /api/v1/combats/:id/reprendre
+func (h *CombatHandler) ReprendreCombat(c *gin.Context) {
+	cmd := application.CommandeReprendreCombat{
+		CombatID: c.Param("id"),
+	}
+
+	if err := h.engine.ReprendreCombat(cmd); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Combat repris"})
+}
+
+// AnnulerCombat annule un combat sans vainqueur ni récompenses (incident serveur)
+// POST /api/v1/combats/:id/annuler
+func (h *CombatHandler) AnnulerCombat(c *gin.Context) {
+	motif, err := lireMotif(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	cmd := application.CommandeAnnulerCombat{
+		CombatID: c.Param("id"),
+		Motif:    motif,
+	}
+
+	if err := h.engine.AnnulerCombat(cmd); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Combat annulé"})
+}
+
+// AnnulerAction annule la dernière action d'un combat d'entraînement
+// POST /api/v1/combats/:id/actions/annuler
+func (h *CombatHandler) AnnulerAction(c *gin.Context) {
+	cmd := application.CommandeAnnulerAction{
+		CombatID: c.Param("id"),
+	}
+
+	if err := h.engine.AnnulerAction(cmd); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Action annulée"})
+}
+
+// RetablirAction refait la dernière action annulée d'un combat d'entraînement
+// POST /api/v1/combats/:id/actions/refaire
+func (h *CombatHandler) RetablirAction(c *gin.Context) {
+	cmd := application.CommandeRetablirAction{
+		CombatID: c.Param("id"),
+	}
+
+	if err := h.engine.RetablirAction(cmd); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Action refaite"})
+}
+
 // ObtenirCombat récupère l'état d'un combat
 // GET /api/v1/combats/:id
 func (h *CombatHandler) ObtenirCombat(c *gin.Context) {
@@ -112,14 +240,46 @@ func (h *CombatHandler) ObtenirCombat(c *gin.Context) {
 	c.JSON(http.StatusOK, combat)
 }
 
+// ObtenirOrdreTours prévoit l'ordre des prochains tours (timeline)
+// GET /api/v1/combats/:id/turn-order?n=10
+func (h *CombatHandler) ObtenirOrdreTours(c *gin.Context) {
+	query := application.QueryObtenirOrdreTours{
+		CombatID: c.Param("id"),
+	}
+
+	if n := c.Query("n"); n != "" {
+		nombre, err := strconv.Atoi(n)
+		if err != nil || nombre <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "paramètre n invalide"})
+			return
+		}
+		query.Nombre = nombre
+	}
+
+	ordre, err := h.engine.ObtenirOrdreTours(query)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, ordre)
+}
+
 // RegisterRoutes enregistre toutes les routes du CombatHandler
 func (h *CombatHandler) RegisterRoutes(router *gin.RouterGroup) {
 	combats := router.Group("/combats")
 	{
 		combats.POST("", h.DemarrerCombat)
 		combats.GET("/:id", h.ObtenirCombat)
+		combats.GET("/:id/turn-order", h.ObtenirOrdreTours)
 		combats.POST("/:id/actions", h.ExecuterAction)
+		combats.POST("/:id/actions/preview", h.PrevisualiserAction)
+		combats.POST("/:id/actions/annuler", h.AnnulerAction)
+		combats.POST("/:id/actions/refaire", h.RetablirAction)
 		combats.POST("/:id/tour-suivant", h.PasserTour)
 		combats.POST("/:id/terminer", h.TerminerCombat)
+		combats.POST("/:id/pause", h.MettreEnPause)
+		combats.POST("/:id/reprendre", h.ReprendreCombat)
+		combats.POST("/:id/annuler", h.AnnulerCombat)
 	}
 }
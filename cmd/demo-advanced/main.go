@@ -122,6 +122,8 @@ func (g *GameDemo) initialiserCombat() error {
 		1.2,
 		domain.CibleEnnemis,
 	)
+	provocation := shared.TypeStatutProvocation
+	taunt.AjouterEffet(domain.NewEffetCompetence(domain.EffetStatut, 0, 2, &provocation))
 	paladin.AjouterCompetence(taunt)
 
 	// Compétence 2: Soin Divin
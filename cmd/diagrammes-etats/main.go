@@ -0,0 +1,38 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain/states"
+)
+
+// Génère les diagrammes de la machine d'états du combat depuis la table des transitions
+// Usage: go run ./cmd/diagrammes-etats [-out doc/diagrammes_etats]
+func main() {
+	out := flag.String("out", filepath.Join("doc", "diagrammes_etats"), "répertoire de sortie")
+	flag.Parse()
+
+	// Refuser d'exporter une table incohérente
+	if rapport := states.VerifierMachineEtats(); !rapport.Valide() {
+		log.Fatalf("Table des transitions invalide: %s", rapport)
+	}
+
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatalf("Erreur création du répertoire %s: %v", *out, err)
+	}
+
+	fichiers := map[string]string{
+		"machine_combat.md":  states.DocumentMachineEtats(),
+		"machine_combat.dot": states.ExporterDOT(),
+	}
+	for nom, contenu := range fichiers {
+		chemin := filepath.Join(*out, nom)
+		if err := os.WriteFile(chemin, []byte(contenu), 0o644); err != nil {
+			log.Fatalf("Erreur écriture de %s: %v", chemin, err)
+		}
+		log.Printf("Diagramme écrit: %s", chemin)
+	}
+}
@@ -47,6 +47,11 @@ func main() {
 	// Créer le Combat Engine
 	combatEngine := application.NewCombatEngine(eventStore, publisher)
 
+	// Reprogrammer les délais de tour en cours (persistés dans le flux d'événements)
+	if err := combatEngine.RestaurerEcheances(); err != nil {
+		log.Printf("Erreur restauration des délais de tour: %v", err)
+	}
+
 	// Créer le router Gin
 	router := gin.Default()
 
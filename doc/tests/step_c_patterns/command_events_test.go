@@ -0,0 +1,152 @@
+package step_c_patterns_test
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/combatfacade"
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+)
+
+// evenementsDepuis retourne les événements levés après les n premiers
+func evenementsDepuis(combat *domain.Combat, n int) []domain.Evenement {
+	return combat.GetUncommittedEvents()[n:]
+}
+
+// typesCorreles retourne les types des événements portant un identifiant de corrélation, dans l'ordre
+func typesCorreles(evenements []domain.Evenement, correlationID string) []string {
+	types := make([]string, 0)
+	for _, evt := range evenements {
+		if evt.CorrelationID() == correlationID {
+			types = append(types, evt.EventType())
+		}
+	}
+	return types
+}
+
+// contientTypes vérifie que chaque type attendu est présent
+func contientTypes(t *testing.T, types []string, attendus ...string) {
+	t.Helper()
+	presents := make(map[string]bool, len(types))
+	for _, eventType := range types {
+		presents[eventType] = true
+	}
+	for _, attendu := range attendus {
+		if !presents[attendu] {
+			t.Errorf("Événement %s attendu dans l'action: %v", attendu, types)
+		}
+	}
+}
+
+func TestExecutePlayerAction_EvenementsAttaqueCorreles(t *testing.T) {
+	// Arrange - l'attaque met E1 KO et termine le combat
+	combat, heros, ennemi := demarrerCombatPrevision(t, 1)
+	ennemi.SetHP(5)
+	avant := len(combat.GetUncommittedEvents())
+
+	// Act
+	_, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewAttackAction(heros.ID(), ennemi.ID()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	evenements := evenementsDepuis(combat, avant)
+	if len(evenements) == 0 || evenements[0].CorrelationID() == "" {
+		t.Fatalf("L'attaque devrait lever des événements corrélés: %v", evenements)
+	}
+	correlationID := evenements[0].CorrelationID()
+	types := typesCorreles(evenements, correlationID)
+	if len(types) != len(evenements) {
+		t.Errorf("Tous les événements de l'attaque devraient partager %s: %v", correlationID, types)
+	}
+	contientTypes(t, types, "DegatsInfliges", "UniteKO", "ActionExecutee", "CombatTermine")
+
+	for _, evt := range combat.GetUncommittedEvents()[:avant] {
+		if evt.CorrelationID() == correlationID {
+			t.Errorf("L'événement %s antérieur ne devrait pas appartenir à l'attaque", evt.EventType())
+		}
+	}
+	for _, evt := range evenements {
+		if termine, ok := evt.(*domain.CombatTermineEvent); ok {
+			if termine.Vainqueur == nil || *termine.Vainqueur != heros.TeamID() {
+				t.Errorf("L'équipe de U1 devrait être victorieuse: %v", termine.Vainqueur)
+			}
+		}
+	}
+	if combat.Etat() != domain.EtatTermine {
+		t.Errorf("Le combat devrait être terminé, état: %s", combat.Etat())
+	}
+}
+
+func TestExecutePlayerAction_EvenementsDeplacementEtTourSuivant(t *testing.T) {
+	// Arrange
+	combat, heros, ennemi := demarrerCombatPrevision(t, 5)
+	avant := len(combat.GetUncommittedEvents())
+
+	// Act
+	_, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewMoveAction(heros.ID(), 2, 0))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	evenements := evenementsDepuis(combat, avant)
+	deplacementID, tourID := "", ""
+	for _, evt := range evenements {
+		switch e := evt.(type) {
+		case *domain.DeplacementExecuteEvent:
+			deplacementID = e.CorrelationID()
+			if e.UniteID != heros.ID() || e.PositionArrivee.X() != 2 || len(e.Chemin) == 0 || e.CoutTotal != 2 {
+				t.Errorf("Déplacement inattendu: %+v", e)
+			}
+		case *domain.TourDemarreEvent:
+			tourID = e.CorrelationID()
+			if e.UniteID != ennemi.ID() {
+				t.Errorf("Le tour de E1 devrait démarrer, obtenu: %s", e.UniteID)
+			}
+		}
+	}
+	if deplacementID == "" || tourID == "" {
+		t.Fatalf("DeplacementExecute et TourDemarre attendus: %v", evenements)
+	}
+	if deplacementID == tourID {
+		t.Errorf("Le tour de E1 devrait avoir sa propre corrélation")
+	}
+	contientTypes(t, typesCorreles(evenements, deplacementID), "DeplacementExecute", "ActionExecutee", "ActiviteTourConclue")
+	contientTypes(t, typesCorreles(evenements, tourID), "TourDemarre", "MachineEtatsPositionnee")
+}
+
+func TestExecutePlayerAction_EvenementsCompetence(t *testing.T) {
+	// Arrange
+	combat, heros, ennemi := demarrerCombatPrevision(t, 2)
+	_ = heros.AjouterCompetence(createTestSkill("fireball", 10, domain.CompetenceMagie))
+	avant := len(combat.GetUncommittedEvents())
+
+	// Act
+	_, err := combatfacade.ExecutePlayerActionTyped(combat,
+		combatfacade.NewSkillAction(heros.ID(), "fireball", []domain.UnitID{ennemi.ID()}))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	evenements := evenementsDepuis(combat, avant)
+	var utilisee *domain.CompetenceUtiliseeEvent
+	for _, evt := range evenements {
+		if e, ok := evt.(*domain.CompetenceUtiliseeEvent); ok {
+			utilisee = e
+		}
+	}
+	if utilisee == nil || utilisee.CompetenceID != "fireball" || len(utilisee.Cibles) != 1 || utilisee.Cibles[0] != ennemi.ID() {
+		t.Fatalf("L'événement CompetenceUtilisee devrait viser E1: %+v", utilisee)
+	}
+	types := typesCorreles(evenements, utilisee.CorrelationID())
+	contientTypes(t, types, "CompetenceUtilisee", "DegatsInfliges", "ActionExecutee")
+	for _, evt := range evenements {
+		if action, ok := evt.(*domain.ActionExecuteeEvent); ok && action.CorrelationID() == utilisee.CorrelationID() {
+			if action.Action.Type != domain.TypeActionCompetence || action.Action.CompetenceID == nil || !action.Resultat.Succes {
+				t.Errorf("ActionExecutee devrait résumer la compétence: %+v", action.Action)
+			}
+		}
+	}
+}
@@ -1,10 +1,18 @@
 package step_c_patterns_test
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/combatfacade"
+	"github.com/aether-engine/aether-engine/internal/combat/combatinitializer"
 	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/states"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/validators"
 	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
 
@@ -340,13 +348,13 @@ func TestItemCommand_Potion(t *testing.T) {
 	user.SetHP(50) // Blessé
 	addUnitToCombat(combat, user)
 
-	// Ajouter une potion à l'inventaire
-	combat.AjouterObjet("potion", 1)
+	// Ajouter une potion à l'inventaire de l'équipe
+	combat.AjouterObjet(user.TeamID(), domain.ObjetPotion, 1)
 
 	factory := commands.NewCommandFactory(combat)
 
 	// Act
-	cmd, err := factory.CreateItemCommand(user, "potion", user.ID())
+	cmd, err := factory.CreateItemCommand(user, domain.ObjetPotion, user.ID())
 	if err != nil {
 		t.Fatalf("Erreur lors de la création de ItemCommand: %v", err)
 	}
@@ -374,7 +382,7 @@ func TestItemCommand_Potion(t *testing.T) {
 		}
 
 		// Vérifier que l'objet a été consommé
-		if combat.ObtenirQuantiteObjet("potion") != 0 {
+		if combat.ObtenirQuantiteObjet(user.TeamID(), domain.ObjetPotion) != 0 {
 			t.Errorf("La potion devrait être consommée")
 		}
 	}
@@ -404,125 +412,1714 @@ func TestItemCommand_ItemNotInInventory(t *testing.T) {
 	}
 }
 
-// Test de FleeCommand avec probabilité de réussite
-func TestFleeCommand_Success(t *testing.T) {
+// Test de ItemCommand - Rollback rend l'objet à l'inventaire
+func TestItemCommand_RollbackRestoresInventory(t *testing.T) {
 	// Arrange
 	combat := createTestCombat()
-	unit := createTestUnit("U1", 100) // Speed élevé = plus de chances
-	addUnitToCombat(combat, unit)
+	user := createTestUnit("U1", 50)
+	user.SetHP(50)
+	addUnitToCombat(combat, user)
+	combat.AjouterObjet(user.TeamID(), domain.ObjetPotion, 1)
 
 	factory := commands.NewCommandFactory(combat)
-
-	// Act
-	cmd, err := factory.CreateFleeCommand(unit)
+	cmd, err := factory.CreateItemCommand(user, domain.ObjetPotion, user.ID())
 	if err != nil {
-		t.Fatalf("Erreur lors de la création de FleeCommand: %v", err)
+		t.Fatalf("Erreur lors de la création de ItemCommand: %v", err)
 	}
 
-	// Execute plusieurs fois pour tester la probabilité
-	successCount := 0
-	for i := 0; i < 100; i++ {
-		result, err := cmd.Execute()
-		if err == nil && result != nil && result.Success {
-			successCount++
-		}
+	avant := len(combat.GetUncommittedEvents())
 
-		// Recréer la commande pour chaque tentative
-		cmd, _ = factory.CreateFleeCommand(unit)
+	// Act
+	if _, err := cmd.Execute(); err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+	if err := cmd.Rollback(); err != nil {
+		t.Fatalf("Erreur lors du rollback: %v", err)
 	}
 
-	// Assert - Avec speed 100, devrait réussir au moins 50% du temps
-	if successCount < 30 {
-		t.Errorf("FleeCommand devrait réussir plus souvent avec speed élevé, succès: %d/100", successCount)
+	// Assert
+	if combat.ObtenirQuantiteObjet(user.TeamID(), domain.ObjetPotion) != 1 {
+		t.Errorf("La potion devrait être rendue à l'inventaire après rollback")
+	}
+	if apres := len(combat.GetUncommittedEvents()); apres != avant {
+		t.Errorf("Les événements de l'objet annulé ne devraient pas être enregistrés: %d avant, %d après", avant, apres)
 	}
 }
 
-// Test de WaitCommand
-func TestWaitCommand_Execute(t *testing.T) {
+// Test de ItemCommand - Éther restaure les MP
+func TestItemCommand_Ether(t *testing.T) {
 	// Arrange
 	combat := createTestCombat()
-	unit := createTestUnit("U1", 50)
-	addUnitToCombat(combat, unit)
+	user := createTestUnit("U1", 50)
+	user.SetMP(10)
+	addUnitToCombat(combat, user)
+	combat.AjouterObjet(user.TeamID(), domain.ObjetEther, 1)
 
 	factory := commands.NewCommandFactory(combat)
+	cmd, err := factory.CreateItemCommand(user, domain.ObjetEther, user.ID())
+	if err != nil {
+		t.Fatalf("Erreur lors de la création de ItemCommand: %v", err)
+	}
+	if err := cmd.Validate(); err != nil {
+		t.Fatalf("ItemCommand devrait être valide: %v", err)
+	}
 
 	// Act
-	cmd, err := factory.CreateWaitCommand(unit)
+	result, err := cmd.Execute()
 	if err != nil {
-		t.Fatalf("Erreur lors de la création de WaitCommand: %v", err)
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
 	}
 
-	// Execute
+	// Assert
+	if user.StatsActuelles().MP != 10+domain.EtherMP {
+		t.Errorf("MP attendus %d, obtenus %d", 10+domain.EtherMP, user.StatsActuelles().MP)
+	}
+	if len(result.Effects) != 1 || result.Effects[0].Type != commands.EffectTypeManaRestore {
+		t.Errorf("Un effet MANA_RESTORE attendu, obtenu: %+v", result.Effects)
+	}
+}
+
+// Test de ItemCommand - Queue de Phénix ranime un allié KO
+func TestItemCommand_Revive(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	user := createTestUnit("U1", 50)
+	ally := createTestUnit("U2", 50)
+	allyPos, _ := shared.NewPosition(1, 0)
+	ally.DeplacerVers(allyPos)
+	ally.RecevoirDegats(1000)
+	addUnitToCombat(combat, user)
+	addUnitToCombat(combat, ally)
+	combat.AjouterObjet(user.TeamID(), domain.ObjetQueuePhenix, 1)
+
+	factory := commands.NewCommandFactory(combat)
+	cmd, err := factory.CreateItemCommand(user, domain.ObjetQueuePhenix, ally.ID())
+	if err != nil {
+		t.Fatalf("Erreur lors de la création de ItemCommand: %v", err)
+	}
+	if err := cmd.Validate(); err != nil {
+		t.Fatalf("La Queue de Phénix devrait être utilisable sur un allié KO: %v", err)
+	}
+
+	// Act
 	result, err := cmd.Execute()
 	if err != nil {
-		t.Errorf("WaitCommand ne devrait jamais échouer: %v", err)
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
 	}
 
 	// Assert
-	if result == nil || !result.Success {
-		t.Errorf("WaitCommand devrait toujours réussir")
+	if ally.EstEliminee() {
+		t.Errorf("L'allié devrait être ranimé")
 	}
-	if result.Message == "" {
-		t.Errorf("WaitCommand devrait retourner un message")
+	if len(result.Effects) != 1 || result.Effects[0].Type != commands.EffectTypeRevive {
+		t.Errorf("Un effet REVIVE attendu, obtenu: %+v", result.Effects)
 	}
 }
 
-// Test de CommandInvoker - History
-func TestCommandInvoker_History(t *testing.T) {
+// Test de ItemCommand - Bombe à zone avec tir ami
+func TestItemCommand_BombArea(t *testing.T) {
 	// Arrange
 	combat := createTestCombat()
-	unit := createTestUnit("U1", 50)
-	addUnitToCombat(combat, unit)
+	user := createTestUnit("U1", 50)
+	ally := createTestUnit("U2", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	farEnemy := createTestUnitWithTeam("E2", 50, "team2")
+
+	allyPos, _ := shared.NewPosition(2, 1)
+	enemyPos, _ := shared.NewPosition(2, 2)
+	farEnemyPos, _ := shared.NewPosition(8, 8)
+	ally.DeplacerVers(allyPos)
+	enemy.DeplacerVers(enemyPos)
+	farEnemy.DeplacerVers(farEnemyPos)
+
+	addUnitToCombat(combat, user)
+	addUnitToCombat(combat, ally)
+	addUnitToCombat(combat, enemy)
+	addUnitToCombat(combat, farEnemy)
+	combat.AjouterObjet(user.TeamID(), domain.ObjetBombe, 1)
 
 	factory := commands.NewCommandFactory(combat)
-	invoker := commands.NewCommandInvoker(100) // Max 100 commandes dans l'historique
 
-	// Act - Exécuter plusieurs commandes
-	cmd1, _ := factory.CreateWaitCommand(unit)
-	cmd2, _ := factory.CreateWaitCommand(unit)
-	cmd3, _ := factory.CreateWaitCommand(unit)
+	// Act - Lancer la bombe sur la case (2,2)
+	cmd, err := factory.CreateItemCommandAtPosition(user, domain.ObjetBombe, 2, 2)
+	if err != nil {
+		t.Fatalf("Erreur lors de la création de ItemCommand: %v", err)
+	}
+	if err := cmd.Validate(); err != nil {
+		t.Fatalf("La bombe devrait être valide: %v", err)
+	}
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
 
-	invoker.Execute(cmd1)
-	invoker.Execute(cmd2)
-	invoker.Execute(cmd3)
+	// Assert - L'ennemi et l'allié dans la zone sont touchés, pas l'ennemi éloigné
+	if len(result.Effects) != 2 {
+		t.Fatalf("2 cibles attendues, obtenu: %d", len(result.Effects))
+	}
+	if enemy.HPActuels() != 100-domain.BombeDegats || ally.HPActuels() != 100-domain.BombeDegats {
+		t.Errorf("L'ennemi et l'allié devraient subir %d dégâts", domain.BombeDegats)
+	}
+	if farEnemy.HPActuels() != 100 {
+		t.Errorf("L'ennemi hors zone ne devrait pas être touché")
+	}
+	if result.DamageDealt != 2*domain.BombeDegats {
+		t.Errorf("DamageDealt attendu %d, obtenu %d", 2*domain.BombeDegats, result.DamageDealt)
+	}
+}
+
+// Test de ItemCommand - Une unité charmée soigne ses nouveaux alliés et bombarde son équipe d'origine
+func TestItemCommand_UtilisateurCharme(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	user := createTestUnit("U1", 50)
+	ally := createTestUnit("U2", 50)
+	charmeur := createTestUnitWithTeam("E1", 50, "team2")
+	allyPos, _ := shared.NewPosition(1, 0)
+	charmeurPos, _ := shared.NewPosition(0, 1)
+	ally.DeplacerVers(allyPos)
+	charmeur.DeplacerVers(charmeurPos)
+	addUnitToCombat(combat, user)
+	addUnitToCombat(combat, ally)
+	addUnitToCombat(combat, charmeur)
+	combat.AjouterObjet(user.TeamID(), domain.ObjetPotion, 2)
+	combat.AjouterObjet(user.TeamID(), domain.ObjetBombe, 1)
+	charmeur.RecevoirDegats(30)
+	ally.RecevoirDegats(30)
+	if _, err := combat.AppliquerCharme(charmeur, user, 2); err != nil {
+		t.Fatalf("Erreur au charme: %v", err)
+	}
+	factory := commands.NewCommandFactory(combat)
+
+	// Act
+	potionCharmeur, _ := factory.CreateItemCommand(user, domain.ObjetPotion, charmeur.ID())
+	potionAllie, _ := factory.CreateItemCommand(user, domain.ObjetPotion, ally.ID())
+	bombe, _ := factory.CreateItemCommand(user, domain.ObjetBombe, ally.ID())
 
 	// Assert
-	history := invoker.GetHistory()
-	if len(history) != 3 {
-		t.Errorf("L'historique devrait contenir 3 commandes, obtenu: %d", len(history))
+	if err := potionCharmeur.Validate(); err != nil {
+		t.Errorf("Le charmeur est un allié de l'unité charmée: %v", err)
+	}
+	if err := potionAllie.Validate(); err == nil {
+		t.Errorf("L'unité charmée ne devrait plus soigner son équipe d'origine")
+	}
+	if err := bombe.Validate(); err != nil {
+		t.Errorf("L'unité charmée devrait pouvoir bombarder son équipe d'origine: %v", err)
 	}
 }
 
-// Test de Command Rollback
-func TestCommand_Rollback(t *testing.T) {
+// Test de ItemCommand - Bombe hors de portée de lancer
+func TestItemCommand_BombOutOfRange(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	user := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(8, 8)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, user)
+	addUnitToCombat(combat, enemy)
+	combat.AjouterObjet(user.TeamID(), domain.ObjetBombe, 1)
+
+	factory := commands.NewCommandFactory(combat)
+	cmd, err := factory.CreateItemCommandAtPosition(user, domain.ObjetBombe, 8, 8)
+	if err != nil {
+		t.Fatalf("Erreur lors de la création de ItemCommand: %v", err)
+	}
+
+	// Act
+	err = cmd.Validate()
+
+	// Assert
+	if err == nil {
+		t.Errorf("La bombe devrait être hors de portée")
+	}
+}
+
+// Test de MoveCommand - Coût en Stamina par case et rollback
+func TestMoveCommand_StaminaCost(t *testing.T) {
 	// Arrange
 	combat := createTestCombat()
 	unit := createTestUnit("U1", 50)
-	initialPos, _ := shared.NewPosition(0, 0)
-	unit.DeplacerVers(initialPos)
 	addUnitToCombat(combat, unit)
+	initialStamina := unit.StatsActuelles().Stamina
 
 	factory := commands.NewCommandFactory(combat)
+	cmd, err := factory.CreateMoveCommand(unit, 2, 1)
+	if err != nil {
+		t.Fatalf("Erreur lors de la création: %v", err)
+	}
+	if err := cmd.Validate(); err != nil {
+		t.Fatalf("MoveCommand devrait être valide: %v", err)
+	}
 
-	// Act - Exécuter puis Rollback
-	cmd, _ := factory.CreateMoveCommand(unit, 5, 5)
+	// Act
 	result, err := cmd.Execute()
 	if err != nil {
 		t.Fatalf("Erreur lors de l'exécution: %v", err)
 	}
 
-	if result != nil && result.Success {
-		// Rollback
-		err = cmd.Rollback()
-		if err != nil {
-			t.Errorf("Erreur lors du rollback: %v", err)
-		}
+	// Assert
+	expected := 3 * domain.CoutStaminaParCase
+	if result.CostStamina != expected {
+		t.Errorf("CostStamina attendu %d, obtenu %d", expected, result.CostStamina)
+	}
+	if unit.StatsActuelles().Stamina != initialStamina-expected {
+		t.Errorf("Stamina attendue %d, obtenue %d", initialStamina-expected, unit.StatsActuelles().Stamina)
+	}
 
-		// Assert - L'unité devrait être revenue à sa position initiale
-		pos := unit.Position()
-		if pos.X() != initialPos.X() || pos.Y() != initialPos.Y() {
-			t.Errorf("Position après rollback: (%d,%d), attendue: (%d,%d)",
-				pos.X(), pos.Y(), initialPos.X(), initialPos.Y())
-		}
+	if err := cmd.Rollback(); err != nil {
+		t.Fatalf("Erreur lors du rollback: %v", err)
+	}
+	if unit.StatsActuelles().Stamina != initialStamina {
+		t.Errorf("La Stamina devrait être restaurée après rollback")
+	}
+}
+
+// Test de AttackCommand - Épuisement quand la Stamina tombe à 0
+func TestAttackCommand_Exhaustion(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 50)
+	target := createTestUnitWithTeam("E1", 50, "team2")
+	targetPos, _ := shared.NewPosition(1, 0)
+	target.DeplacerVers(targetPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, target)
+	attacker.SetStamina(domain.CoutStaminaAttaque)
+
+	factory := commands.NewCommandFactory(combat)
+	cmd, err := factory.CreateAttackCommand(attacker, target.ID())
+	if err != nil {
+		t.Fatalf("Erreur lors de la création: %v", err)
+	}
+	if err := cmd.Validate(); err != nil {
+		t.Fatalf("AttackCommand devrait être valide: %v", err)
+	}
+
+	// Act
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	// Assert - L'attaquant est épuisé et ne peut plus attaquer
+	if !attacker.EstEpuise() {
+		t.Fatalf("L'attaquant devrait être épuisé")
+	}
+	if len(result.StatusApplied) != 1 {
+		t.Errorf("Le statut Épuisement devrait apparaître dans le résultat")
+	}
+
+	next, _ := factory.CreateAttackCommand(attacker, target.ID())
+	if err := next.Validate(); err == nil {
+		t.Errorf("Une unité épuisée ne devrait pas pouvoir attaquer")
+	}
+}
+
+// Test de SkillCommand avec provocation respectée par le TargetValidator
+func TestSkillCommand_Taunt(t *testing.T) {
+	// Arrange - Le paladin provoque le héros, un goblin est aussi à portée
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 50)
+	paladin := createTestUnitWithTeam("E1", 50, "team2")
+	goblin := createTestUnitWithTeam("E2", 50, "team2")
+	paladinPos, _ := shared.NewPosition(1, 0)
+	goblinPos, _ := shared.NewPosition(0, 1)
+	paladin.DeplacerVers(paladinPos)
+	goblin.DeplacerVers(goblinPos)
+	addUnitToCombat(combat, heros)
+	addUnitToCombat(combat, paladin)
+	addUnitToCombat(combat, goblin)
+
+	taunt := createTestSkill("taunt", 5, domain.CompetenceAttaque)
+	provocation := shared.TypeStatutProvocation
+	taunt.AjouterEffet(domain.NewEffetCompetence(domain.EffetStatut, 0, 2, &provocation))
+	paladin.AjouterCompetence(taunt)
+
+	cmd := commands.NewSkillCommand(paladin, combat, taunt, []*domain.Unite{heros})
+
+	// Act
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	// Assert - Le héros est provoqué et ne peut viser que le paladin
+	if !heros.EstProvoquee() {
+		t.Fatalf("Le héros devrait être provoqué")
+	}
+	if last := result.Effects[len(result.Effects)-1]; last.Type != commands.EffectTypeStatus || last.TargetID != heros.ID() {
+		t.Errorf("L'effet de provocation devrait apparaître dans le résultat")
+	}
+
+	validator := validators.NewTargetValidator()
+	if err := validator.Validate(commands.NewAttackCommand(heros, combat, goblin)); err == nil {
+		t.Errorf("Un héros provoqué ne devrait pas pouvoir attaquer le goblin")
+	}
+	if err := validator.Validate(commands.NewAttackCommand(heros, combat, paladin)); err != nil {
+		t.Errorf("Un héros provoqué devrait pouvoir attaquer le paladin: %v", err)
+	}
+}
+
+// Test de SkillCommand avec drain de vie et de mana rattachés aux dégâts, annulés au rollback
+func TestSkillCommand_Drain(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	caster := createTestUnit("U1", 50)
+	target := createTestUnitWithTeam("E1", 50, "team2")
+	targetPos, _ := shared.NewPosition(1, 0)
+	target.DeplacerVers(targetPos)
+	addUnitToCombat(combat, caster)
+	addUnitToCombat(combat, target)
+	caster.SetHP(40)
+
+	skill := createTestSkill("drain", 10, domain.CompetenceMagie)
+	skill.AjouterEffet(domain.NewEffetCompetence(domain.EffetDrainVie, 50, 0, nil))
+	skill.AjouterEffet(domain.NewEffetCompetence(domain.EffetDrainMana, 20, 0, nil))
+	caster.AjouterCompetence(skill)
+	hpAvant := caster.HPActuels()
+	mpAvant := caster.StatsActuelles().MP
+	hpCibleAvant := target.HPActuels()
+
+	cmd := commands.NewSkillCommand(caster, combat, skill, []*domain.Unite{target})
+
+	// Act
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	// Assert - Les drains sont rattachés à l'effet de dégâts
+	degats := result.Effects[0]
+	if degats.Type != commands.EffectTypeDamage || len(degats.Linked) != 2 {
+		t.Fatalf("L'effet de dégâts devrait porter 2 effets liés, obtenu: %+v", degats)
+	}
+	reels := hpCibleAvant - target.HPActuels()
+	if degats.Linked[0].Type != commands.EffectTypeDrain || degats.Linked[0].Value != reels*50/100 {
+		t.Errorf("Drain de vie attendu: %d, obtenu: %+v", reels*50/100, degats.Linked[0])
+	}
+	if caster.HPActuels() != hpAvant+reels*50/100 {
+		t.Errorf("HP du lanceur attendus: %d, obtenus: %d", hpAvant+reels*50/100, caster.HPActuels())
+	}
+	if degats.Linked[1].Type != commands.EffectTypeManaDrain {
+		t.Errorf("Le second effet lié devrait être un drain de mana")
+	}
+
+	// Rollback - Lanceur et cible retrouvent leur état
+	if err := cmd.Rollback(); err != nil {
+		t.Fatalf("Erreur lors du rollback: %v", err)
+	}
+	if caster.HPActuels() != hpAvant || caster.StatsActuelles().MP != mpAvant {
+		t.Errorf("Rollback: HP/MP du lanceur attendus %d/%d, obtenus %d/%d", hpAvant, mpAvant, caster.HPActuels(), caster.StatsActuelles().MP)
+	}
+	if target.HPActuels() != hpCibleAvant {
+		t.Errorf("Rollback: HP de la cible attendus %d, obtenus %d", hpCibleAvant, target.HPActuels())
+	}
+}
+
+// Test de SkillCommand infligeant les HP manquants du lanceur
+func TestSkillCommand_MissingHPDamage(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	caster := createTestUnit("U1", 50)
+	target := createTestUnitWithTeam("E1", 50, "team2")
+	targetPos, _ := shared.NewPosition(1, 0)
+	target.DeplacerVers(targetPos)
+	addUnitToCombat(combat, caster)
+	addUnitToCombat(combat, target)
+	caster.SetHP(70)
+
+	skill := createTestSkill("rage", 0, domain.CompetenceAttaque)
+	skill.AjouterEffet(domain.NewEffetCompetence(domain.EffetDegatsHPManquants, 0, 0, nil))
+	caster.AjouterCompetence(skill)
+
+	cmd := commands.NewSkillCommand(caster, combat, skill, []*domain.Unite{target})
+
+	// Act
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	// Assert
+	if result.DamageDealt != 30 {
+		t.Errorf("Dégâts attendus: 30 (HP manquants), obtenus: %d", result.DamageDealt)
+	}
+	if target.HPActuels() != 70 {
+		t.Errorf("HP de la cible attendus: 70, obtenus: %d", target.HPActuels())
+	}
+}
+
+// Test de SkillCommand: résultat, événements et menace reflètent les montants réels (surplus exclu)
+func TestSkillCommand_ReportsActualAmounts(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	caster := createTestUnit("U1", 50)
+	ally := createTestUnit("U2", 50)
+	target := createTestUnitWithTeam("E1", 50, "team2")
+	targetPos, _ := shared.NewPosition(1, 0)
+	target.DeplacerVers(targetPos)
+	allyPos, _ := shared.NewPosition(0, 1)
+	ally.DeplacerVers(allyPos)
+	addUnitToCombat(combat, caster)
+	addUnitToCombat(combat, ally)
+	addUnitToCombat(combat, target)
+	target.SetHP(5)
+	ally.SetHP(96)
+
+	fireball := createTestSkill("fireball", 0, domain.CompetenceMagie)
+	heal := createTestSkill("heal", 0, domain.CompetenceSoin)
+	caster.AjouterCompetence(fireball)
+	caster.AjouterCompetence(heal)
+
+	// Act
+	degats, err := commands.NewSkillCommand(caster, combat, fireball, []*domain.Unite{target}).Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+	menaceApresDegats := combat.TableMenace(target.ID()).Get(caster.ID())
+	soins, err := commands.NewSkillCommand(caster, combat, heal, []*domain.Unite{ally}).Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	// Assert - Dégâts plafonnés aux HP restants, soins plafonnés aux HP max
+	if degats.DamageDealt != 5 || degats.Effects[0].Value != 5 {
+		t.Errorf("Dégâts réels attendus: 5, obtenus: %d (effet: %d)", degats.DamageDealt, degats.Effects[0].Value)
+	}
+	if menaceApresDegats != 5*domain.MenaceParDegat {
+		t.Errorf("Menace attendue: %d, obtenue: %d", 5*domain.MenaceParDegat, menaceApresDegats)
+	}
+	if soins.HealingDone != 4 || soins.Effects[0].Value != 4 {
+		t.Errorf("Soins réels attendus: 4, obtenus: %d (effet: %d)", soins.HealingDone, soins.Effects[0].Value)
+	}
+	for _, evt := range combat.GetUncommittedEvents() {
+		if soin, ok := evt.(*domain.SoinApliqueEvent); ok && soin.Soin != 4 {
+			t.Errorf("SoinApliqueEvent attendu avec 4 HP, obtenu: %d", soin.Soin)
+		}
+	}
+}
+
+// Test de SkillCommand renvoyé par Reflet (sans boucle si le lanceur est aussi sous Reflet)
+func TestSkillCommand_Reflect(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	caster := createTestUnit("U1", 50)
+	target := createTestUnitWithTeam("E1", 50, "team2")
+	targetPos, _ := shared.NewPosition(1, 0)
+	target.DeplacerVers(targetPos)
+	addUnitToCombat(combat, caster)
+	addUnitToCombat(combat, target)
+	target.AjouterStatut(shared.NewStatut(shared.TypeStatutReflet, 3, domain.RenvoiVersLanceur))
+	caster.AjouterStatut(shared.NewStatut(shared.TypeStatutReflet, 3, domain.RenvoiVersLanceur))
+
+	skill := createTestSkill("fireball", 10, domain.CompetenceMagie)
+	caster.AjouterCompetence(skill)
+	hpCaster := caster.HPActuels()
+	hpTarget := target.HPActuels()
+
+	cmd := commands.NewSkillCommand(caster, combat, skill, []*domain.Unite{target})
+
+	// Act
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	// Assert - Le sort revient une seule fois sur le lanceur
+	if target.HPActuels() != hpTarget {
+		t.Errorf("Le réflecteur ne devrait pas subir de dégâts")
+	}
+	if caster.HPActuels() >= hpCaster {
+		t.Errorf("Le lanceur devrait subir son propre sort")
+	}
+	if result.Effects[0].Type != commands.EffectTypeReflect || result.Effects[0].TargetID != caster.ID() {
+		t.Errorf("Le premier effet devrait être le renvoi vers le lanceur, obtenu: %+v", result.Effects[0])
+	}
+	renvois := 0
+	for _, evt := range combat.GetUncommittedEvents() {
+		if _, ok := evt.(*domain.SortRenvoyeEvent); ok {
+			renvois++
+		}
+	}
+	if renvois != 1 {
+		t.Errorf("Un seul SortRenvoyeEvent attendu, obtenu: %d", renvois)
+	}
+}
+
+// Test de SkillCommand annulé par Contre-sort (rendu au rollback)
+func TestSkillCommand_SpellCounter(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	caster := createTestUnit("U1", 50)
+	target := createTestUnitWithTeam("E1", 50, "team2")
+	targetPos, _ := shared.NewPosition(1, 0)
+	target.DeplacerVers(targetPos)
+	addUnitToCombat(combat, caster)
+	addUnitToCombat(combat, target)
+	target.AjouterStatut(shared.NewStatut(shared.TypeStatutContreSort, 3, 0))
+
+	skill := createTestSkill("fireball", 10, domain.CompetenceMagie)
+	caster.AjouterCompetence(skill)
+	hpTarget := target.HPActuels()
+
+	cmd := commands.NewSkillCommand(caster, combat, skill, []*domain.Unite{target})
+
+	// Act
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	// Assert - Le sort est annulé et le Contre-sort consommé
+	if target.HPActuels() != hpTarget || result.DamageDealt != 0 {
+		t.Errorf("Le sort annulé ne devrait infliger aucun dégât")
+	}
+	if target.AContreSort() {
+		t.Errorf("Le Contre-sort devrait être consommé")
+	}
+	if result.Effects[0].Type != commands.EffectTypeSpellCountered {
+		t.Errorf("Effet SPELL_COUNTERED attendu, obtenu: %+v", result.Effects[0])
+	}
+
+	if err := cmd.Rollback(); err != nil {
+		t.Fatalf("Erreur lors du rollback: %v", err)
+	}
+	if !target.AContreSort() {
+		t.Errorf("Le rollback devrait rendre le Contre-sort")
+	}
+}
+
+// Test de FleeCommand avec probabilité de réussite
+func TestFleeCommand_Success(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 100) // Speed élevé = plus de chances
+	addUnitToCombat(combat, unit)
+
+	factory := commands.NewCommandFactory(combat)
+
+	// Act
+	cmd, err := factory.CreateFleeCommand(unit)
+	if err != nil {
+		t.Fatalf("Erreur lors de la création de FleeCommand: %v", err)
+	}
+
+	// Execute plusieurs fois pour tester la probabilité
+	successCount := 0
+	for i := 0; i < 100; i++ {
+		result, err := cmd.Execute()
+		if err == nil && result != nil && result.Success {
+			successCount++
+		}
+
+		// Recréer la commande pour chaque tentative
+		cmd, _ = factory.CreateFleeCommand(unit)
+	}
+
+	// Assert - Avec speed 100, devrait réussir au moins 50% du temps
+	if successCount < 30 {
+		t.Errorf("FleeCommand devrait réussir plus souvent avec speed élevé, succès: %d/100", successCount)
+	}
+}
+
+// Test de WaitCommand
+func TestWaitCommand_Execute(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	addUnitToCombat(combat, unit)
+
+	factory := commands.NewCommandFactory(combat)
+
+	// Act
+	cmd, err := factory.CreateWaitCommand(unit)
+	if err != nil {
+		t.Fatalf("Erreur lors de la création de WaitCommand: %v", err)
+	}
+
+	// Execute
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Errorf("WaitCommand ne devrait jamais échouer: %v", err)
+	}
+
+	// Assert
+	if result == nil || !result.Success {
+		t.Errorf("WaitCommand devrait toujours réussir")
+	}
+	if result.Message == "" {
+		t.Errorf("WaitCommand devrait retourner un message")
+	}
+}
+
+// Test de CommandInvoker - History
+func TestCommandInvoker_History(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	addUnitToCombat(combat, unit)
+
+	factory := commands.NewCommandFactory(combat)
+	invoker := commands.NewCommandInvoker(100) // Max 100 commandes dans l'historique
+
+	// Act - Exécuter plusieurs commandes
+	cmd1, _ := factory.CreateWaitCommand(unit)
+	cmd2, _ := factory.CreateWaitCommand(unit)
+	cmd3, _ := factory.CreateWaitCommand(unit)
+
+	invoker.Execute(cmd1)
+	invoker.Execute(cmd2)
+	invoker.Execute(cmd3)
+
+	// Assert
+	history := invoker.GetHistory()
+	if len(history) != 3 {
+		t.Errorf("L'historique devrait contenir 3 commandes, obtenu: %d", len(history))
+	}
+}
+
+// Test de CommandInvoker - Undo/Redo et invalidation des commandes annulées
+func TestCommandInvoker_UndoRedo(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+
+	factory := commands.NewCommandFactory(combat)
+	invoker := commands.NewCommandInvoker(0) // Historique illimité
+	hpAvant := enemy.HPActuels()
+	attaque, _ := factory.CreateAttackCommand(attacker, enemy.ID())
+	if _, err := invoker.Execute(attaque); err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+	hpApres := enemy.HPActuels()
+
+	// Act & Assert - Annuler
+	if err := invoker.Undo(); err != nil {
+		t.Fatalf("Erreur lors de l'annulation: %v", err)
+	}
+	if enemy.HPActuels() != hpAvant {
+		t.Errorf("Undo devrait rendre ses HP à E1: %d, obtenu: %d", hpAvant, enemy.HPActuels())
+	}
+	if invoker.CanUndo() || !invoker.CanRedo() {
+		t.Errorf("L'attaque annulée devrait passer de l'historique à la pile de redo")
+	}
+
+	// Act & Assert - Refaire
+	if err := invoker.Redo(); err != nil {
+		t.Fatalf("Erreur lors du redo: %v", err)
+	}
+	if enemy.HPActuels() != hpApres {
+		t.Errorf("Redo devrait infliger à nouveau les dégâts: %d HP attendus, obtenu: %d", hpApres, enemy.HPActuels())
+	}
+	if !invoker.CanUndo() || invoker.CanRedo() {
+		t.Errorf("L'attaque refaite devrait revenir dans l'historique")
+	}
+
+	// Act & Assert - Une nouvelle commande invalide les commandes annulées
+	_ = invoker.Undo()
+	attente, _ := factory.CreateWaitCommand(attacker)
+	if _, err := invoker.Execute(attente); err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+	if invoker.CanRedo() {
+		t.Errorf("Une nouvelle commande devrait vider la pile de redo")
+	}
+	if err := invoker.Redo(); err == nil {
+		t.Errorf("Redo devrait échouer sans commande annulée")
+	}
+}
+
+// Test de Command Rollback
+func TestCommand_Rollback(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	initialPos, _ := shared.NewPosition(0, 0)
+	unit.DeplacerVers(initialPos)
+	addUnitToCombat(combat, unit)
+
+	factory := commands.NewCommandFactory(combat)
+
+	// Act - Exécuter puis Rollback
+	cmd, _ := factory.CreateMoveCommand(unit, 5, 5)
+	result, err := cmd.Execute()
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+
+	if result != nil && result.Success {
+		// Rollback
+		err = cmd.Rollback()
+		if err != nil {
+			t.Errorf("Erreur lors du rollback: %v", err)
+		}
+
+		// Assert - L'unité devrait être revenue à sa position initiale
+		pos := unit.Position()
+		if pos.X() != initialPos.X() || pos.Y() != initialPos.Y() {
+			t.Errorf("Position après rollback: (%d,%d), attendue: (%d,%d)",
+				pos.X(), pos.Y(), initialPos.X(), initialPos.Y())
+		}
+	}
+}
+
+// Test de la chaîne de validation: portée et cibles vérifiées avant exécution
+func TestValidationChain_RangeAndTarget(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 50)
+	ally := createTestUnit("U2", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	allyPos, _ := shared.NewPosition(1, 0)
+	enemyPos, _ := shared.NewPosition(3, 0)
+	ally.DeplacerVers(allyPos)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, ally)
+	addUnitToCombat(combat, enemy)
+
+	chain := validators.NewValidationChain(commands.NewCommandRegistry())
+
+	// Act & Assert - Ennemi hors de portée de mêlée
+	if err := chain.ValidateCommand(commands.NewAttackCommand(attacker, combat, enemy)); err == nil {
+		t.Errorf("Une attaque hors de portée devrait être rejetée")
+	}
+
+	// Act & Assert - Allié à portée
+	if err := chain.ValidateCommand(commands.NewAttackCommand(attacker, combat, ally)); err == nil {
+		t.Errorf("Une attaque sur un allié devrait être rejetée")
+	}
+
+	// Act & Assert - Déplacement sur une case occupée
+	if err := chain.ValidateCommand(commands.NewMoveCommand(attacker, combat, allyPos)); err == nil {
+		t.Errorf("Un déplacement sur une case occupée devrait être rejeté")
+	}
+
+	// Act & Assert - Ennemi au contact
+	enemyPos, _ = shared.NewPosition(0, 1)
+	enemy.DeplacerVers(enemyPos)
+	if err := chain.ValidateCommand(commands.NewAttackCommand(attacker, combat, enemy)); err != nil {
+		t.Errorf("Une attaque au contact devrait être acceptée: %v", err)
+	}
+}
+
+// Test d'une règle personnalisée ajoutée en fin de chaîne (règles de tournoi)
+func TestValidationChain_CustomValidator(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, unit)
+	addUnitToCombat(combat, enemy)
+
+	chain := validators.NewValidationChain(commands.NewCommandRegistry())
+	chain.AddValidator(validators.NewRuleValidator("tournoi", func(cmd commands.Command) error {
+		if cmd.GetType() == commands.CommandTypeFlee {
+			return fmt.Errorf("la fuite est interdite en tournoi")
+		}
+		return nil
+	}))
+
+	// Act
+	errFuite := chain.Validate(commands.NewFleeCommand(unit, combat))
+	errAttaque := chain.Validate(commands.NewAttackCommand(unit, combat, enemy))
+
+	// Assert
+	if errFuite == nil || !strings.Contains(errFuite.Error(), "tournoi") {
+		t.Errorf("La règle de tournoi devrait rejeter la fuite, obtenu: %v", errFuite)
+	}
+	if errAttaque != nil {
+		t.Errorf("La règle de tournoi ne devrait pas bloquer une attaque: %v", errAttaque)
+	}
+}
+
+// Test du câblage: le combat initialisé valide chaque action avant de l'exécuter
+func TestExecutePlayerAction_ValidatesBeforeExecute(t *testing.T) {
+	// Arrange - U1 joue en premier
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(4, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+
+	demarrerCombatJoueurs(t, combat, attacker, enemy)
+	hpAvant := enemy.HPActuels()
+
+	// Act
+	_, err := combatfacade.ExecutePlayerAction(combat, attacker.ID(), combatfacade.CommandTypeAttack,
+		map[string]interface{}{"targetID": enemy.ID()})
+
+	// Assert
+	if combatfacade.GetValidationChain(combat) == nil {
+		t.Fatalf("La chaîne de validation devrait être attachée au combat")
+	}
+	if err == nil || !strings.Contains(err.Error(), "rejetée") {
+		t.Errorf("Une attaque hors de portée devrait être rejetée par la chaîne, obtenu: %v", err)
+	}
+	if enemy.HPActuels() != hpAvant {
+		t.Errorf("Aucune commande rejetée ne devrait être exécutée")
+	}
+
+	// La main reste à l'attaquant
+	sm := combatfacade.GetStateMachine(combat)
+	if sm.GetCurrentState() != "ActionSelection" || sm.UniteActive() != attacker {
+		t.Errorf("Le tour de %s devrait continuer, état: %s", attacker.ID(), sm.GetCurrentState())
+	}
+}
+
+// Test de Wait dans la machine: l'attente est validée par la chaîne comme toute autre commande
+func TestExecutePlayerAction_WaitValidated(t *testing.T) {
+	// Arrange - une règle de tournoi interdit d'attendre
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(4, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, unit)
+	addUnitToCombat(combat, enemy)
+	sm := demarrerCombatJoueurs(t, combat, unit, enemy)
+	combatfacade.GetValidationChain(combat).AddValidator(validators.NewRuleValidator("tournoi", func(cmd commands.Command) error {
+		if cmd.GetType() == commands.CommandTypeWait {
+			return fmt.Errorf("attendre est interdit en tournoi")
+		}
+		return nil
+	}))
+
+	// Act
+	result, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(unit.ID()))
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), "tournoi") {
+		t.Errorf("La règle de tournoi devrait rejeter l'attente, obtenu: %v", err)
+	}
+	if result != nil {
+		t.Errorf("Une attente rejetée ne devrait retourner aucun résultat, obtenu: %+v", result)
+	}
+	if sm.GetCurrentState() != "ActionSelection" || sm.UniteActive() != unit {
+		t.Errorf("Le tour de %s devrait continuer, état: %s", unit.ID(), sm.GetCurrentState())
+	}
+	for _, evt := range combat.GetUncommittedEvents() {
+		if evt.EventType() == "ActionExecutee" {
+			t.Errorf("Une attente rejetée ne devrait pas être enregistrée")
+		}
+	}
+}
+
+// Test de Wait dans la machine: l'attente passe par validation et exécution, une seule fois
+func TestExecutePlayerAction_WaitDrivesStateMachine(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(4, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, unit)
+	addUnitToCombat(combat, enemy)
+	sm := demarrerCombatJoueurs(t, combat, unit, enemy)
+	avant := len(combat.GetUncommittedEvents())
+
+	// Act
+	result, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(unit.ID()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if result == nil || !result.Success {
+		t.Fatalf("L'attente devrait réussir, obtenu: %+v", result)
+	}
+	traverses := make(map[string]bool)
+	for _, transition := range sm.GetStateHistory() {
+		traverses[transition.ToState] = true
+	}
+	for _, etat := range []string{"Validating", "Confirmed", "Executing", "ApplyingEffects"} {
+		if !traverses[etat] {
+			t.Errorf("L'attente devrait passer par l'état %s", etat)
+		}
+	}
+	actions := 0
+	for _, evt := range combat.GetUncommittedEvents()[avant:] {
+		if evt.EventType() == "ActionExecutee" {
+			actions++
+		}
+	}
+	if actions != 1 {
+		t.Errorf("L'attente devrait être enregistrée une fois, obtenu: %d", actions)
+	}
+}
+
+// Test de bout en bout: l'action passe par les états du tour puis la machine avance au joueur suivant
+func TestExecutePlayerAction_DrivesStateMachine(t *testing.T) {
+	// Arrange - U1 plus rapide que E1, au contact
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+	sm := demarrerCombatJoueurs(t, combat, attacker, enemy)
+	hpAvant := enemy.HPActuels()
+
+	// Act
+	result, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewAttackAction(attacker.ID(), enemy.ID()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if result == nil || !result.Success {
+		t.Fatalf("L'attaque devrait réussir, obtenu: %+v", result)
+	}
+	if enemy.HPActuels() >= hpAvant {
+		t.Errorf("E1 devrait avoir subi des dégâts")
+	}
+
+	traverses := make(map[string]bool)
+	for _, transition := range sm.GetStateHistory() {
+		traverses[transition.ToState] = true
+	}
+	for _, etat := range []string{"Validating", "Confirmed", "Executing", "ApplyingEffects", "CheckVictory", "TurnEnd", "WaitingATB"} {
+		if !traverses[etat] {
+			t.Errorf("L'action devrait passer par l'état %s", etat)
+		}
+	}
+
+	// La machine attend maintenant l'action de E1
+	if sm.GetCurrentState() != "ActionSelection" || sm.UniteActive() != enemy {
+		t.Errorf("Le tour devrait passer à E1, état: %s", sm.GetCurrentState())
+	}
+}
+
+// Test des erreurs typées: action hors de son tour ou dans un état qui n'attend aucune action
+func TestExecutePlayerAction_RejectsOutOfTurn(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+
+	initializer := combatinitializer.NewCombatInitializer(combat)
+	if err := initializer.InitializeAll(); err != nil {
+		t.Fatalf("Erreur d'initialisation: %v", err)
+	}
+
+	// Act - Combat non démarré (Idle)
+	_, errEtat := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(attacker.ID()))
+
+	// Assert
+	var etatInvalide *states.ErreurEtatInvalide
+	if !errors.As(errEtat, &etatInvalide) || etatInvalide.Etat != "Idle" {
+		t.Errorf("ErreurEtatInvalide attendue en Idle, obtenu: %v", errEtat)
+	}
+
+	// Act - E1 joue pendant le tour de U1
+	attacker.SetIA(false)
+	enemy.SetIA(false)
+	if err := combatfacade.DemarrerCombat(combat); err != nil {
+		t.Fatalf("Erreur au démarrage: %v", err)
+	}
+	hpAvant := attacker.HPActuels()
+	_, errTour := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewAttackAction(enemy.ID(), attacker.ID()))
+
+	// Assert
+	var horsTour *states.ErreurHorsTour
+	if !errors.As(errTour, &horsTour) || horsTour.UniteID != enemy.ID() || horsTour.UniteActive != attacker.ID() {
+		t.Errorf("ErreurHorsTour attendue pour E1, obtenu: %v", errTour)
+	}
+	if attacker.HPActuels() != hpAvant {
+		t.Errorf("Une action hors tour ne devrait pas être exécutée")
+	}
+}
+
+// demarrerCombatJoueurs initialise les patterns, confie les unités aux joueurs et avance au premier tour
+func demarrerCombatJoueurs(t *testing.T, combat *domain.Combat, unites ...*domain.Unite) *states.CombatStateMachine {
+	t.Helper()
+	return demarrerCombatJoueursAvecRegistre(t, combat, commands.NewCommandRegistry(), unites...)
+}
+
+// demarrerCombatJoueursAvecRegistre démarre le combat avec les types de commandes personnalisés du registre
+func demarrerCombatJoueursAvecRegistre(t *testing.T, combat *domain.Combat, registry *commands.CommandRegistry, unites ...*domain.Unite) *states.CombatStateMachine {
+	t.Helper()
+	initializer := combatinitializer.NewCombatInitializerWithRegistry(combat, registry)
+	if err := initializer.InitializeAll(); err != nil {
+		t.Fatalf("Erreur d'initialisation: %v", err)
+	}
+	for _, unite := range unites {
+		unite.SetIA(false)
+	}
+	if err := combatfacade.DemarrerCombat(combat); err != nil {
+		t.Fatalf("Erreur au démarrage: %v", err)
+	}
+
+	sm := combatfacade.GetStateMachine(combat)
+	if sm.UniteActive() != unites[0] {
+		t.Fatalf("Le premier tour devrait revenir à %s, état: %s", unites[0].ID(), sm.GetCurrentState())
+	}
+	return sm
+}
+
+// TestMettreEnPause_RefuseActionsJusquALaReprise teste qu'une pause arbitrée gèle le tour du joueur
+func TestMettreEnPause_RefuseActionsJusquALaReprise(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, attacker, enemy)
+
+	// Act
+	if err := combatfacade.MettreEnPause(combat, "litige sur une règle"); err != nil {
+		t.Fatalf("Erreur à la mise en pause: %v", err)
+	}
+	_, errPause := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(attacker.ID()))
+
+	// Assert
+	if sm.GetCurrentState() != "Paused" {
+		t.Errorf("État attendu Paused, obtenu: %s", sm.GetCurrentState())
+	}
+	var etatInvalide *states.ErreurEtatInvalide
+	if !errors.As(errPause, &etatInvalide) {
+		t.Errorf("ErreurEtatInvalide attendue pendant la pause, obtenu: %v", errPause)
+	}
+
+	// Act - Reprise: le tour revient à la même unité
+	if err := combatfacade.ReprendreCombat(combat); err != nil {
+		t.Fatalf("Erreur à la reprise: %v", err)
+	}
+
+	// Assert
+	if sm.GetCurrentState() != "ActionSelection" || sm.UniteActive() != attacker {
+		t.Errorf("Le tour de U1 devrait reprendre, état: %s", sm.GetCurrentState())
+	}
+	if combat.Etat() != domain.EtatEnCours {
+		t.Errorf("Le combat devrait être en cours après la reprise, obtenu: %v", combat.Etat())
+	}
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(attacker.ID())); err != nil {
+		t.Errorf("L'action devrait être acceptée après la reprise: %v", err)
+	}
+}
+
+// TestAnnulerCombat_TermineSansVainqueur teste que l'annulation arrête la machine et refuse toute action
+func TestAnnulerCombat_TermineSansVainqueur(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, attacker, enemy)
+
+	// Act
+	err := combatfacade.AnnulerCombat(combat, "incident serveur")
+	_, errAction := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(attacker.ID()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur à l'annulation: %v", err)
+	}
+	if sm.GetCurrentState() != "Cancelled" {
+		t.Errorf("État attendu Cancelled, obtenu: %s", sm.GetCurrentState())
+	}
+	if combat.ObtenirResultat() != "CANCELLED" {
+		t.Errorf("Résultat attendu CANCELLED, obtenu: %s", combat.ObtenirResultat())
+	}
+	var etatInvalide *states.ErreurEtatInvalide
+	if !errors.As(errAction, &etatInvalide) {
+		t.Errorf("ErreurEtatInvalide attendue après l'annulation, obtenu: %v", errAction)
+	}
+	if errReprise := combatfacade.ReprendreCombat(combat); errReprise == nil {
+		t.Errorf("Un combat annulé ne devrait pas pouvoir reprendre")
+	}
+}
+
+// TestFinaliserCombat_DistribueRecompenses teste qu'une victoire finalisée récompense le vainqueur une fois
+func TestFinaliserCombat_DistribueRecompenses(t *testing.T) {
+	// Arrange - U1 attaque E1 jusqu'au KO, E1 attend à chacun de ses tours
+	combat, attacker, enemy := creerDuelJoueurs(t)
+	sm := demarrerCombatJoueurs(t, combat, attacker, enemy)
+	for i := 0; i < 100 && sm.GetCurrentState() != "BattleEnded"; i++ {
+		action := combatfacade.NewAttackAction(attacker.ID(), enemy.ID())
+		if sm.UniteActive() == enemy {
+			action = combatfacade.NewWaitAction(enemy.ID())
+		}
+		if _, err := combatfacade.ExecutePlayerActionTyped(combat, action); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+	if sm.GetCurrentState() != "BattleEnded" {
+		t.Fatalf("Le combat devrait être terminé, état: %s", sm.GetCurrentState())
+	}
+
+	// Act
+	if err := sm.Finaliser(); err != nil {
+		t.Fatalf("Erreur à la finalisation: %v", err)
+	}
+	combat.DistribuerRecompenses()
+
+	// Assert
+	var recompenses []*domain.RecompensesDistribueesEvent
+	for _, evt := range combat.GetUncommittedEvents() {
+		if r, ok := evt.(*domain.RecompensesDistribueesEvent); ok {
+			recompenses = append(recompenses, r)
+		}
+	}
+	if len(recompenses) != 1 {
+		t.Fatalf("Les récompenses devraient être distribuées une fois, obtenu: %d", len(recompenses))
+	}
+	if recompenses[0].Vainqueur != attacker.TeamID() || recompenses[0].Experience[attacker.ID()] != domain.ExperienceParEnnemiVaincu {
+		t.Errorf("U1 devrait gagner %d XP pour team1, obtenu: %+v", domain.ExperienceParEnnemiVaincu, recompenses[0])
+	}
+}
+
+// TestAnnulerCombat_SansRecompenses teste qu'un combat annulé ne lève aucun événement de récompense ni de butin
+func TestAnnulerCombat_SansRecompenses(t *testing.T) {
+	// Arrange
+	combat, attacker, enemy := creerDuelJoueurs(t)
+	demarrerCombatJoueurs(t, combat, attacker, enemy)
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewAttackAction(attacker.ID(), enemy.ID())); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+
+	// Act
+	if err := combatfacade.AnnulerCombat(combat, "incident serveur"); err != nil {
+		t.Fatalf("Erreur à l'annulation: %v", err)
+	}
+	errFinalisation := combatfacade.GetStateMachine(combat).Finaliser()
+	combat.DistribuerRecompenses()
+
+	// Assert
+	if errFinalisation == nil {
+		t.Errorf("Un combat annulé ne devrait pas pouvoir être finalisé")
+	}
+	for _, evt := range combat.GetUncommittedEvents() {
+		switch evt.EventType() {
+		case "RecompensesDistribuees", "ButinDepose", "ButinRamasse":
+			t.Errorf("Un combat annulé ne devrait lever aucun événement %s", evt.EventType())
+		}
+	}
+}
+
+// creerDuelJoueurs crée un combat démarré entre U1 (SPD 60) et E1 (SPD 50)
+func creerDuelJoueurs(t *testing.T) (*domain.Combat, *domain.Unite, *domain.Unite) {
+	t.Helper()
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	return combat, attacker, enemy
+}
+
+// restaurerDepuis reconstruit un combat depuis les événements d'un autre et restaure sa State Machine
+// Les unités sont rendues aux joueurs comme dans demarrerCombatJoueurs (après CombatDemarre)
+func restaurerDepuis(t *testing.T, source *domain.Combat) (*domain.Combat, *states.CombatStateMachine) {
+	t.Helper()
+	combat, err := domain.ReconstruireDepuisEvenements(source.GetUncommittedEvents())
+	if err != nil {
+		t.Fatalf("Erreur au rejeu: %v", err)
+	}
+	for _, equipe := range combat.Equipes() {
+		for _, unite := range equipe.Membres() {
+			unite.SetIA(false)
+		}
+	}
+
+	if err := combatinitializer.NewCombatInitializer(combat).InitializeAll(); err != nil {
+		t.Fatalf("Erreur d'initialisation: %v", err)
+	}
+	sm := combatfacade.GetStateMachine(combat)
+	if err := sm.Restaurer(*combat.PositionMachine()); err != nil {
+		t.Fatalf("Erreur de restauration: %v", err)
+	}
+	return combat, sm
+}
+
+// TestStateMachine_RestaurerPosition teste qu'un combat rechargé reprend exactement où il s'était arrêté
+func TestStateMachine_RestaurerPosition(t *testing.T) {
+	// Arrange
+	combat, attacker, enemy := creerDuelJoueurs(t)
+	sm := demarrerCombatJoueurs(t, combat, attacker, enemy)
+
+	// Act
+	recharge, smRecharge := restaurerDepuis(t, combat)
+
+	// Assert - Même état, même unité, mêmes jauges
+	if smRecharge.GetCurrentState() != "ActionSelection" || smRecharge.UniteActive().ID() != attacker.ID() {
+		t.Fatalf("Le tour de U1 devrait être restauré, état: %s", smRecharge.GetCurrentState())
+	}
+	if fmt.Sprint(smRecharge.Context().ATBSystem.Snapshot()) != fmt.Sprint(sm.Context().ATBSystem.Snapshot()) {
+		t.Errorf("Jauges restaurées différentes:\n%v\n%v", smRecharge.Context().ATBSystem.Snapshot(), sm.Context().ATBSystem.Snapshot())
+	}
+
+	// Act - Le même tour joué des deux côtés mène à la même suite
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(attacker.ID())); err != nil {
+		t.Fatalf("Erreur sur le combat d'origine: %v", err)
+	}
+	if _, err := combatfacade.ExecutePlayerActionTyped(recharge, combatfacade.NewWaitAction(attacker.ID())); err != nil {
+		t.Fatalf("Erreur sur le combat rechargé: %v", err)
+	}
+
+	// Assert
+	if smRecharge.UniteActive().ID() != sm.UniteActive().ID() {
+		t.Errorf("Unité active attendue %s, obtenu: %s", sm.UniteActive().ID(), smRecharge.UniteActive().ID())
+	}
+	if fmt.Sprint(smRecharge.Context().ATBSystem.Snapshot()) != fmt.Sprint(sm.Context().ATBSystem.Snapshot()) {
+		t.Errorf("Les jauges devraient évoluer à l'identique après restauration")
+	}
+}
+
+// TestStateMachine_RestaurerRoundCTB teste qu'un combat CTB rechargé reprend le round en cours
+func TestStateMachine_RestaurerRoundCTB(t *testing.T) {
+	// Arrange - round U1 → U2 → E1, U1 a déjà joué
+	combat := createTestCombat()
+	if err := combat.SetModeTour(domain.ModeTourCTB); err != nil {
+		t.Fatalf("Erreur de configuration: %v", err)
+	}
+	premier := createTestUnit("U1", 60)
+	second := createTestUnit("U2", 55)
+	secondPos, _ := shared.NewPosition(0, 1)
+	second.DeplacerVers(secondPos)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(5, 5)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, premier)
+	addUnitToCombat(combat, second)
+	addUnitToCombat(combat, enemy)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, premier, second, enemy)
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(premier.ID())); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+
+	// Act
+	recharge, smRecharge := restaurerDepuis(t, combat)
+
+	// Assert - le round continue avec U2 puis E1 des deux côtés
+	if smRecharge.UniteActive() == nil || smRecharge.UniteActive().ID() != second.ID() {
+		t.Fatalf("Le tour de U2 devrait être restauré, état: %s", smRecharge.GetCurrentState())
+	}
+	for _, attendu := range []domain.UnitID{enemy.ID(), premier.ID()} {
+		for _, c := range []*domain.Combat{combat, recharge} {
+			active := combatfacade.GetStateMachine(c).UniteActive()
+			if _, err := combatfacade.ExecutePlayerActionTyped(c, combatfacade.NewWaitAction(active.ID())); err != nil {
+				t.Fatalf("Erreur inattendue: %v", err)
+			}
+		}
+		if sm.UniteActive().ID() != attendu || smRecharge.UniteActive().ID() != attendu {
+			t.Errorf("Tour attendu de %s, obtenu: %s (origine) et %s (rechargé)",
+				attendu, sm.UniteActive().ID(), smRecharge.UniteActive().ID())
+		}
+	}
+}
+
+// TestStateMachine_RestaurerPause teste qu'un combat rechargé en pause reprend le tour interrompu
+func TestStateMachine_RestaurerPause(t *testing.T) {
+	// Arrange
+	combat, attacker, enemy := creerDuelJoueurs(t)
+	demarrerCombatJoueurs(t, combat, attacker, enemy)
+	if err := combatfacade.MettreEnPause(combat, "litige"); err != nil {
+		t.Fatalf("Erreur à la mise en pause: %v", err)
+	}
+
+	// Act
+	recharge, smRecharge := restaurerDepuis(t, combat)
+	etatRecharge := smRecharge.GetCurrentState()
+	errReprise := combatfacade.ReprendreCombat(recharge)
+
+	// Assert
+	if etatRecharge != "Paused" {
+		t.Errorf("État restauré attendu Paused, obtenu: %s", etatRecharge)
+	}
+	if errReprise != nil {
+		t.Fatalf("Erreur à la reprise: %v", errReprise)
+	}
+	if smRecharge.UniteActive() == nil || smRecharge.UniteActive().ID() != attacker.ID() {
+		t.Errorf("Le tour de U1 devrait reprendre après rechargement, état: %s", smRecharge.GetCurrentState())
+	}
+}
+
+// TestUndoLastCommand_EntrainementUniquement teste annuler/refaire via la façade:
+// autorisés en entraînement, refusés dans un combat normal (classé)
+func TestUndoLastCommand_EntrainementUniquement(t *testing.T) {
+	for _, entrainement := range []bool{true, false} {
+		// Arrange
+		combat := createTestCombat()
+		attacker := createTestUnit("U1", 60)
+		enemy := createTestUnitWithTeam("E1", 50, "team2")
+		enemyPos, _ := shared.NewPosition(1, 0)
+		enemy.DeplacerVers(enemyPos)
+		addUnitToCombat(combat, attacker)
+		addUnitToCombat(combat, enemy)
+		if err := combat.SetModeEntrainement(entrainement); err != nil {
+			t.Fatalf("Erreur de configuration: %v", err)
+		}
+		if err := combat.Demarrer(); err != nil {
+			t.Fatalf("Erreur au démarrage du combat: %v", err)
+		}
+		demarrerCombatJoueurs(t, combat, attacker, enemy)
+		hpAvant := enemy.HPActuels()
+		if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewAttackAction(attacker.ID(), enemy.ID())); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+		hpApres := enemy.HPActuels()
+
+		// Act
+		errUndo := combatfacade.UndoLastCommand(combat)
+
+		// Assert
+		if !entrainement {
+			if errUndo == nil || combatfacade.CanUndo(combat) {
+				t.Errorf("Un combat hors entraînement ne devrait pas permettre d'annuler")
+			}
+			if enemy.HPActuels() != hpApres {
+				t.Errorf("Les dégâts ne devraient pas être annulés hors entraînement")
+			}
+			continue
+		}
+		if errUndo != nil {
+			t.Fatalf("Erreur lors de l'annulation: %v", errUndo)
+		}
+		if enemy.HPActuels() != hpAvant || !combatfacade.CanRedo(combat) {
+			t.Errorf("L'attaque devrait être annulée et refaisable, HP: %d", enemy.HPActuels())
+		}
+		if err := combatfacade.RedoLastCommand(combat); err != nil {
+			t.Fatalf("Erreur lors du redo: %v", err)
+		}
+		if enemy.HPActuels() != hpApres || combatfacade.CanRedo(combat) {
+			t.Errorf("L'attaque devrait être refaite, HP: %d", enemy.HPActuels())
+		}
+	}
+}
+
+// TestUndoLastCommand_RembobineLeTour teste qu'annuler une action rend la main à son unité:
+// le tour de l'IA joué depuis est annulé avec elle, l'annulation est rechargée depuis le flux
+// d'événements et refaire rejoue l'action et le tour de l'IA
+func TestUndoLastCommand_RembobineLeTour(t *testing.T) {
+	// Arrange - U1 joue, puis l'IA E1, puis U2
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	allie := createTestUnit("U2", 40)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	alliePos, _ := shared.NewPosition(0, 1)
+	enemyPos, _ := shared.NewPosition(1, 0)
+	allie.DeplacerVers(alliePos)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, allie)
+	addUnitToCombat(combat, enemy)
+	if err := combat.SetModeEntrainement(true); err != nil {
+		t.Fatalf("Erreur de configuration: %v", err)
+	}
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	enemy.SetIA(true)
+	sm := demarrerCombatJoueurs(t, combat, attacker, allie)
+	hpEnnemi := enemy.HPActuels()
+	hpEquipe := attacker.HPActuels() + allie.HPActuels()
+
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewAttackAction(attacker.ID(), enemy.ID())); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if sm.UniteActive() == nil || sm.UniteActive().ID() != allie.ID() || attacker.HPActuels()+allie.HPActuels() == hpEquipe {
+		t.Fatalf("L'IA devrait avoir joué son tour avant celui de U2, état: %s", sm.GetCurrentState())
+	}
+	hpApres := enemy.HPActuels()
+
+	// Act
+	errUndo := combatfacade.UndoLastCommand(combat)
+
+	// Assert - U1 rejoue son tour, comme si ni son attaque ni le tour de l'IA n'avaient eu lieu
+	if errUndo != nil {
+		t.Fatalf("Erreur lors de l'annulation: %v", errUndo)
+	}
+	if sm.GetCurrentState() != "ActionSelection" || sm.UniteActive() == nil || sm.UniteActive().ID() != attacker.ID() {
+		t.Errorf("La main devrait revenir à U1, état: %s", sm.GetCurrentState())
+	}
+	if enemy.HPActuels() != hpEnnemi || attacker.HPActuels()+allie.HPActuels() != hpEquipe {
+		t.Errorf("L'attaque et le tour de l'IA devraient être annulés, HP E1: %d", enemy.HPActuels())
+	}
+	recharge, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	verifierRechargement(t, combat, recharge)
+	if !recharge.PeutRetablirAction() || recharge.PeutAnnulerAction() {
+		t.Errorf("L'annulation devrait être rechargée depuis le flux d'événements")
+	}
+
+	if err := combatfacade.RedoLastCommand(combat); err != nil {
+		t.Fatalf("Erreur lors du redo: %v", err)
+	}
+	if enemy.HPActuels() != hpApres || sm.UniteActive() == nil || sm.UniteActive().ID() != allie.ID() {
+		t.Errorf("L'attaque et le tour de l'IA devraient être rejoués jusqu'au tour de U2, état: %s", sm.GetCurrentState())
+	}
+	if !combatfacade.CanUndo(combat) || combatfacade.CanRedo(combat) {
+		t.Errorf("L'action refaite devrait revenir dans l'historique")
+	}
+}
+
+// Test de CompositeCommand - La compétence est validée à la position atteinte par le déplacement
+func TestCompositeCommand_MoveThenAct(t *testing.T) {
+	// Arrange - E1 hors de portée de U1 avant le déplacement
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(3, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+
+	factory := commands.NewCommandFactory(combat)
+	move, _ := factory.CreateMoveCommand(attacker, 2, 0)
+	attack, _ := factory.CreateAttackCommand(attacker, enemy.ID())
+	wait, _ := factory.CreateWaitCommand(attacker)
+	composite, err := factory.CreateCompositeCommand(attacker, move, attack, wait)
+	if err != nil {
+		t.Fatalf("Erreur lors de la création: %v", err)
+	}
+	if err := attack.Validate(); err == nil {
+		t.Fatalf("L'attaque seule devrait être hors de portée")
+	}
+	hpAvant := enemy.HPActuels()
+
+	// Act
+	if err := composite.Validate(); err != nil {
+		t.Fatalf("La séquence devrait être valide sur l'état projeté: %v", err)
+	}
+	if attacker.Position().X() != 0 {
+		t.Fatalf("La validation ne devrait pas déplacer l'acteur")
+	}
+	result, err := composite.Execute()
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur lors de l'exécution: %v", err)
+	}
+	if !result.Success || result.CostMovement == 0 || len(result.Effects) < 2 {
+		t.Errorf("Le résultat devrait cumuler le déplacement et l'attaque: %+v", result)
+	}
+	if attacker.Position().X() != 2 || enemy.HPActuels() >= hpAvant {
+		t.Errorf("U1 devrait s'être déplacé puis avoir frappé E1")
+	}
+}
+
+// Test de CompositeCommand - Une étape invalide sur l'état projeté rejette toute la séquence
+func TestCompositeCommand_ValidatesProjectedState(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(5, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+	staminaAvant := attacker.StatsActuelles().Stamina
+
+	factory := commands.NewCommandFactory(combat)
+	move, _ := factory.CreateMoveCommand(attacker, 2, 0)
+	attack, _ := factory.CreateAttackCommand(attacker, enemy.ID())
+	wait, _ := factory.CreateWaitCommand(attacker)
+
+	// Act
+	tropLoin, _ := factory.CreateCompositeCommand(attacker, move, attack)
+	errPortee := tropLoin.Validate()
+	attenteAuMilieu, _ := factory.CreateCompositeCommand(attacker, wait, move)
+	errOrdre := attenteAuMilieu.Validate()
+
+	// Assert
+	if errPortee == nil || !strings.Contains(errPortee.Error(), "étape 2") {
+		t.Errorf("L'attaque devrait rester hors de portée après le déplacement, obtenu: %v", errPortee)
+	}
+	if errOrdre == nil {
+		t.Errorf("Attendre devrait être refusé avant la dernière étape")
+	}
+	if attacker.Position().X() != 0 || attacker.StatsActuelles().Stamina != staminaAvant {
+		t.Errorf("La validation devrait laisser l'acteur dans son état réel")
+	}
+}
+
+// Test de CompositeCommand - L'échec d'une étape annule les étapes déjà exécutées
+func TestCompositeCommand_RollbackOnStepFailure(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	addUnitToCombat(combat, unit)
+	unit.SetHP(20)
+	combat.AjouterObjet(unit.TeamID(), domain.ObjetPotion, 1)
+	etatAvant := unit.CapturerEtat()
+
+	factory := commands.NewCommandFactory(combat)
+	move, _ := factory.CreateMoveCommand(unit, 2, 0)
+	potion, _ := factory.CreateItemCommand(unit, domain.ObjetPotion, unit.ID())
+	composite, _ := factory.CreateCompositeCommand(unit, move, potion)
+	if err := composite.Validate(); err != nil {
+		t.Fatalf("La séquence devrait être valide: %v", err)
+	}
+
+	// La potion disparaît de l'inventaire entre la validation et l'exécution
+	if err := combat.ConsommerObjet(unit.TeamID(), domain.ObjetPotion, 1); err != nil {
+		t.Fatalf("Erreur de préparation: %v", err)
+	}
+
+	// Act
+	_, err := composite.Execute()
+
+	// Assert
+	if err == nil {
+		t.Fatalf("L'utilisation de la potion devrait échouer")
+	}
+	if !reflect.DeepEqual(etatAvant, unit.CapturerEtat()) {
+		t.Errorf("Le déplacement devrait être annulé: %+v, obtenu: %+v", etatAvant, unit.CapturerEtat())
+	}
+	if err := composite.Rollback(); err != nil {
+		t.Errorf("Un second rollback ne devrait rien faire: %v", err)
+	}
+}
+
+// failingStep est une étape qui échoue avant de prendre son snapshot (son rollback seul échouerait)
+type failingStep struct {
+	*commands.BaseCommand
+}
+
+func (c *failingStep) Validate() error { return nil }
+
+func (c *failingStep) Execute() (*commands.CommandResult, error) {
+	return nil, errors.New("étape impossible")
+}
+
+func (c *failingStep) Rollback() error {
+	return c.RestoreSnapshot()
+}
+
+// Test de CompositeCommand - Une étape en échec sans snapshot n'empêche pas d'annuler les précédentes
+func TestCompositeCommand_RollbackStepFailedBeforeSnapshot(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	addUnitToCombat(combat, unit)
+	etatAvant := unit.CapturerEtat()
+
+	factory := commands.NewCommandFactory(combat)
+	move, _ := factory.CreateMoveCommand(unit, 2, 0)
+	step := &failingStep{BaseCommand: commands.NewBaseCommand(unit, combat, "FAIL")}
+	composite, _ := factory.CreateCompositeCommand(unit, move, step)
+
+	// Act
+	_, err := composite.Execute()
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), "étape 2") {
+		t.Fatalf("L'échec de l'étape 2 devrait être signalé, obtenu: %v", err)
+	}
+	if strings.Contains(err.Error(), "rollback") {
+		t.Errorf("Le rollback ne devrait pas échouer: %v", err)
+	}
+	if !reflect.DeepEqual(etatAvant, unit.CapturerEtat()) {
+		t.Errorf("Le déplacement devrait être annulé: %+v, obtenu: %+v", etatAvant, unit.CapturerEtat())
+	}
+	if n := len(combat.GetUncommittedEvents()); n != 0 {
+		t.Errorf("Les événements du déplacement devraient être retirés, obtenu: %d", n)
+	}
+}
+
+// Test de bout en bout: déplacement, attaque et fin de tour en une seule requête
+func TestExecutePlayerAction_CompositeInOneTurn(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(3, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, attacker, enemy)
+	hpAvant := enemy.HPActuels()
+
+	// Act
+	result, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewCompositeAction(attacker.ID(),
+		combatfacade.NewMoveAction(attacker.ID(), 2, 0),
+		combatfacade.NewAttackAction(attacker.ID(), enemy.ID()),
+		combatfacade.NewWaitAction(attacker.ID()),
+	))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if !result.Success || attacker.Position().X() != 2 || enemy.HPActuels() >= hpAvant {
+		t.Errorf("U1 devrait s'être déplacé puis avoir frappé E1: %+v", result)
+	}
+	if sm.GetCurrentState() != "ActionSelection" || sm.UniteActive() != enemy {
+		t.Errorf("Le tour devrait passer à E1 après la séquence, état: %s", sm.GetCurrentState())
+	}
+}
+
+// Test de la façade à paramètres libres: une action composite décrite par ses étapes
+func TestExecutePlayerAction_CompositeParametresLibres(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	attacker := createTestUnit("U1", 60)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(3, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, attacker)
+	addUnitToCombat(combat, enemy)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, attacker, enemy)
+	hpAvant := enemy.HPActuels()
+
+	// Act
+	result, err := combatfacade.ExecutePlayerAction(combat, attacker.ID(), combatfacade.CommandTypeComposite,
+		map[string]interface{}{"steps": []map[string]interface{}{
+			{"type": combatfacade.CommandTypeMove, "targetX": 2, "targetY": 0},
+			{"type": combatfacade.CommandTypeAttack, "targetID": enemy.ID()},
+			{"type": combatfacade.CommandTypeWait},
+		}})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if !result.Success || attacker.Position().X() != 2 || enemy.HPActuels() >= hpAvant {
+		t.Errorf("U1 devrait s'être déplacé puis avoir frappé E1: %+v", result)
+	}
+	if sm.GetCurrentState() != "ActionSelection" || sm.UniteActive() != enemy {
+		t.Errorf("Le tour devrait passer à E1 après la séquence, état: %s", sm.GetCurrentState())
 	}
 }
@@ -0,0 +1,159 @@
+package step_c_patterns_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/combatfacade"
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// demarrerCombatPrevision démarre un combat où U1 (0,0) joue face à E1
+func demarrerCombatPrevision(t *testing.T, xEnnemi int) (*domain.Combat, *domain.Unite, *domain.Unite) {
+	t.Helper()
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 60)
+	ennemi := createTestUnitWithTeam("E1", 50, "team2")
+	position, _ := shared.NewPosition(xEnnemi, 0)
+	ennemi.DeplacerVers(position)
+	addUnitToCombat(combat, heros)
+	addUnitToCombat(combat, ennemi)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	demarrerCombatJoueurs(t, combat, heros, ennemi)
+	return combat, heros, ennemi
+}
+
+// previsionUnite retourne la prévision d'une unité
+func previsionUnite(t *testing.T, preview *commands.CommandPreview, id domain.UnitID) commands.UnitPreview {
+	t.Helper()
+	for _, unit := range preview.Units {
+		if unit.UnitID == id {
+			return unit
+		}
+	}
+	t.Fatalf("Aucune prévision pour %s: %+v", id, preview.Units)
+	return commands.UnitPreview{}
+}
+
+func TestPreviewPlayerAction_AttaqueNeModifiePasLeCombat(t *testing.T) {
+	// Arrange
+	combat, heros, ennemi := demarrerCombatPrevision(t, 1)
+	ennemi.SetHP(5)
+	avant := capturerEtatRollback(combat)
+	evenementsAvant := len(combat.GetUncommittedEvents())
+
+	// Act
+	preview, err := combatfacade.PreviewPlayerAction(combat, combatfacade.NewAttackAction(heros.ID(), ennemi.ID()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if !preview.Valid {
+		t.Fatalf("L'attaque devrait être valide: %s", preview.Reason)
+	}
+	cible := previsionUnite(t, preview, ennemi.ID())
+	if cible.DamageMin <= 0 || cible.DamageMax != cible.DamageMin || cible.HitChance != commands.ChanceToucheCertaine {
+		t.Errorf("Prévision de dégâts inattendue: %+v", cible)
+	}
+	if !cible.KO || cible.HPBefore != 5 || cible.HPAfter != 0 {
+		t.Errorf("L'attaque devrait mettre E1 KO: %+v", cible)
+	}
+	if preview.CostStamina != combat.ReglesStamina().CoutAttaque {
+		t.Errorf("Coût en Stamina attendu %d, obtenu %d", combat.ReglesStamina().CoutAttaque, preview.CostStamina)
+	}
+	if len(preview.Tiles) != 1 || !preview.Tiles[0].Equals(ennemi.Position()) {
+		t.Errorf("La case de E1 devrait être touchée: %v", preview.Tiles)
+	}
+
+	if !reflect.DeepEqual(avant, capturerEtatRollback(combat)) {
+		t.Errorf("La prévisualisation ne devrait pas modifier le combat")
+	}
+	if len(combat.GetUncommittedEvents()) != evenementsAvant {
+		t.Errorf("La prévisualisation ne devrait émettre aucun événement")
+	}
+}
+
+func TestPreviewPlayerAction_DegatsDeterministes(t *testing.T) {
+	// Arrange - un calculateur de critiques ne tire aucun critique: la prévision annonce les dégâts réels
+	combat, heros, ennemi := demarrerCombatPrevision(t, 1)
+	combat.SetDamageCalculator(domain.NewCriticalDamageCalculator(domain.NewPhysicalDamageCalculator(), 0.25, 1.5))
+	hpAvant := ennemi.HPActuels()
+
+	// Act
+	preview, err := combatfacade.PreviewPlayerAction(combat, combatfacade.NewAttackAction(heros.ID(), ennemi.ID()))
+	if err != nil || !preview.Valid {
+		t.Fatalf("L'attaque devrait être valide: %v %+v", err, preview)
+	}
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewAttackAction(heros.ID(), ennemi.ID())); err != nil {
+		t.Fatalf("Erreur lors de l'attaque: %v", err)
+	}
+
+	// Assert
+	cible := previsionUnite(t, preview, ennemi.ID())
+	if cible.DamageMin != cible.DamageMax || cible.DamageMin != hpAvant-ennemi.HPActuels() {
+		t.Errorf("Dégâts prévus %d-%d, infligés %d", cible.DamageMin, cible.DamageMax, hpAvant-ennemi.HPActuels())
+	}
+}
+
+func TestPreviewPlayerAction_ActionInvalide(t *testing.T) {
+	// Arrange - E1 hors de portée d'attaque
+	combat, heros, ennemi := demarrerCombatPrevision(t, 5)
+	avant := capturerEtatRollback(combat)
+
+	// Act
+	preview, err := combatfacade.PreviewPlayerAction(combat, combatfacade.NewAttackAction(heros.ID(), ennemi.ID()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Une action invalide devrait donner une prévision, pas une erreur: %v", err)
+	}
+	if preview.Valid || preview.Reason == "" {
+		t.Errorf("La prévision devrait être refusée avec sa raison: %+v", preview)
+	}
+	if len(preview.Units) != 0 {
+		t.Errorf("Aucune unité ne devrait être touchée: %+v", preview.Units)
+	}
+	if !reflect.DeepEqual(avant, capturerEtatRollback(combat)) {
+		t.Errorf("La prévisualisation ne devrait pas modifier le combat")
+	}
+}
+
+func TestPreviewPlayerAction_CompositeCheminEtCout(t *testing.T) {
+	// Arrange
+	combat, heros, ennemi := demarrerCombatPrevision(t, 3)
+	avant := capturerEtatRollback(combat)
+
+	// Act
+	preview, err := combatfacade.PreviewPlayerAction(combat, combatfacade.NewCompositeAction(heros.ID(),
+		combatfacade.NewMoveAction(heros.ID(), 2, 0),
+		combatfacade.NewAttackAction(heros.ID(), ennemi.ID()),
+	))
+
+	// Assert
+	if err != nil || !preview.Valid {
+		t.Fatalf("La séquence devrait être valide: %v %+v", err, preview)
+	}
+	arrivee, _ := shared.NewPosition(2, 0)
+	trouvee := false
+	for _, tile := range preview.Tiles {
+		trouvee = trouvee || tile.Equals(arrivee)
+	}
+	if !trouvee {
+		t.Errorf("Le chemin du déplacement devrait être dans les cases touchées: %v", preview.Tiles)
+	}
+	acteur := previsionUnite(t, preview, heros.ID())
+	if !acteur.Position.Equals(arrivee) || acteur.StaminaAfter != acteur.StaminaBefore-preview.CostStamina {
+		t.Errorf("U1 devrait finir en (2,0) après avoir payé la séquence: %+v", acteur)
+	}
+	if previsionUnite(t, preview, ennemi.ID()).DamageMin <= 0 {
+		t.Errorf("E1 devrait subir des dégâts")
+	}
+	if !reflect.DeepEqual(avant, capturerEtatRollback(combat)) {
+		t.Errorf("La prévisualisation ne devrait pas modifier le combat")
+	}
+}
@@ -0,0 +1,247 @@
+package step_c_patterns_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/combatfacade"
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// stealCommand est une commande de jeu définie hors du package commands (Vol)
+type stealCommand struct {
+	*commands.BaseCommand
+	target *domain.Unite
+	itemID string
+}
+
+// stealParams sont les paramètres décodés d'un Vol
+type stealParams struct {
+	targetID domain.UnitID
+	itemID   string
+}
+
+const coutStaminaVol = 5
+
+func (c *stealCommand) GetTargets() []*domain.Unite { return []*domain.Unite{c.target} }
+func (c *stealCommand) GetRange() int               { return 1 }
+func (c *stealCommand) GetStaminaCost() int         { return coutStaminaVol }
+func (c *stealCommand) Validate() error             { return nil }
+
+func (c *stealCommand) Execute() (*commands.CommandResult, error) {
+	c.CreateSnapshot(c.target)
+	result := &commands.CommandResult{Success: true, Effects: []commands.CommandEffect{}}
+	if err := c.ConsumeStamina(c.GetStaminaCost(), result); err != nil {
+		return nil, err
+	}
+	c.GetCombat().AjouterObjet(c.GetActor().TeamID(), c.itemID, 1)
+	c.RecordTurnActivity()
+	result.Message = fmt.Sprintf("%s vole %s à %s", c.GetActor().Nom(), c.itemID, c.target.Nom())
+	return result, nil
+}
+
+func (c *stealCommand) Rollback() error {
+	return c.RestoreSnapshot()
+}
+
+// enregistrerVol crée un registre propre au test contenant le type STEAL
+func enregistrerVol(t *testing.T, regle func(cmd commands.Command) error) *commands.CommandRegistry {
+	t.Helper()
+	registry := commands.NewCommandRegistry()
+	err := registry.Register(commands.CommandDefinition{
+		Type:     "steal",
+		Physical: true,
+		Hostile:  true,
+		Validate: regle,
+		Decode: func(params commands.CommandParams) (interface{}, error) {
+			targetID, ok := params.UnitID(commands.ParamTargetID)
+			if !ok {
+				return nil, errors.New("cible manquante")
+			}
+			itemID, ok := params.String("itemID")
+			if !ok {
+				itemID = domain.ObjetPotion
+			}
+			return stealParams{targetID: targetID, itemID: itemID}, nil
+		},
+		Create: func(actor *domain.Unite, combat *domain.Combat, params interface{}) (commands.Command, error) {
+			p := params.(stealParams)
+			return &stealCommand{
+				BaseCommand: commands.NewBaseCommand(actor, combat, "STEAL"),
+				target:      combat.TrouverUnite(p.targetID),
+				itemID:      p.itemID,
+			}, nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Erreur lors de l'enregistrement: %v", err)
+	}
+	return registry
+}
+
+// demarrerCombatVol démarre un combat où U1 (0,0) joue face à E1 (1,0), allié U2 en (0,1)
+func demarrerCombatVol(t *testing.T, registry *commands.CommandRegistry) (*domain.Combat, *domain.Unite, *domain.Unite, *domain.Unite) {
+	t.Helper()
+	combat := createTestCombat()
+	voleur := createTestUnit("U1", 60)
+	allie := createTestUnit("U2", 40)
+	ennemi := createTestUnitWithTeam("E1", 50, "team2")
+	positionAllie, _ := shared.NewPosition(0, 1)
+	allie.DeplacerVers(positionAllie)
+	positionEnnemi, _ := shared.NewPosition(1, 0)
+	ennemi.DeplacerVers(positionEnnemi)
+	addUnitToCombat(combat, voleur)
+	addUnitToCombat(combat, allie)
+	addUnitToCombat(combat, ennemi)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	demarrerCombatJoueursAvecRegistre(t, combat, registry, voleur, ennemi, allie)
+	return combat, voleur, allie, ennemi
+}
+
+func TestCommandRegistry_EnregistrementInvalide(t *testing.T) {
+	creer := func(actor *domain.Unite, combat *domain.Combat, params interface{}) (commands.Command, error) {
+		return commands.NewWaitCommand(actor, combat), nil
+	}
+	registry := enregistrerVol(t, nil)
+
+	cas := map[string]commands.CommandDefinition{
+		"type standard réservé": {Type: "move", Create: creer},
+		"type déjà enregistré":  {Type: "Steal", Create: creer},
+		"sans constructeur":     {Type: "jump"},
+		"sans type":             {Create: creer},
+	}
+	for nom, definition := range cas {
+		if err := registry.Register(definition); err == nil {
+			t.Errorf("%s: l'enregistrement devrait échouer", nom)
+		}
+	}
+	if _, ok := registry.Lookup("JUMP"); ok {
+		t.Errorf("JUMP ne devrait pas être enregistré")
+	}
+}
+
+func TestExecutePlayerAction_CommandePersonnalisee(t *testing.T) {
+	// Arrange
+	registry := enregistrerVol(t, nil)
+	combat, voleur, _, ennemi := demarrerCombatVol(t, registry)
+	sm := combatfacade.GetStateMachine(combat)
+	staminaAvant := voleur.StatsActuelles().Stamina
+
+	// Act - paramètres bruts tels que décodés depuis le JSON de l'API REST
+	result, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewCustomAction(voleur.ID(), "steal",
+		commands.CommandParams{commands.ParamTargetID: string(ennemi.ID()), "itemID": domain.ObjetEther}))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if !result.Success || combat.ObtenirQuantiteObjet(voleur.TeamID(), domain.ObjetEther) != 1 {
+		t.Errorf("U1 devrait avoir volé un Éther: %+v", result)
+	}
+	if voleur.StatsActuelles().Stamina != staminaAvant-coutStaminaVol {
+		t.Errorf("Le vol devrait coûter %d de Stamina", coutStaminaVol)
+	}
+	if sm.UniteActive() == voleur {
+		t.Errorf("Le tour devrait passer à l'unité suivante")
+	}
+}
+
+func TestExecutePlayerAction_CommandePersonnaliseeValidee(t *testing.T) {
+	cas := []struct {
+		nom     string
+		regle   func(cmd commands.Command) error
+		prepare func(combat *domain.Combat, voleur, allie, ennemi *domain.Unite) domain.UnitID
+		erreur  string
+	}{
+		{
+			nom: "cible alliée refusée (hostile)",
+			prepare: func(_ *domain.Combat, _, allie, _ *domain.Unite) domain.UnitID {
+				return allie.ID()
+			},
+			erreur: "allié",
+		},
+		{
+			nom: "cible hors de portée (RangedCommand)",
+			prepare: func(_ *domain.Combat, _, _, ennemi *domain.Unite) domain.UnitID {
+				loin, _ := shared.NewPosition(5, 5)
+				ennemi.DeplacerVers(loin)
+				return ennemi.ID()
+			},
+			erreur: "hors de portée",
+		},
+		{
+			nom: "acteur Stun (physique)",
+			prepare: func(_ *domain.Combat, voleur, _, ennemi *domain.Unite) domain.UnitID {
+				_ = voleur.AjouterStatut(shared.NewStatut(shared.TypeStatutStun, 2, 0))
+				return ennemi.ID()
+			},
+			erreur: "Stun",
+		},
+		{
+			nom: "acteur épuisé (physique)",
+			prepare: func(_ *domain.Combat, voleur, _, ennemi *domain.Unite) domain.UnitID {
+				_ = voleur.AjouterStatut(shared.NewStatut(shared.TypeStatutEpuisement, 2, 0))
+				return ennemi.ID()
+			},
+			erreur: "épuisée",
+		},
+		{
+			nom: "Stamina insuffisante (StaminaConsumer)",
+			prepare: func(_ *domain.Combat, voleur, _, ennemi *domain.Unite) domain.UnitID {
+				voleur.SetStamina(coutStaminaVol - 1)
+				return ennemi.ID()
+			},
+			erreur: "Stamina",
+		},
+		{
+			nom:   "règle propre au type",
+			regle: func(cmd commands.Command) error { return errors.New("E1 n'a rien à voler") },
+			prepare: func(_ *domain.Combat, _, _, ennemi *domain.Unite) domain.UnitID {
+				return ennemi.ID()
+			},
+			erreur: "rien à voler",
+		},
+	}
+
+	for _, c := range cas {
+		t.Run(c.nom, func(t *testing.T) {
+			// Arrange
+			registry := enregistrerVol(t, c.regle)
+			combat, voleur, allie, ennemi := demarrerCombatVol(t, registry)
+			cibleID := c.prepare(combat, voleur, allie, ennemi)
+
+			// Act
+			_, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewCustomAction(voleur.ID(), "steal",
+				commands.CommandParams{commands.ParamTargetID: string(cibleID)}))
+
+			// Assert
+			if err == nil || !strings.Contains(err.Error(), c.erreur) {
+				t.Errorf("Erreur attendue contenant %q, obtenue: %v", c.erreur, err)
+			}
+			if combat.ObtenirQuantiteObjet(voleur.TeamID(), domain.ObjetPotion) != 0 {
+				t.Errorf("Rien ne devrait être volé")
+			}
+		})
+	}
+}
+
+func TestExecutePlayerAction_CommandePersonnaliseeInconnue(t *testing.T) {
+	// Arrange - STEAL n'est enregistré que dans le registre d'un autre moteur
+	enregistrerVol(t, nil)
+	combat, voleur, _, ennemi := demarrerCombatVol(t, commands.NewCommandRegistry())
+
+	// Act
+	_, err := combatfacade.ExecutePlayerAction(combat, voleur.ID(), "steal",
+		map[string]interface{}{commands.ParamTargetID: string(ennemi.ID())})
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), "type d'action inconnu") {
+		t.Errorf("Un type non enregistré devrait être refusé, obtenu: %v", err)
+	}
+}
@@ -0,0 +1,276 @@
+package step_c_patterns_test
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// etatRollback capture tout ce qu'une commande peut modifier dans un combat
+type etatRollback struct {
+	Unites     map[domain.UnitID]*domain.EtatUnite
+	Objets     map[domain.TeamID]map[shared.ObjetID]int
+	Butins     []string
+	Vainqueur  string
+	Menaces    map[string]int           // "IA<-source" -> menace
+	Provoquees map[domain.UnitID]string // unité provoquée -> provocateur
+	Camps      map[domain.UnitID]domain.TeamID
+	Activite   *domain.ActiviteTour
+	Evenements int // Événements non committés
+}
+
+// capturerEtatRollback capture l'état des unités, des inventaires, des butins, de l'aggro,
+// des camps et de l'activité du tour, ainsi que le nombre d'événements non committés
+func capturerEtatRollback(combat *domain.Combat) etatRollback {
+	etat := etatRollback{
+		Unites:     make(map[domain.UnitID]*domain.EtatUnite),
+		Objets:     make(map[domain.TeamID]map[shared.ObjetID]int),
+		Vainqueur:  combat.VerifierConditionsVictoire(),
+		Menaces:    make(map[string]int),
+		Provoquees: make(map[domain.UnitID]string),
+		Camps:      make(map[domain.UnitID]domain.TeamID),
+		Evenements: len(combat.GetUncommittedEvents()),
+	}
+	if activite := combat.ActiviteTour(); activite != nil {
+		copie := *activite
+		etat.Activite = &copie
+	}
+	unites := make([]*domain.Unite, 0)
+	for teamID, equipe := range combat.Equipes() {
+		unites = append(unites, equipe.Membres()...)
+		for _, unite := range equipe.Membres() {
+			etat.Unites[unite.ID()] = unite.CapturerEtat()
+			etat.Camps[unite.ID()] = combat.EquipeEffective(unite)
+			if provocateur := combat.CibleForcee(unite); provocateur != nil {
+				etat.Provoquees[unite.ID()] = string(provocateur.ID())
+			}
+		}
+		if inventaire := combat.InventaireEquipe(teamID); inventaire != nil && len(inventaire.Quantities()) > 0 {
+			etat.Objets[teamID] = inventaire.Quantities()
+		}
+	}
+	for _, unite := range unites {
+		table := combat.TableMenace(unite.ID())
+		if table == nil {
+			continue
+		}
+		for _, source := range unites {
+			if menace := table.Get(source.ID()); menace != 0 {
+				etat.Menaces[fmt.Sprintf("%s<-%s", unite.ID(), source.ID())] = menace
+			}
+		}
+	}
+	for _, butin := range combat.Butins() {
+		etat.Butins = append(etat.Butins, fmt.Sprintf("%s@%d,%d", butin.ID(), butin.Position().X(), butin.Position().Y()))
+	}
+	sort.Strings(etat.Butins)
+	return etat
+}
+
+// casRollback prépare un combat et la commande à exécuter puis annuler
+type casRollback struct {
+	nom string
+	// modifie indique si l'exécution change l'état (faux pour Attendre)
+	modifie  bool
+	preparer func(t *testing.T) (*domain.Combat, commands.Command)
+}
+
+// placer crée une unité à une position et l'ajoute au combat
+func placer(t *testing.T, combat *domain.Combat, id, teamID string, x, y int) *domain.Unite {
+	t.Helper()
+	unite := createTestUnitWithTeam(id, 50, teamID)
+	position, err := shared.NewPosition(x, y)
+	if err != nil {
+		t.Fatalf("Position invalide: %v", err)
+	}
+	unite.DeplacerVers(position)
+	addUnitToCombat(combat, unite)
+	return unite
+}
+
+// TestCommand_RollbackRestaureEtatComplet exécute chaque type de commande, l'annule
+// et vérifie que le combat retrouve exactement son état initial
+func TestCommand_RollbackRestaureEtatComplet(t *testing.T) {
+	cas := []casRollback{
+		{
+			nom:     "Déplacement sur un coffre",
+			modifie: true,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				heros := placer(t, combat, "U1", "team1", 0, 0)
+				placer(t, combat, "E1", "team2", 9, 9)
+				coffre, _ := shared.NewPosition(2, 1)
+				_ = combat.Apply(domain.NewButinDeposeEvent(combat.ID(), 1,
+					domain.NewButin("butin-E9", domain.ButinCoffre, coffre, "E9", domain.ObjetCoffreDefaut)))
+				cmd, err := commands.NewCommandFactory(combat).CreateMoveCommand(heros, 2, 1)
+				if err != nil {
+					t.Fatalf("Erreur lors de la création: %v", err)
+				}
+				return combat, cmd
+			},
+		},
+		{
+			nom:     "Attaque qui épuise l'attaquant, réveille et met KO la cible",
+			modifie: true,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				attaquant := placer(t, combat, "U1", "team1", 0, 0)
+				cible := placer(t, combat, "E1", "team2", 1, 0)
+				attaquant.SetStamina(combat.ReglesStamina().CoutAttaque)
+				cible.SetHP(1)
+				_ = cible.AjouterStatut(shared.NewStatut(shared.TypeStatutSommeil, 2, 0))
+				return combat, commands.NewAttackCommand(attaquant, combat, cible)
+			},
+		},
+		{
+			nom:     "Compétence multi-cibles: poison, Contre-sort, KO et cooldown",
+			modifie: true,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				lanceur := placer(t, combat, "U1", "team1", 0, 0)
+				contre := placer(t, combat, "E1", "team2", 1, 0)
+				fragile := placer(t, combat, "E2", "team2", 0, 1)
+				cible := placer(t, combat, "E3", "team2", 1, 1)
+				_ = contre.AjouterStatut(shared.NewStatut(shared.TypeStatutContreSort, 3, 0))
+				fragile.SetHP(1)
+
+				sort := createTestSkill("venin", 10, domain.CompetenceMagie)
+				poison := shared.TypeStatutPoison
+				sort.AjouterEffet(domain.NewEffetCompetence(domain.EffetStatut, 5, 3, &poison))
+				sort.AjouterEffet(domain.NewEffetCompetence(domain.EffetDrainVie, 50, 0, nil))
+				_ = lanceur.AjouterCompetence(sort)
+				lanceur.SetHP(40)
+				return combat, commands.NewSkillCommand(lanceur, combat, sort, []*domain.Unite{contre, fragile, cible})
+			},
+		},
+		{
+			nom:     "Compétence de provocation et de charme sur des unités IA",
+			modifie: true,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				lanceur := placer(t, combat, "U1", "team1", 0, 0)
+				provoque := placer(t, combat, "E1", "team2", 1, 0)
+				charme := placer(t, combat, "E2", "team2", 0, 1)
+				provoque.SetIA(true)
+				charme.SetIA(true)
+				combat.OuvrirActiviteTour(lanceur.ID())
+
+				sort := createTestSkill("emprise", 5, domain.CompetenceMagie)
+				provocation := shared.TypeStatutProvocation
+				charmeStatut := shared.TypeStatutCharme
+				sort.AjouterEffet(domain.NewEffetCompetence(domain.EffetStatut, 0, 2, &provocation))
+				sort.AjouterEffet(domain.NewEffetCompetence(domain.EffetStatut, 0, 2, &charmeStatut))
+				_ = lanceur.AjouterCompetence(sort)
+				return combat, commands.NewSkillCommand(lanceur, combat, sort, []*domain.Unite{provoque, charme})
+			},
+		},
+		{
+			nom:     "Compétence renvoyée par Reflet sur une autre unité",
+			modifie: true,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				combat.SetGraineAleatoire(1)
+				lanceur := placer(t, combat, "U1", "team1", 0, 0)
+				placer(t, combat, "U2", "team1", 5, 5)
+				reflecteur := placer(t, combat, "E1", "team2", 1, 0)
+				_ = reflecteur.AjouterStatut(shared.NewStatut(shared.TypeStatutReflet, 3, domain.RenvoiAleatoire))
+
+				sort := createTestSkill("feu", 10, domain.CompetenceMagie)
+				_ = lanceur.AjouterCompetence(sort)
+				return combat, commands.NewSkillCommand(lanceur, combat, sort, []*domain.Unite{reflecteur})
+			},
+		},
+		{
+			nom:     "Queue de Phénix sur un allié KO",
+			modifie: true,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				heros := placer(t, combat, "U1", "team1", 0, 0)
+				allie := placer(t, combat, "U2", "team1", 1, 0)
+				placer(t, combat, "E1", "team2", 9, 9)
+				allie.RecevoirDegats(1000)
+				combat.AvancerCompteAReboursKO(allie)
+				combat.AjouterObjet(heros.TeamID(), domain.ObjetQueuePhenix, 2)
+				cmd, err := commands.NewCommandFactory(combat).CreateItemCommand(heros, domain.ObjetQueuePhenix, allie.ID())
+				if err != nil {
+					t.Fatalf("Erreur lors de la création: %v", err)
+				}
+				return combat, cmd
+			},
+		},
+		{
+			nom:     "Bombe à zone avec tir ami et KO",
+			modifie: true,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				heros := placer(t, combat, "U1", "team1", 0, 0)
+				placer(t, combat, "U2", "team1", 2, 1)
+				ennemi := placer(t, combat, "E1", "team2", 2, 2)
+				ennemi.SetHP(1)
+				combat.AjouterObjet(heros.TeamID(), domain.ObjetBombe, 1)
+				cmd, err := commands.NewCommandFactory(combat).CreateItemCommandAtPosition(heros, domain.ObjetBombe, 2, 2)
+				if err != nil {
+					t.Fatalf("Erreur lors de la création: %v", err)
+				}
+				return combat, cmd
+			},
+		},
+		{
+			nom:     "Fuite",
+			modifie: false,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				heros := placer(t, combat, "U1", "team1", 0, 0)
+				placer(t, combat, "E1", "team2", 9, 9)
+				combat.SetFuiteAutorisee(true)
+				return combat, commands.NewFleeCommand(heros, combat)
+			},
+		},
+		{
+			nom:     "Attendre",
+			modifie: false,
+			preparer: func(t *testing.T) (*domain.Combat, commands.Command) {
+				combat := createTestCombat()
+				heros := placer(t, combat, "U1", "team1", 0, 0)
+				placer(t, combat, "E1", "team2", 9, 9)
+				return combat, commands.NewWaitCommand(heros, combat)
+			},
+		},
+	}
+
+	for _, c := range cas {
+		t.Run(c.nom, func(t *testing.T) {
+			// Arrange
+			combat, cmd := c.preparer(t)
+			avant := capturerEtatRollback(combat)
+
+			// Act - Exécuter puis annuler
+			if _, err := cmd.Execute(); err != nil {
+				t.Fatalf("Erreur lors de l'exécution: %v", err)
+			}
+			pendant := capturerEtatRollback(combat)
+			if err := cmd.Rollback(); err != nil {
+				t.Fatalf("Erreur lors du rollback: %v", err)
+			}
+			apres := capturerEtatRollback(combat)
+
+			// Assert
+			if c.modifie && reflect.DeepEqual(avant, pendant) {
+				t.Fatalf("L'exécution devrait modifier le combat")
+			}
+			if !reflect.DeepEqual(avant, apres) {
+				for id, etat := range avant.Unites {
+					if !reflect.DeepEqual(etat, apres.Unites[id]) {
+						t.Errorf("%s: état attendu %+v, obtenu %+v", id, etat, apres.Unites[id])
+					}
+				}
+				t.Errorf("Le rollback devrait restaurer le combat:\navant: %+v\naprès: %+v", avant, apres)
+			}
+		})
+	}
+}
@@ -0,0 +1,472 @@
+package step_c_patterns_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/combatfacade"
+	"github.com/aether-engine/aether-engine/internal/combat/combatinitializer"
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// demarrerCombatAvecCompetence démarre un duel U1 (fireball) contre E1 (team2) à la position x
+// La compétence est apprise avant le démarrage: elle fait partie de la configuration enregistrée
+func demarrerCombatAvecCompetence(t *testing.T, xEnnemi int) (*domain.Combat, *domain.Unite, *domain.Unite) {
+	t.Helper()
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 60)
+	ennemi := createTestUnitWithTeam("E1", 50, "team2")
+	position, _ := shared.NewPosition(xEnnemi, 0)
+	ennemi.DeplacerVers(position)
+	if err := heros.AjouterCompetence(createTestSkill("fireball", 10, domain.CompetenceMagie)); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	addUnitToCombat(combat, heros)
+	addUnitToCombat(combat, ennemi)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	demarrerCombatJoueurs(t, combat, heros, ennemi)
+	return combat, heros, ennemi
+}
+
+// allerRetourJSON sérialise puis désérialise les événements comme l'Event Store
+// (version, agrégat et corrélation sont des colonnes, hors du payload)
+func allerRetourJSON(t *testing.T, evenements []domain.Evenement) []domain.Evenement {
+	t.Helper()
+	relus := make([]domain.Evenement, 0, len(evenements))
+	for _, evt := range evenements {
+		payload, err := json.Marshal(evt)
+		if err != nil {
+			t.Fatalf("Erreur de sérialisation de %s: %v", evt.EventType(), err)
+		}
+		relu := reflect.New(reflect.TypeOf(evt).Elem()).Interface().(domain.Evenement)
+		if err := json.Unmarshal(payload, relu); err != nil {
+			t.Fatalf("Erreur de désérialisation de %s: %v", evt.EventType(), err)
+		}
+		relu.SetAggregateID(evt.AggregateID())
+		relu.SetAggregateVersion(evt.AggregateVersion())
+		relu.SetCorrelationID(evt.CorrelationID())
+		relus = append(relus, relu)
+	}
+	return relus
+}
+
+// verifierRechargement vérifie que le combat rechargé reproduit l'état de chaque unité
+func verifierRechargement(t *testing.T, combat, recharge *domain.Combat) {
+	t.Helper()
+	if recharge.Etat() != combat.Etat() || recharge.TourActuel() != combat.TourActuel() || recharge.Version() != len(combat.GetUncommittedEvents()) {
+		t.Errorf("Combat rechargé: état %s tour %d version %d, attendu %s tour %d version %d",
+			recharge.Etat(), recharge.TourActuel(), recharge.Version(),
+			combat.Etat(), combat.TourActuel(), len(combat.GetUncommittedEvents()))
+	}
+	for teamID, equipe := range combat.Equipes() {
+		if len(recharge.Equipes()[teamID].Membres()) != len(equipe.Membres()) {
+			t.Fatalf("L'équipe %s rechargée devrait avoir %d membres", teamID, len(equipe.Membres()))
+		}
+		for _, unite := range equipe.Membres() {
+			rechargee := recharge.TrouverUnite(unite.ID())
+			if !reflect.DeepEqual(rechargee.CapturerEtat(), unite.CapturerEtat()) {
+				t.Errorf("État de %s différent:\n%+v\n%+v", unite.ID(), rechargee.CapturerEtat(), unite.CapturerEtat())
+			}
+		}
+	}
+}
+
+func TestReconstruireDepuisEvenements_AllerRetourActions(t *testing.T) {
+	// Arrange - compétence, déplacement puis attaque de U1; E1 attend à chacun de ses tours
+	combat, heros, ennemi := demarrerCombatAvecCompetence(t, 2)
+	sm := combatfacade.GetStateMachine(combat)
+	actions := []combatfacade.ActionParameters{
+		combatfacade.NewSkillAction(heros.ID(), "fireball", []domain.UnitID{ennemi.ID()}),
+		combatfacade.NewMoveAction(heros.ID(), 1, 0),
+		combatfacade.NewAttackAction(heros.ID(), ennemi.ID()),
+	}
+	for _, action := range actions {
+		for sm.UniteActive() != nil && sm.UniteActive().ID() == ennemi.ID() {
+			if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(ennemi.ID())); err != nil {
+				t.Fatalf("Erreur inattendue: %v", err)
+			}
+		}
+		if _, err := combatfacade.ExecutePlayerActionTyped(combat, action); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(allerRetourJSON(t, combat.GetUncommittedEvents()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	verifierRechargement(t, combat, recharge)
+	if recharge.TrouverUnite(heros.ID()).SkillEstPret("fireball") != heros.SkillEstPret("fireball") {
+		t.Errorf("Le cooldown de fireball devrait être rechargé")
+	}
+	if recharge.Grille().Largeur() != combat.Grille().Largeur() || recharge.Grille().Hauteur() != combat.Grille().Hauteur() {
+		t.Errorf("La grille devrait être rechargée")
+	}
+}
+
+func TestReconstruireDepuisEvenements_AllerRetourFinDeCombat(t *testing.T) {
+	// Arrange - U1 lance fireball dès qu'elle est prête jusqu'à mettre E1 KO, ce qui termine le combat
+	combat, heros, ennemi := demarrerCombatAvecCompetence(t, 2)
+	sm := combatfacade.GetStateMachine(combat)
+	for tour := 0; !ennemi.EstKO(); tour++ {
+		if tour == 20 {
+			t.Fatalf("E1 devrait être KO, HP restants: %d", ennemi.HPActuels())
+		}
+		action := combatfacade.NewWaitAction(sm.UniteActive().ID())
+		if sm.UniteActive().ID() == heros.ID() && heros.SkillEstPret("fireball") {
+			action = combatfacade.NewSkillAction(heros.ID(), "fireball", []domain.UnitID{ennemi.ID()})
+		}
+		if _, err := combatfacade.ExecutePlayerActionTyped(combat, action); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(allerRetourJSON(t, combat.GetUncommittedEvents()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	verifierRechargement(t, combat, recharge)
+	if !recharge.TrouverUnite(ennemi.ID()).EstKO() {
+		t.Errorf("E1 devrait être KO après rechargement")
+	}
+	if recharge.Vainqueur() == nil || *recharge.Vainqueur() != heros.TeamID() {
+		t.Errorf("L'équipe de U1 devrait être victorieuse après rechargement")
+	}
+}
+
+func TestReconstruireDepuisEvenements_AllerRetourStatuts(t *testing.T) {
+	// Arrange - U1 provoque puis fige E1; le Stop s'écoule aux tours de U1 jusqu'à libérer E1
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 60)
+	ennemi := createTestUnitWithTeam("E1", 50, "team2")
+	position, _ := shared.NewPosition(1, 0)
+	ennemi.DeplacerVers(position)
+	provocation, stop := shared.TypeStatutProvocation, shared.TypeStatutStop
+	for _, sort := range []struct {
+		id     domain.CompetenceID
+		statut *shared.TypeStatut
+	}{{"taunt", &provocation}, {"chrono", &stop}} {
+		competence := domain.NewCompetence(sort.id, "Test Skill", "Test skill description", domain.CompetenceMagie, 2,
+			domain.ZoneEffet{}, 5, 0, 1, 0, 1.0, domain.CibleEnnemis)
+		competence.AjouterEffet(domain.NewEffetCompetence(domain.EffetStatut, 0, 2, sort.statut))
+		if err := heros.AjouterCompetence(competence); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+	addUnitToCombat(combat, heros)
+	addUnitToCombat(combat, ennemi)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, heros, ennemi)
+
+	plan := []combatfacade.ActionParameters{
+		combatfacade.NewSkillAction(heros.ID(), "taunt", []domain.UnitID{ennemi.ID()}),
+		combatfacade.NewSkillAction(heros.ID(), "chrono", []domain.UnitID{ennemi.ID()}),
+		combatfacade.NewWaitAction(heros.ID()),
+		combatfacade.NewWaitAction(heros.ID()),
+	}
+	figee := false
+	for _, action := range plan {
+		for sm.UniteActive().ID() == ennemi.ID() {
+			if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(ennemi.ID())); err != nil {
+				t.Fatalf("Erreur inattendue: %v", err)
+			}
+		}
+		if _, err := combatfacade.ExecutePlayerActionTyped(combat, action); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+		figee = figee || ennemi.EstStop()
+	}
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(allerRetourJSON(t, combat.GetUncommittedEvents()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	if !figee || ennemi.EstStop() {
+		t.Fatalf("E1 devrait avoir été figé puis libéré")
+	}
+	verifierRechargement(t, combat, recharge)
+	ennemiRecharge := recharge.TrouverUnite(ennemi.ID())
+	if (combat.CibleForcee(ennemi) == nil) != (recharge.CibleForcee(ennemiRecharge) == nil) {
+		t.Errorf("La provocation de E1 devrait être rechargée")
+	}
+}
+
+func TestReconstruireDepuisEvenements_IncantationEnCours(t *testing.T) {
+	// Arrange - U1 commence à incanter un météore (30 ticks): la charge court encore au tour suivant
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 60)
+	ennemi := createTestUnitWithTeam("E1", 50, "team2")
+	position, _ := shared.NewPosition(2, 0)
+	ennemi.DeplacerVers(position)
+	meteore := createTestSkill("meteore", 10, domain.CompetenceMagie)
+	meteore.SetTempsIncantation(30)
+	if err := heros.AjouterCompetence(meteore); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	addUnitToCombat(combat, heros)
+	addUnitToCombat(combat, ennemi)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	demarrerCombatJoueurs(t, combat, heros, ennemi)
+
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewSkillAction(heros.ID(), "meteore", []domain.UnitID{ennemi.ID()})); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if ennemi.HPActuels() != ennemi.Stats().HP || heros.StatsActuelles().MP != heros.Stats().MP {
+		t.Fatalf("Le météore ne devrait pas être résolu avant la fin de l'incantation")
+	}
+	charges := combat.ChargesATB()
+	if len(charges) != 1 || charges[0].UniteID != heros.ID() || charges[0].CompetenceID != "meteore" || charges[0].Restant <= 0 {
+		t.Fatalf("L'incantation en cours devrait être enregistrée avec les jauges, obtenu: %+v", charges)
+	}
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(allerRetourJSON(t, combat.GetUncommittedEvents()))
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	for _, unite := range []domain.UnitID{heros.ID(), ennemi.ID()} {
+		recharge.TrouverUnite(unite).SetIA(false) // Joueurs ajoutés après la configuration enregistrée
+	}
+	if err := combatinitializer.NewCombatInitializer(recharge).InitializeAll(); err != nil {
+		t.Fatalf("Erreur d'initialisation: %v", err)
+	}
+	sm := combatfacade.GetStateMachine(recharge)
+	if err := sm.Restaurer(*recharge.PositionMachine()); err != nil {
+		t.Fatalf("Erreur à la restauration de la machine: %v", err)
+	}
+
+	// Assert - la charge est rechargée puis résolue par les jauges du combat rechargé
+	if !reflect.DeepEqual(recharge.ChargesATB(), charges) {
+		t.Errorf("Charges rechargées: %+v, attendu: %+v", recharge.ChargesATB(), charges)
+	}
+	ennemiRecharge := recharge.TrouverUnite(ennemi.ID())
+	for tour := 0; ennemiRecharge.HPActuels() == ennemiRecharge.Stats().HP; tour++ {
+		if tour == 20 {
+			t.Fatalf("Le météore aurait dû être résolu")
+		}
+		if _, err := combatfacade.ExecutePlayerActionTyped(recharge, combatfacade.NewWaitAction(sm.UniteActive().ID())); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+	if mp := recharge.TrouverUnite(heros.ID()).StatsActuelles().MP; mp >= heros.Stats().MP {
+		t.Errorf("Les MP du lanceur devraient être consommés à la résolution, obtenu: %d", mp)
+	}
+}
+
+func TestIncantation_ResolutionTermineLeCombat(t *testing.T) {
+	// Arrange - E1 n'a plus que 1 HP: le météore résolu pendant l'attente des jauges le met KO
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 60)
+	ennemi := createTestUnitWithTeam("E1", 50, "team2")
+	position, _ := shared.NewPosition(2, 0)
+	ennemi.DeplacerVers(position)
+	ennemi.RecevoirDegats(ennemi.Stats().HP - 1)
+	meteore := createTestSkill("meteore", 10, domain.CompetenceMagie)
+	meteore.SetTempsIncantation(3)
+	if err := heros.AjouterCompetence(meteore); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	addUnitToCombat(combat, heros)
+	addUnitToCombat(combat, ennemi)
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, heros, ennemi)
+
+	// Act
+	_, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewSkillAction(heros.ID(), "meteore", []domain.UnitID{ennemi.ID()}))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if !ennemi.EstKO() {
+		t.Fatalf("E1 devrait être KO à la résolution du météore")
+	}
+	if sm.GetCurrentState() != "BattleEnded" {
+		t.Errorf("État attendu: BattleEnded, obtenu: %s", sm.GetCurrentState())
+	}
+	if combat.Vainqueur() == nil || *combat.Vainqueur() != heros.TeamID() {
+		t.Errorf("L'équipe de U1 devrait être victorieuse")
+	}
+}
+
+func TestReconstruireDepuisEvenements_AllerRetourSequenceMixte(t *testing.T) {
+	// Arrange - U1 ouvre son tour, se déplace (Stamina), empoisonne puis fige E1 (statut, menace
+	// dans la table de E1 contrôlé par l'IA, Stop), charme E2 et met E3 KO; le Stop et le compte
+	// à rebours de E3 s'écoulent ensuite
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 60)
+	unites := []*domain.Unite{heros}
+	for i, id := range []string{"E1", "E2", "E3"} {
+		ennemi := createTestUnitWithTeam(id, 50, "team2")
+		position, _ := shared.NewPosition(i+1, 1)
+		ennemi.DeplacerVers(position)
+		ennemi.SetIA(id == "E1")
+		unites = append(unites, ennemi)
+	}
+	heros.SetIA(false)
+	poison, stop := shared.TypeStatutPoison, shared.TypeStatutStop
+	for _, sort := range []struct {
+		id     domain.CompetenceID
+		degats int
+		statut *shared.TypeStatut
+	}{{"venin", 10, &poison}, {"chrono", 0, &stop}, {"meteore", 500, nil}} {
+		competence := domain.NewCompetence(sort.id, "Test Skill", "Test skill description", domain.CompetenceMagie, 5,
+			domain.ZoneEffet{}, 5, 0, 1, sort.degats, 1.0, domain.CibleEnnemis)
+		if sort.statut != nil {
+			competence.AjouterEffet(domain.NewEffetCompetence(domain.EffetStatut, 5, 3, sort.statut))
+		}
+		if err := heros.AjouterCompetence(competence); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+	for _, unite := range unites {
+		addUnitToCombat(combat, unite)
+	}
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	e1, e2, e3 := unites[1], unites[2], unites[3]
+
+	combat.DemarrerTourUnite(heros.ID())
+	combat.EntamerTourUnite(heros)
+	destination, _ := shared.NewPosition(1, 0)
+	sequence := []commands.Command{
+		commands.NewMoveCommand(heros, combat, destination),
+		commands.NewSkillCommand(heros, combat, heros.ObtenirCompetence("venin"), []*domain.Unite{e1}),
+		commands.NewSkillCommand(heros, combat, heros.ObtenirCompetence("chrono"), []*domain.Unite{e1}),
+		commands.NewSkillCommand(heros, combat, heros.ObtenirCompetence("meteore"), []*domain.Unite{e3}),
+	}
+	for _, cmd := range sequence {
+		if err := cmd.Validate(); err != nil {
+			t.Fatalf("Commande %s refusée: %v", cmd.GetType(), err)
+		}
+		if _, err := cmd.Execute(); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+	if _, err := combat.AppliquerCharme(heros, e2, 3); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	combat.ConclureActiviteTour(heros.ID())
+	combat.DemarrerTourUnite(e2.ID())
+	combat.EntamerTourUnite(e2)
+	combat.DecompterStop(e2.ID())
+	combat.AvancerCompteAReboursKO(e3)
+	combat.EnregistrerPositionMachine(domain.PositionMachineEtats{Etat: "ActionSelection", UniteID: e2.ID()})
+
+	if heros.StatsActuelles().Stamina == heros.Stats().Stamina || e1.ObtenirStatut(shared.TypeStatutPoison) == nil || !e1.EstStop() ||
+		combat.TableMenace(e1.ID()).Get(heros.ID()) == 0 || combat.EquipeEffective(e2) != heros.TeamID() || !e3.EstKO() {
+		t.Fatalf("La séquence devrait avoir consommé de la Stamina, empoisonné, figé et menacé E1, charmé E2 et mis E3 KO")
+	}
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(allerRetourJSON(t, combat.GetUncommittedEvents()))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	verifierRechargement(t, combat, recharge)
+	e1Recharge, e2Recharge, e3Recharge := recharge.TrouverUnite(e1.ID()), recharge.TrouverUnite(e2.ID()), recharge.TrouverUnite(e3.ID())
+	if menace := recharge.TableMenace(e1.ID()).Get(heros.ID()); menace != combat.TableMenace(e1.ID()).Get(heros.ID()) {
+		t.Errorf("Menace de U1 dans la table de E1 rechargée: %d, attendu %d", menace, combat.TableMenace(e1.ID()).Get(heros.ID()))
+	}
+	if recharge.EquipeEffective(e2Recharge) != combat.EquipeEffective(e2) {
+		t.Errorf("E2 rechargé devrait combattre pour %s", combat.EquipeEffective(e2))
+	}
+	if e1Recharge.ObtenirStatut(shared.TypeStatutStop).Duree() != e1.ObtenirStatut(shared.TypeStatutStop).Duree() {
+		t.Errorf("Le Stop de E1 rechargé devrait avoir la même durée restante")
+	}
+	if e3Recharge.CompteAReboursKO() != e3.CompteAReboursKO() || !e3Recharge.EstKO() {
+		t.Errorf("Compte à rebours de E3 rechargé: %d, attendu %d", e3Recharge.CompteAReboursKO(), e3.CompteAReboursKO())
+	}
+	if !reflect.DeepEqual(recharge.PositionMachine(), combat.PositionMachine()) || recharge.ActiviteTour() != nil {
+		t.Errorf("La position de la machine et l'activité du tour devraient être rechargées")
+	}
+}
+
+func TestReconstruireDepuisEvenements_AnnulerRefaireEntrainement(t *testing.T) {
+	// Arrange - entraînement: U1 se déplace, E1 attend, U1 attaque; deux annulations puis un redo
+	combat := createTestCombat()
+	heros := createTestUnit("U1", 60)
+	ennemi := createTestUnitWithTeam("E1", 50, "team2")
+	position, _ := shared.NewPosition(2, 0)
+	ennemi.DeplacerVers(position)
+	addUnitToCombat(combat, heros)
+	addUnitToCombat(combat, ennemi)
+	if err := combat.SetModeEntrainement(true); err != nil {
+		t.Fatalf("Erreur de configuration: %v", err)
+	}
+	if err := combat.Demarrer(); err != nil {
+		t.Fatalf("Erreur au démarrage du combat: %v", err)
+	}
+	sm := demarrerCombatJoueurs(t, combat, heros, ennemi)
+	for _, action := range []combatfacade.ActionParameters{
+		combatfacade.NewMoveAction(heros.ID(), 1, 0),
+		combatfacade.NewWaitAction(ennemi.ID()),
+		combatfacade.NewAttackAction(heros.ID(), ennemi.ID()),
+	} {
+		if _, err := combatfacade.ExecutePlayerActionTyped(combat, action); err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+	for _, err := range []error{combatfacade.UndoLastCommand(combat), combatfacade.UndoLastCommand(combat), combatfacade.RedoLastCommand(combat)} {
+		if err != nil {
+			t.Fatalf("Erreur inattendue: %v", err)
+		}
+	}
+	if sm.UniteActive() == nil || sm.UniteActive().ID() != heros.ID() || ennemi.HPActuels() != ennemi.Stats().HP {
+		t.Fatalf("L'attaque devrait être annulée et la main revenue à U1, état: %s", sm.GetCurrentState())
+	}
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(allerRetourJSON(t, combat.GetUncommittedEvents()))
+
+	// Assert - l'attaque annulée reste refaisable, le déplacement et l'attente sont annulables
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	verifierRechargement(t, combat, recharge)
+	if !recharge.PeutAnnulerAction() || !recharge.PeutRetablirAction() {
+		t.Errorf("Les actions annulables et refaisables devraient être rechargées")
+	}
+	if err := recharge.RetablirAction(); err != nil {
+		t.Fatalf("Erreur lors du redo sur le combat rechargé: %v", err)
+	}
+	if hp := recharge.TrouverUnite(ennemi.ID()).HPActuels(); hp == ennemi.Stats().HP {
+		t.Errorf("L'attaque refaite sur le combat rechargé devrait toucher E1")
+	}
+
+	// Une nouvelle action invalide l'attaque annulée, y compris après rechargement
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(heros.ID())); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	recharge, err = domain.ReconstruireDepuisEvenements(allerRetourJSON(t, combat.GetUncommittedEvents()))
+	if err != nil {
+		t.Fatalf("Erreur au rechargement: %v", err)
+	}
+	verifierRechargement(t, combat, recharge)
+	if combat.PeutRetablirAction() || recharge.PeutRetablirAction() || !recharge.PeutAnnulerAction() {
+		t.Errorf("Une nouvelle action devrait invalider les actions annulées")
+	}
+}
@@ -1,8 +1,13 @@
 package step_c_patterns_test
 
 import (
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/states"
 	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
@@ -94,6 +99,345 @@ func TestATBSystem_TickAndGauges(t *testing.T) {
 	}
 }
 
+// Test de l'ordre d'initiative - départage SPD, excédent, puis ID
+func TestATBSystem_InitiativeOrder(t *testing.T) {
+	// Arrange - Même vitesse de remplissage (5), SPD différentes
+	lent := createTestUnit("B", 55)
+	rapide1 := createTestUnit("C", 59)
+	rapide2 := createTestUnit("A", 59)
+
+	atb := states.NewATBSystem()
+	atb.InitializeUnitGauge(lent)
+	atb.InitializeUnitGauge(rapide1)
+	atb.InitializeUnitGauge(rapide2)
+
+	// Act
+	for i := 0; i < 20; i++ {
+		atb.Tick()
+	}
+	readyUnits := atb.GetReadyUnits()
+
+	// Assert
+	expected := []domain.UnitID{"A", "C", "B"}
+	if len(readyUnits) != len(expected) {
+		t.Fatalf("Unités prêtes attendues: %d, obtenues: %d", len(expected), len(readyUnits))
+	}
+	for i, id := range expected {
+		if readyUnits[i] != id {
+			t.Errorf("Position %d: attendu %s, obtenu %s", i, id, readyUnits[i])
+		}
+	}
+
+	// Arrange - SPD inconnue: l'excédent départage avant l'ID
+	atb = states.NewATBSystem()
+	atb.InitializeGauge("U1", 34) // 3 ticks: 102 → excédent 2
+	atb.InitializeGauge("U2", 30) // 4 ticks: 120 → excédent 20
+
+	// Act
+	for i := 0; i < 4; i++ {
+		atb.Tick()
+	}
+
+	// Assert
+	next, ok := atb.NextReadyUnit()
+	if !ok || next != "U2" {
+		t.Errorf("U2 (plus grand excédent) devrait jouer en premier, obtenu %s", next)
+	}
+}
+
+// Test de la prévision d'ordre des tours avec action chargée
+func TestATBSystem_ForecastIncludesCharges(t *testing.T) {
+	// Arrange
+	atb := states.NewATBSystem()
+	atb.InitializeGauge("U1", 50)
+	atb.InitializeGauge("U2", 25)
+	atb.StartCharge("U2", "Météore", 3)
+
+	// Act
+	forecast := atb.Forecast(5)
+
+	// Assert
+	expected := []struct {
+		unitID domain.UnitID
+		typ    states.ForecastType
+		ticks  int
+	}{
+		{"U1", states.ForecastTurn, 2},
+		{"U2", states.ForecastCharge, 3},
+		{"U1", states.ForecastTurn, 4},
+		{"U2", states.ForecastTurn, 4},
+		{"U1", states.ForecastTurn, 6},
+	}
+	if len(forecast) != len(expected) {
+		t.Fatalf("Entrées attendues: %d, obtenues: %d", len(expected), len(forecast))
+	}
+	for i, e := range expected {
+		entree := forecast[i]
+		if entree.Rank != i+1 || entree.UnitID != e.unitID || entree.Type != e.typ || entree.Ticks != e.ticks {
+			t.Errorf("Entrée %d: attendu %s/%s@%d, obtenu %+v", i, e.unitID, e.typ, e.ticks, entree)
+		}
+	}
+	if forecast[1].Label != "Météore" {
+		t.Errorf("Libellé de la charge attendu: Météore, obtenu: %s", forecast[1].Label)
+	}
+
+	// La simulation ne doit pas modifier le système réel
+	if atb.GetGaugeValue("U1") != 0 || len(atb.GetResolvedCharges()) != 0 {
+		t.Errorf("La prévision ne doit pas modifier l'état ATB")
+	}
+}
+
+// Test de l'avance des jauges quand toutes les unités sont figées (Stop) avec une incantation en cours
+func TestATBSystem_AdvanceUntilReadyAllStopped(t *testing.T) {
+	// Arrange
+	atb := states.NewATBSystem()
+	for _, id := range []string{"U1", "U2"} {
+		unit := createTestUnit(id, 50)
+		unit.AjouterStatut(shared.NewStatut(shared.TypeStatutStop, 2, 0))
+		atb.InitializeUnitGauge(unit)
+	}
+	atb.StartCharge("U1", "Météore", 3)
+
+	// Act
+	err := atb.AdvanceUntilReady()
+
+	// Assert - l'incantation est suspendue avec son lanceur: plus rien ne progresse
+	if err == nil {
+		t.Fatalf("Une erreur est attendue quand aucune jauge ni incantation ne progresse")
+	}
+	if charges := atb.SnapshotCharges(); len(charges) != 1 || charges[0].Restant != 3 {
+		t.Errorf("L'incantation d'une unité figée ne devrait pas progresser, obtenu: %+v", charges)
+	}
+}
+
+// Test de la sauvegarde et restauration des incantations en cours
+func TestATBSystem_SnapshotRestoreCharges(t *testing.T) {
+	// Arrange
+	meteore := createTestSkill("meteore", 10, domain.CompetenceMagie)
+	meteore.SetTempsIncantation(4)
+	atb := states.NewATBSystem()
+	atb.InitializeUnitGauge(createTestUnit("U1", 60))
+	atb.StartSkillCharge("U1", meteore, []domain.UnitID{"E1"})
+	atb.Tick()
+
+	// Act
+	restored := states.NewATBSystem()
+	restored.Restore(atb.Snapshot())
+	restored.RestoreCharges(atb.SnapshotCharges())
+
+	// Assert
+	charges := restored.SnapshotCharges()
+	if len(charges) != 1 || charges[0].CompetenceID != "meteore" || charges[0].Restant != 3 ||
+		len(charges[0].Cibles) != 1 || charges[0].Cibles[0] != "E1" {
+		t.Errorf("Incantation restaurée inattendue: %+v", charges)
+	}
+	if err := restored.AdvanceUntilReady(); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	if resolues := restored.GetResolvedCharges(); len(resolues) != 1 || resolues[0].SkillID != "meteore" {
+		t.Errorf("L'incantation restaurée devrait arriver à terme avant le premier tour")
+	}
+}
+
+// Test du recalcul de vitesse ATB - Hâte, Lenteur et Stop
+func TestATBSystem_SpeedStatuses(t *testing.T) {
+	// Arrange
+	unit := createTestUnit("U1", 50) // Speed de remplissage: 5 par tick
+	atb := states.NewATBSystem()
+	atb.InitializeUnitGauge(unit)
+
+	// Act & Assert - Hâte
+	unit.AjouterStatut(shared.NewStatut(shared.TypeStatutHate, 3, 0))
+	atb.SyncUnitSpeed(unit)
+	if speed := atb.Snapshot()[0].Vitesse; speed != 7 {
+		t.Errorf("Vitesse sous Hâte attendue: 7, obtenue: %d", speed)
+	}
+
+	// Act & Assert - Lenteur
+	unit.RetirerStatut(shared.TypeStatutHate)
+	unit.AjouterStatut(shared.NewStatut(shared.TypeStatutLenteur, 3, 0))
+	atb.SyncUnitSpeed(unit)
+	if speed := atb.Snapshot()[0].Vitesse; speed != 2 {
+		t.Errorf("Vitesse sous Lenteur attendue: 2, obtenue: %d", speed)
+	}
+
+	// Act & Assert - Stop: la jauge pleine ne donne pas de tour et ne progresse plus
+	for i := 0; i < 50; i++ {
+		atb.Tick()
+	}
+	unit.AjouterStatut(shared.NewStatut(shared.TypeStatutStop, 2, 0))
+	atb.SyncUnitSpeed(unit)
+	if len(atb.GetReadyUnits()) != 0 {
+		t.Errorf("Une unité figée ne devrait pas être prête")
+	}
+	if atb.CanProgress() {
+		t.Errorf("Aucune jauge ne devrait progresser quand la seule unité est figée")
+	}
+
+	// Act & Assert - Fin du Stop
+	unit.RetirerStatut(shared.TypeStatutStop)
+	atb.SyncUnitSpeed(unit)
+	if next, ok := atb.NextReadyUnit(); !ok || next != unit.ID() {
+		t.Errorf("L'unité libérée devrait être prête")
+	}
+}
+
+// Test de la sauvegarde et restauration de l'état des jauges
+func TestATBSystem_SnapshotRestore(t *testing.T) {
+	// Arrange
+	atb := states.NewATBSystem()
+	atb.InitializeUnitGauge(createTestUnit("U1", 60))
+	atb.InitializeUnitGauge(createTestUnit("U2", 40))
+	for i := 0; i < 7; i++ {
+		atb.Tick()
+	}
+
+	// Act
+	restored := states.NewATBSystem()
+	restored.Restore(atb.Snapshot())
+
+	// Assert
+	if restored.GetGaugeValue("U1") != atb.GetGaugeValue("U1") || restored.GetGaugeValue("U2") != atb.GetGaugeValue("U2") {
+		t.Errorf("Les jauges restaurées devraient être identiques")
+	}
+	original, copie := atb.Forecast(4), restored.Forecast(4)
+	for i := range original {
+		if original[i] != copie[i] {
+			t.Errorf("Prévision %d divergente: %+v / %+v", i, original[i], copie[i])
+		}
+	}
+}
+
+// Test de la part de jauge conservée en fin de tour et de son effet sur la prévision
+func TestATBSystem_RetainGaugeForecast(t *testing.T) {
+	// Arrange - U1 (vitesse 5) vient de jouer, U2 (vitesse 4) est à 80
+	atb := states.NewATBSystem()
+	atb.InitializeUnitGauge(createTestUnit("U1", 50))
+	atb.InitializeUnitGauge(createTestUnit("U2", 40))
+	for i := 0; i < 20; i++ {
+		atb.Tick()
+	}
+	atb.EndTurn("U1")
+
+	// Act - U1 a attendu (40% conservés), les tours simulés conservent 20%
+	atb.RetainGauge("U1", 40)
+	atb.SetForecastRetention(20)
+	forecast := atb.Forecast(3)
+
+	// Assert - Sans la part conservée, U1 ne rejouerait qu'au tick 20
+	if atb.GetGaugeValue("U1") != 40 {
+		t.Errorf("Jauge attendue après attente: 40, obtenue: %d", atb.GetGaugeValue("U1"))
+	}
+	assertForecastOrder(t, forecast, []domain.UnitID{"U2", "U1", "U2"}, []int{5, 12, 25})
+}
+
+// Test de TurnEnd: la jauge de l'unité repart de la part conservée selon son activité
+func TestTurnEndState_RetainsGaugeShare(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	addUnitToCombat(combat, unit)
+
+	sm := states.NewCombatStateMachine(combat)
+	sm.Context().ATBSystem.InitializeUnitGauge(unit)
+	combat.OuvrirActiviteTour(unit.ID())
+
+	// Act - L'unité termine son tour sans se déplacer ni agir
+	if err := sm.TransitionTo(states.NewTurnEndState()); err != nil {
+		t.Fatalf("Erreur lors de la transition vers TurnEnd: %v", err)
+	}
+
+	// Assert
+	if value := sm.Context().ATBSystem.GetGaugeValue(unit.ID()); value != domain.ConservationJaugeAttente {
+		t.Errorf("Jauge attendue: %d, obtenue: %d", domain.ConservationJaugeAttente, value)
+	}
+	if combat.ActiviteTour() != nil {
+		t.Errorf("L'activité du tour devrait être close")
+	}
+	conclu := false
+	for _, evt := range combat.GetUncommittedEvents() {
+		if e, ok := evt.(*domain.ActiviteTourConclueEvent); ok {
+			conclu = e.Conservation == domain.ConservationJaugeAttente
+		}
+	}
+	if !conclu {
+		t.Errorf("L'événement ActiviteTourConclue devrait indiquer %d%% conservés", domain.ConservationJaugeAttente)
+	}
+}
+
+// Test de l'ordonnanceur CTB - tourniquet strict par SPD
+func TestRoundScheduler_CTB(t *testing.T) {
+	// Arrange
+	scheduler := states.NewCTBScheduler()
+	scheduler.InitializeUnit(createTestUnitWithTeam("A", 30, "team1"))
+	scheduler.InitializeUnit(createTestUnitWithTeam("B", 50, "team1"))
+	scheduler.InitializeUnit(createTestUnitWithTeam("C", 70, "team2"))
+
+	// Act
+	forecast := scheduler.Forecast(6)
+
+	// Assert
+	expected := []domain.UnitID{"C", "B", "A", "C", "B", "A"}
+	assertForecastOrder(t, forecast, expected, []int{0, 0, 0, 1, 1, 1})
+}
+
+// Test de l'ordonnanceur à phases d'équipes - toute l'équipe joue avant l'équipe suivante
+func TestRoundScheduler_TeamPhase(t *testing.T) {
+	// Arrange
+	scheduler := states.NewTeamPhaseScheduler()
+	scheduler.InitializeUnit(createTestUnitWithTeam("A", 30, "team1"))
+	scheduler.InitializeUnit(createTestUnitWithTeam("B", 50, "team1"))
+	scheduler.InitializeUnit(createTestUnitWithTeam("C", 70, "team2"))
+
+	// Act
+	if err := scheduler.AdvanceUntilReady(); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	first, _ := scheduler.NextReadyUnit()
+	scheduler.EndTurn(first)
+	scheduler.DeactivateUnit("A")
+	forecast := scheduler.Forecast(3)
+
+	// Assert - B ouvre la phase de team1, A mort: team2 enchaîne
+	if first != "B" {
+		t.Errorf("Premier tour attendu: B, obtenu: %s", first)
+	}
+	assertForecastOrder(t, forecast, []domain.UnitID{"C", "B", "C"}, []int{1, 2, 3})
+}
+
+// Test du choix de l'ordonnanceur selon le mode du combat
+func TestStateMachine_SchedulerFollowsCombatMode(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	if err := combat.SetModeTour(domain.ModeTourCTB); err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+
+	// Act
+	sm := states.NewCombatStateMachine(combat)
+
+	// Assert
+	if mode := sm.Context().Scheduler.Mode(); mode != domain.ModeTourCTB {
+		t.Errorf("Mode attendu: CTB, obtenu: %s", mode)
+	}
+	if mode := states.NewCombatStateMachine(createTestCombat()).Context().Scheduler.Mode(); mode != domain.ModeTourATB {
+		t.Errorf("Mode par défaut attendu: ATB, obtenu: %s", mode)
+	}
+}
+
+// assertForecastOrder vérifie les unités et les ticks d'une prévision
+func assertForecastOrder(t *testing.T, forecast []states.TurnForecast, units []domain.UnitID, ticks []int) {
+	t.Helper()
+	if len(forecast) != len(units) {
+		t.Fatalf("Entrées attendues: %d, obtenues: %d", len(units), len(forecast))
+	}
+	for i := range units {
+		if forecast[i].UnitID != units[i] || forecast[i].Ticks != ticks[i] {
+			t.Errorf("Entrée %d: attendu %s@%d, obtenu %s@%d", i, units[i], ticks[i], forecast[i].UnitID, forecast[i].Ticks)
+		}
+	}
+}
+
 // Test de transitions multiples
 func TestStateMachine_MultipleTransitions(t *testing.T) {
 	// Arrange
@@ -193,6 +537,235 @@ func TestActionSelectionState_Transitions(t *testing.T) {
 	}
 }
 
+// Test d'ActionSelectionState avec Berserk (la commande du joueur est remplacée)
+func TestActionSelectionState_BerserkOverridesInput(t *testing.T) {
+	// Arrange - U1 en Berserk, E1 au contact
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, unit)
+	addUnitToCombat(combat, enemy)
+	unit.AjouterStatut(shared.NewStatut(shared.TypeStatutBerserk, 2, 10))
+
+	sm := states.NewCombatStateMachine(combat)
+	if err := sm.TransitionTo(states.NewActionSelectionState(unit)); err != nil {
+		t.Fatalf("Erreur lors de la transition vers ActionSelection: %v", err)
+	}
+
+	// Act - Le joueur demande d'attendre
+	sm.HandleEvent(states.StateEvent{
+		Type: states.EventCommandSelected,
+		Data: commands.NewWaitCommand(unit, combat),
+	})
+
+	// Assert - L'attaque sur l'ennemi le plus proche est imposée et tracée
+	cmd, ok := sm.Context().PendingCommand.(commands.Command)
+	if !ok || cmd.GetType() != commands.CommandTypeAttack {
+		t.Fatalf("Une attaque devrait être imposée par le Berserk")
+	}
+	found := false
+	for _, evt := range combat.GetUncommittedEvents() {
+		if imposee, ok := evt.(*domain.ActionImposeeEvent); ok && imposee.CibleID == enemy.ID() {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("L'événement ActionImposee devrait être levé")
+	}
+}
+
+// Test d'ActionSelectionState avec Berserk hors de portée (l'unité se rapproche puis attaque)
+func TestActionSelectionState_BerserkApprochesDistantEnemy(t *testing.T) {
+	// Arrange - U1 en Berserk, E1 à 3 cases (déplacement de 5)
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(3, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, unit)
+	addUnitToCombat(combat, enemy)
+	unit.AjouterStatut(shared.NewStatut(shared.TypeStatutBerserk, 2, 10))
+
+	sm := states.NewCombatStateMachine(combat)
+
+	// Act
+	if err := sm.TransitionTo(states.NewActionSelectionState(unit)); err != nil {
+		t.Fatalf("Erreur lors de la transition vers ActionSelection: %v", err)
+	}
+	sm.HandleEvent(states.StateEvent{Type: states.EventCommandSelected})
+
+	// Assert - déplacement au contact puis attaque, validés ensemble
+	composite, ok := sm.Context().PendingCommand.(*commands.CompositeCommand)
+	if !ok {
+		t.Fatalf("Un déplacement suivi d'une attaque devrait être imposé, obtenu: %T", sm.Context().PendingCommand)
+	}
+	steps := composite.GetSteps()
+	if len(steps) != 2 || steps[0].GetType() != commands.CommandTypeMove || steps[1].GetType() != commands.CommandTypeAttack {
+		t.Fatalf("Étapes attendues: MOVE puis ATTACK")
+	}
+	if destination := steps[0].(*commands.MoveCommand).GetTargetPosition(); destination.Distance(enemyPos) != 1 {
+		t.Errorf("U1 devrait s'arrêter au contact de E1, obtenu (%d,%d)", destination.X(), destination.Y())
+	}
+	if err := sm.Context().ValidationError; err != nil {
+		t.Errorf("L'action imposée devrait être valide: %v", err)
+	}
+}
+
+// Test d'ActionSelectionState avec Berserk trop loin pour attaquer (l'unité se rapproche seulement)
+func TestActionSelectionState_BerserkMovesTowardUnreachableEnemy(t *testing.T) {
+	// Arrange - E1 à 18 cases
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(9, 9)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, unit)
+	addUnitToCombat(combat, enemy)
+	unit.AjouterStatut(shared.NewStatut(shared.TypeStatutBerserk, 2, 10))
+
+	sm := states.NewCombatStateMachine(combat)
+
+	// Act
+	if err := sm.TransitionTo(states.NewActionSelectionState(unit)); err != nil {
+		t.Fatalf("Erreur lors de la transition vers ActionSelection: %v", err)
+	}
+
+	// Assert - la case atteinte est la plus proche possible de E1
+	move, ok := sm.Context().PendingCommand.(*commands.MoveCommand)
+	if !ok {
+		t.Fatalf("Un déplacement vers E1 devrait être imposé, obtenu: %T", sm.Context().PendingCommand)
+	}
+	if distance := move.GetTargetPosition().Distance(enemyPos); distance != 18-unit.PorteeDeplacement() {
+		t.Errorf("Distance restante attendue: %d, obtenue: %d", 18-unit.PorteeDeplacement(), distance)
+	}
+}
+
+// Test de la Confusion - le type d'action est tiré au hasard, comme la cible
+func TestActionSelectionState_ConfusionDrawsActionType(t *testing.T) {
+	// Arrange - U1 confus, un allié au contact
+	tirees := make(map[commands.CommandType]bool)
+	for graine := int64(0); graine < 30; graine++ {
+		combat := createTestCombat()
+		combat.SetGraineAleatoire(graine)
+		unit := createTestUnit("U1", 50)
+		ally := createTestUnit("U2", 50)
+		allyPos, _ := shared.NewPosition(1, 0)
+		ally.DeplacerVers(allyPos)
+		addUnitToCombat(combat, unit)
+		addUnitToCombat(combat, ally)
+		unit.AjouterStatut(shared.NewStatut(shared.TypeStatutConfusion, 2, 0))
+
+		sm := states.NewCombatStateMachine(combat)
+
+		// Act
+		if err := sm.TransitionTo(states.NewActionSelectionState(unit)); err != nil {
+			t.Fatalf("Erreur lors de la transition vers ActionSelection: %v", err)
+		}
+
+		cmd, ok := sm.Context().PendingCommand.(commands.Command)
+		if !ok {
+			t.Fatalf("Une action devrait être imposée par la Confusion")
+		}
+		tirees[cmd.GetType()] = true
+	}
+
+	// Assert
+	for _, typ := range []commands.CommandType{commands.CommandTypeAttack, commands.CommandTypeMove, commands.CommandTypeWait} {
+		if !tirees[typ] {
+			t.Errorf("Le type d'action %s devrait pouvoir être tiré", typ)
+		}
+	}
+}
+
+// Test du délai de tour - l'expiration confie le tour à l'IA et lève TourExpire
+func TestActionSelectionState_TimeoutPlaysDefaultAction(t *testing.T) {
+	// Arrange - Joueur U1 avec 30s par tour, E1 au contact
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	enemy := createTestUnitWithTeam("E1", 50, "team2")
+	enemyPos, _ := shared.NewPosition(1, 0)
+	enemy.DeplacerVers(enemyPos)
+	addUnitToCombat(combat, unit)
+	addUnitToCombat(combat, enemy)
+	unit.SetIA(false)
+	combat.SetReglesDelaiTour(&domain.ReglesDelaiTour{Duree: 30 * time.Second, ActionParDefaut: domain.ActionExpirationIA})
+
+	debut := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	sm := states.NewCombatStateMachine(combat)
+	sm.Context().Clock = func() time.Time { return debut }
+	if err := sm.TransitionTo(states.NewActionSelectionState(unit)); err != nil {
+		t.Fatalf("Erreur lors de la transition vers ActionSelection: %v", err)
+	}
+	echeance := combat.EcheanceTour()
+	if echeance == nil || !echeance.Echeance.Equal(debut.Add(30*time.Second)) {
+		t.Fatalf("Une échéance à +30s devrait être fixée, obtenu: %+v", echeance)
+	}
+
+	// Act - Le minuteur déclenche l'expiration
+	err := sm.HandleEvent(states.StateEvent{Type: states.EventTimeout, Data: unit.ID()})
+
+	// Assert - L'IA joue à la place du joueur
+	if err != nil {
+		t.Fatalf("Erreur inattendue: %v", err)
+	}
+	cmd, ok := sm.Context().PendingCommand.(commands.Command)
+	if !ok || cmd.GetType() != commands.CommandTypeAttack {
+		t.Fatalf("L'IA devrait attaquer l'ennemi au contact")
+	}
+	if combat.EcheanceTour() != nil {
+		t.Errorf("L'échéance devrait être levée après expiration")
+	}
+	found := false
+	for _, evt := range combat.GetUncommittedEvents() {
+		if expire, ok := evt.(*domain.TourExpireEvent); ok && expire.UniteID == unit.ID() && expire.ActionAppliquee == domain.ActionExpirationIA {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("L'événement TourExpire devrait être levé")
+	}
+}
+
+// Test du délai de tour - une action choisie à temps lève l'échéance
+func TestActionSelectionState_ActionInTimeClearsDeadline(t *testing.T) {
+	// Arrange
+	combat := createTestCombat()
+	unit := createTestUnit("U1", 50)
+	addUnitToCombat(combat, unit)
+	unit.SetIA(false)
+	combat.SetReglesDelaiTour(&domain.ReglesDelaiTour{Duree: time.Minute, ActionParDefaut: domain.ActionExpirationAttente})
+
+	sm := states.NewCombatStateMachine(combat)
+	if err := sm.TransitionTo(states.NewActionSelectionState(unit)); err != nil {
+		t.Fatalf("Erreur lors de la transition vers ActionSelection: %v", err)
+	}
+
+	// Act
+	sm.HandleEvent(states.StateEvent{
+		Type: states.EventCommandSelected,
+		Data: commands.NewWaitCommand(unit, combat),
+	})
+
+	// Assert
+	if combat.EcheanceTour() != nil {
+		t.Errorf("L'échéance devrait être levée quand le joueur agit à temps")
+	}
+	levee := false
+	for _, evt := range combat.GetUncommittedEvents() {
+		switch evt.(type) {
+		case *domain.EcheanceTourLeveeEvent:
+			levee = true
+		case *domain.TourExpireEvent:
+			t.Errorf("Aucun TourExpire ne devrait être levé")
+		}
+	}
+	if !levee {
+		t.Errorf("L'événement EcheanceTourLevee devrait être levé")
+	}
+}
+
 // Test de StunnedState (unité étourdie saute son tour)
 func TestStunnedState_SkipTurn(t *testing.T) {
 	// Arrange
@@ -272,17 +845,15 @@ func TestWaitingATBState_NextUnitReady(t *testing.T) {
 
 	sm := states.NewCombatStateMachine(combat)
 
-	// Configurer ATB
-	atb := states.NewATBSystem()
-	atb.InitializeGauge(unit1.ID(), 50)
-	atb.InitializeGauge(unit2.ID(), 100)
-
-	// Faire avancer les gauges
-	for i := 0; i < 10; i++ {
-		atb.Tick()
+	// Enregistrer les unités dans l'ordonnanceur du contexte (jauges ATB à 0)
+	scheduler := sm.Context().Scheduler
+	scheduler.InitializeUnit(unit1)
+	scheduler.InitializeUnit(unit2)
+	if _, ok := scheduler.NextReadyUnit(); ok {
+		t.Fatalf("Aucune unité ne devrait être prête avant WaitingATB")
 	}
 
-	// Act - Transitionner vers WaitingATB
+	// Act - Transitionner vers WaitingATB (les jauges progressent jusqu'à une unité prête)
 	waitingState := states.NewWaitingATBState()
 	err := sm.TransitionTo(waitingState)
 
@@ -294,9 +865,13 @@ func TestWaitingATBState_NextUnitReady(t *testing.T) {
 		t.Errorf("État attendu: WaitingATB, obtenu: %s", sm.CurrentState().Name())
 	}
 
-	// Vérifier que les unités prêtes sont détectées
-	if len(atb.GetReadyUnits()) == 0 {
-		t.Skip("Aucune unité prête après 10 ticks (peut varier selon l'implémentation)")
+	// L'unité la plus rapide est prête la première
+	unitID, ok := scheduler.NextReadyUnit()
+	if !ok {
+		t.Fatalf("Une unité devrait être prête en sortie de WaitingATB")
+	}
+	if unitID != unit2.ID() {
+		t.Errorf("Unité prête attendue: %s, obtenue: %s", unit2.ID(), unitID)
 	}
 }
 
@@ -351,3 +926,79 @@ func TestFailedState_ErrorHandling(t *testing.T) {
 }
 
 // Helpers définis dans helpers_test.go
+
+// Test de la table des transitions: aucune incohérence et les états en dérivent
+func TestTransitionTable_IsConsistent(t *testing.T) {
+	// Act
+	rapport := states.VerifierMachineEtats()
+
+	// Assert
+	if !rapport.Valide() {
+		t.Fatalf("La table des transitions devrait être valide: %s", rapport)
+	}
+	if len(states.EtatsCombat()) != 20 {
+		t.Errorf("20 états attendus, obtenu: %d", len(states.EtatsCombat()))
+	}
+	validating := states.NewValidatingState()
+	if !validating.CanTransitionTo("ActionRejected") || validating.CanTransitionTo("TurnEnd") {
+		t.Errorf("Les transitions de Validating devraient venir de la table")
+	}
+	if states.NewFinalizingState().CanTransitionTo("Idle") {
+		t.Errorf("Finalizing est terminal")
+	}
+}
+
+// Test du vérificateur sur une table défectueuse
+func TestVerifierTransitions_DetectsDefects(t *testing.T) {
+	// Arrange - B boucle sur C sans atteindre Fin, D n'est jamais atteint, E n'a aucune sortie
+	table := []states.Transition{
+		{From: "A", Event: states.EventStartBattle, To: "B"},
+		{From: "A", Event: states.EventSetupComplete, To: "Fin"},
+		{From: "A", Event: states.EventWait, To: "E"},
+		{From: "B", Event: states.EventTurnComplete, To: "C"},
+		{From: "C", Event: states.EventNextUnitReady, To: "B"},
+		{From: "D", Event: states.EventSkipTurn, To: "Fin"},
+		{From: "A", Event: states.EventTimeout, To: "X"},
+	}
+
+	// Act
+	rapport := states.VerifierTransitions(table, "A", []string{"A", "B", "C", "D", "E", "Fin"}, []string{"Fin"})
+
+	// Assert
+	if rapport.Valide() {
+		t.Fatalf("La table devrait être invalide")
+	}
+	assertEtats(t, "inconnus", rapport.EtatsInconnus, "X")
+	assertEtats(t, "inatteignables", rapport.EtatsInatteignables, "D")
+	assertEtats(t, "sans événement", rapport.EtatsSansEvenement, "E")
+	assertEtats(t, "impasses", rapport.ImpassesSansIssue, "B", "C")
+}
+
+// Test anti-dérive: les diagrammes de doc/diagrammes_etats correspondent au code
+func TestExporterDiagrams_MatchDocumentation(t *testing.T) {
+	attendus := map[string]string{
+		"../../diagrammes_etats/machine_combat.md":  states.DocumentMachineEtats(),
+		"../../diagrammes_etats/machine_combat.dot": states.ExporterDOT(),
+	}
+
+	for chemin, attendu := range attendus {
+		contenu, err := os.ReadFile(chemin)
+		if err != nil {
+			t.Fatalf("Lecture de %s: %v", chemin, err)
+		}
+		if string(contenu) != attendu {
+			t.Errorf("%s n'est plus à jour, relancer: go run ./cmd/diagrammes-etats", chemin)
+		}
+	}
+	if !strings.Contains(states.ExporterMermaid(), "Validating --> ActionRejected : VALIDATION_FAILED") {
+		t.Errorf("Le diagramme Mermaid devrait contenir les transitions de la table")
+	}
+}
+
+// assertEtats vérifie la liste d'états d'un rapport
+func assertEtats(t *testing.T, libelle string, obtenus []string, attendus ...string) {
+	t.Helper()
+	if strings.Join(obtenus, ",") != strings.Join(attendus, ",") {
+		t.Errorf("États %s attendus: %v, obtenu: %v", libelle, attendus, obtenus)
+	}
+}
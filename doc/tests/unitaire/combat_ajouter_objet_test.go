@@ -3,6 +3,7 @@ package unitaire
 import (
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,9 +12,11 @@ func TestCombat_AjouterObjet(t *testing.T) {
 	// Arrange
 	combat := newTestCombat("combat-1")
 
-	// Act - Méthode void, ne devrait pas crasher
-	combat.AjouterObjet("potion-2", 5)
+	// Act
+	combat.AjouterObjet(domain.TeamID("team-1"), domain.ObjetEther, 5)
+	combat.AjouterObjet(domain.TeamID("team-1"), domain.ObjetEther, 2)
 
 	// Assert
-	assert.NotNil(t, combat, "Le combat devrait toujours exister")
+	assert.Equal(t, 7, combat.ObtenirQuantiteObjet(domain.TeamID("team-1"), domain.ObjetEther))
+	assert.Empty(t, combat.GetUncommittedEvents(), "L'ajout de setup ne lève pas d'événement")
 }
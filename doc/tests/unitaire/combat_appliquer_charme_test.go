@@ -0,0 +1,49 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_AppliquerCharme teste que l'unité charmée combat pour l'équipe du charmeur
+func TestCombat_AppliquerCharme(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	sorciere := newTestUnite("sorciere", "Sorcière", "team-2", 5, 5)
+	guerrier := newTestUnite("guerrier", "Guerrier", "team-1", 5, 6)
+	mage := newTestUnite("mage", "Mage", "team-1", 5, 7)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(sorciere)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(guerrier)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(mage)
+
+	// Act
+	statut, err := combat.AppliquerCharme(sorciere, guerrier, 2)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, statut)
+	assert.Equal(t, domain.TeamID("team-2"), combat.EquipeEffective(guerrier))
+	assert.True(t, combat.SontEnnemis(guerrier, mage), "Le guerrier charmé devrait combattre son allié")
+	assert.False(t, combat.SontEnnemis(guerrier, sorciere))
+	assert.Equal(t, mage.ID(), combat.ChoisirCibleIA(guerrier).ID())
+}
+
+// TestCombat_AppliquerCharme_Expire teste que l'unité retrouve son camp à la fin du Charme
+func TestCombat_AppliquerCharme_Expire(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	sorciere := newTestUnite("sorciere", "Sorcière", "team-2", 5, 5)
+	guerrier := newTestUnite("guerrier", "Guerrier", "team-1", 5, 6)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(sorciere)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(guerrier)
+	statut, _ := combat.AppliquerCharme(sorciere, guerrier, 2)
+
+	// Act
+	guerrier.RetirerStatut(statut.Type())
+
+	// Assert
+	assert.Equal(t, domain.TeamID("team-1"), combat.EquipeEffective(guerrier))
+	assert.True(t, combat.SontEnnemis(guerrier, sorciere))
+}
@@ -0,0 +1,53 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_AvancerCompteAReboursKO teste la méthode AvancerCompteAReboursKO()
+func TestCombat_AvancerCompteAReboursKO(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	unite := newTestUnite("unite-1", "Guerrier", "team-1", 3, 4)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(unite)
+	unite.RecevoirDegats(200)
+
+	// Act
+	for i := 0; i < domain.CompteAReboursKO; i++ {
+		combat.AvancerCompteAReboursKO(unite)
+	}
+
+	// Assert
+	assert.True(t, unite.EstMorte())
+
+	butins := combat.Butins()
+	assert.Len(t, butins, 1, "L'unité morte devrait laisser un butin")
+	assert.Equal(t, domain.ButinCristal, butins[0].Type())
+	assert.True(t, butins[0].Position().Equals(newTestPosition(3, 4)))
+
+	events := combat.GetUncommittedEvents()
+	assert.IsType(t, &domain.UniteMorteEvent{}, events[len(events)-2])
+	assert.IsType(t, &domain.ButinDeposeEvent{}, events[len(events)-1])
+}
+
+// TestCombat_AvancerCompteAReboursKO_LiberePosition teste que la case d'une unité morte est libérée
+func TestCombat_AvancerCompteAReboursKO_LiberePosition(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	unite := newTestUnite("unite-1", "Guerrier", "team-1", 3, 4)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(unite)
+	unite.RecevoirDegats(200)
+	occupeesKO := len(combat.ObtenirPositionsOccupees(""))
+
+	// Act
+	for i := 0; i < domain.CompteAReboursKO; i++ {
+		combat.AvancerCompteAReboursKO(unite)
+	}
+
+	// Assert
+	assert.Equal(t, 1, occupeesKO, "Le corps d'une unité KO devrait occuper sa case")
+	assert.Empty(t, combat.ObtenirPositionsOccupees(""), "La case d'une unité morte devrait être libre")
+}
@@ -0,0 +1,91 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestCombatAggro crée un combat avec un goblin IA, un guerrier proche et un mage éloigné
+func newTestCombatAggro() (*domain.Combat, *domain.Unite, *domain.Unite, *domain.Unite) {
+	combat := newTestCombat("combat-1")
+	goblin := newTestUnite("goblin", "Goblin", "team-2", 5, 5)
+	guerrier := newTestUnite("guerrier", "Guerrier", "team-1", 5, 6)
+	mage := newTestUnite("mage", "Mage", "team-1", 5, 9)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(goblin)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(guerrier)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(mage)
+	return combat, goblin, guerrier, mage
+}
+
+// TestCombat_ChoisirCibleIA teste que l'IA vise l'ennemi le plus proche sans menace
+func TestCombat_ChoisirCibleIA(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, _ := newTestCombatAggro()
+
+	// Act
+	cible := combat.ChoisirCibleIA(goblin)
+
+	// Assert
+	assert.Equal(t, guerrier.ID(), cible.ID())
+}
+
+// TestCombat_ChoisirCibleIA_Menace teste que l'IA vise l'unité la plus menaçante (dégâts et soins)
+func TestCombat_ChoisirCibleIA_Menace(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	combat.EnregistrerDegats(guerrier, goblin, 10)
+	combat.EnregistrerSoin(mage, 40)
+
+	// Act
+	cible := combat.ChoisirCibleIA(goblin)
+
+	// Assert
+	assert.Equal(t, 20, combat.TableMenace(goblin.ID()).Get(mage.ID()), "Les soins devraient générer la moitié de leur valeur en menace")
+	assert.Equal(t, mage.ID(), cible.ID())
+}
+
+// TestCombat_ChoisirCibleIA_Provocation teste que la provocation prime sur la menace
+func TestCombat_ChoisirCibleIA_Provocation(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	combat.EnregistrerDegats(mage, goblin, 500)
+	_, err := combat.AppliquerProvocation(guerrier, goblin, 2)
+
+	// Act
+	cible := combat.ChoisirCibleIA(goblin)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, goblin.EstProvoquee())
+	assert.Equal(t, guerrier.ID(), cible.ID())
+}
+
+// TestCombat_ChoisirCibleIA_ProvocateurKO teste que la provocation cesse quand le provocateur tombe
+func TestCombat_ChoisirCibleIA_ProvocateurKO(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	combat.AppliquerProvocation(guerrier, goblin, 2)
+	guerrier.RecevoirDegats(1000)
+
+	// Act
+	cible := combat.ChoisirCibleIA(goblin)
+
+	// Assert
+	assert.Nil(t, combat.CibleForcee(goblin))
+	assert.Equal(t, mage.ID(), cible.ID())
+}
+
+// TestCombat_EnregistrerSoin_SoigneurCharme teste que les soins d'une unité charmée menacent ses ennemis effectifs
+func TestCombat_EnregistrerSoin_SoigneurCharme(t *testing.T) {
+	// Arrange
+	combat, goblin, _, mage := newTestCombatAggro()
+	combat.AppliquerCharme(goblin, mage, 2)
+
+	// Act
+	combat.EnregistrerSoin(mage, 40)
+
+	// Assert
+	assert.Equal(t, 0, combat.TableMenace(goblin.ID()).Get(mage.ID()), "Le gobelin est un allié du mage charmé")
+}
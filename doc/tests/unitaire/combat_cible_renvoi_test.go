@@ -0,0 +1,60 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_CibleRenvoi teste le renvoi d'un sort vers son lanceur
+func TestCombat_CibleRenvoi(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	lanceur := newTestUnite("mage", "Mage", "team-1", 0, 0)
+	reflecteur := newTestUnite("golem", "Golem", "team-2", 3, 0)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(lanceur)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(reflecteur)
+	reflecteur.AjouterStatut(shared.NewStatut(shared.TypeStatutReflet, 3, domain.RenvoiVersLanceur))
+
+	// Act
+	cible := combat.CibleRenvoi(lanceur, reflecteur)
+
+	// Assert
+	assert.Equal(t, lanceur.ID(), cible.ID())
+}
+
+// TestCombat_CibleRenvoi_Aleatoire teste le renvoi vers un ennemi du réflecteur tiré au hasard
+func TestCombat_CibleRenvoi_Aleatoire(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	combat.SetGraineAleatoire(7)
+	lanceur := newTestUnite("mage", "Mage", "team-1", 0, 0)
+	archer := newTestUnite("archer", "Archer", "team-1", 1, 0)
+	reflecteur := newTestUnite("golem", "Golem", "team-2", 3, 0)
+	gobelin := newTestUnite("gobelin", "Gobelin", "team-2", 4, 0)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(lanceur)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(archer)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(reflecteur)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(gobelin)
+	reflecteur.AjouterStatut(shared.NewStatut(shared.TypeStatutReflet, 3, domain.RenvoiAleatoire))
+
+	// Act
+	cible := combat.CibleRenvoi(lanceur, reflecteur)
+
+	// Assert
+	assert.NotNil(t, cible)
+	assert.Equal(t, domain.TeamID("team-1"), cible.TeamID(), "Le sort devrait frapper un ennemi du réflecteur")
+}
+
+// TestCombat_CibleRenvoi_SansReflet teste qu'une unité sans Reflet ne renvoie rien
+func TestCombat_CibleRenvoi_SansReflet(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	lanceur := newTestUnite("mage", "Mage", "team-1", 0, 0)
+	cible := newTestUnite("golem", "Golem", "team-2", 3, 0)
+
+	// Act & Assert
+	assert.Nil(t, combat.CibleRenvoi(lanceur, cible))
+}
@@ -0,0 +1,102 @@
+package unitaire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/validators"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_Cloner teste que la copie d'un combat est indépendante de l'original
+func TestCombat_Cloner(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	combat.AjouterObjet(guerrier.TeamID(), domain.ObjetPotion, 2)
+	hpGoblin := goblin.HPActuels()
+	positionGuerrier := guerrier.Position()
+	evenements := len(combat.GetUncommittedEvents())
+
+	// Act
+	clone := combat.Cloner()
+	cloneGoblin := clone.TrouverUnite(goblin.ID())
+	cloneGuerrier := clone.TrouverUnite(guerrier.ID())
+	cloneGoblin.RecevoirDegats(10)
+	cloneGuerrier.DeplacerVers(goblin.Position())
+	clone.EnregistrerSoin(clone.TrouverUnite(mage.ID()), 1000)
+	_ = clone.ConsommerObjet(guerrier.TeamID(), domain.ObjetPotion, 1)
+
+	// Assert
+	assert.NotSame(t, goblin, cloneGoblin)
+	assert.Equal(t, hpGoblin, goblin.HPActuels())
+	assert.Equal(t, hpGoblin-10, cloneGoblin.HPActuels())
+	assert.True(t, positionGuerrier.Equals(guerrier.Position()))
+	assert.Equal(t, 2, combat.ObtenirQuantiteObjet(guerrier.TeamID(), domain.ObjetPotion))
+	assert.Equal(t, 1, clone.ObtenirQuantiteObjet(guerrier.TeamID(), domain.ObjetPotion))
+	assert.Equal(t, mage.ID(), clone.ChoisirCibleIA(cloneGoblin).ID())
+	assert.Equal(t, guerrier.ID(), combat.ChoisirCibleIA(goblin).ID())
+	assert.Len(t, combat.GetUncommittedEvents(), evenements)
+}
+
+// TestCombat_Cloner_ChaineDeValidation teste que la copie reçoit sa propre chaîne de validation
+func TestCombat_Cloner_ChaineDeValidation(t *testing.T) {
+	// Arrange
+	combat, goblin, _, _ := newTestCombatAggro()
+	combat.SetValidationChain(validators.NewValidationChain(commands.NewCommandRegistry()))
+	attente := commands.NewWaitCommand(goblin, combat)
+
+	// Act
+	clone := combat.Cloner()
+	clone.GetValidationChain().AddValidator(validators.NewRuleValidator("sans attente", func(cmd commands.Command) error {
+		return errors.New("attendre est interdit")
+	}))
+
+	// Assert
+	assert.NotSame(t, combat.GetValidationChain(), clone.GetValidationChain())
+	assert.Error(t, clone.GetValidationChain().Validate(attente))
+	assert.NoError(t, combat.GetValidationChain().Validate(attente), "La règle ajoutée à la copie ne devrait pas toucher l'original")
+}
+
+// quotaValidator limite le nombre de commandes validées par unité (validateur avec état)
+type quotaValidator struct {
+	validators.BaseValidator
+	quota     int
+	compteurs map[domain.UnitID]int
+}
+
+func (v *quotaValidator) Validate(cmd commands.Command) error {
+	if v.compteurs[cmd.GetActor().ID()] >= v.quota {
+		return errors.New("quota atteint")
+	}
+	v.compteurs[cmd.GetActor().ID()]++
+	return v.CallNext(cmd)
+}
+
+func (v *quotaValidator) Clone() validators.Validator {
+	compteurs := make(map[domain.UnitID]int, len(v.compteurs))
+	for id, n := range v.compteurs {
+		compteurs[id] = n
+	}
+	return &quotaValidator{quota: v.quota, compteurs: compteurs}
+}
+
+// TestCombat_Cloner_ValidateurAvecEtat teste que l'état d'un validateur personnalisé n'est pas partagé avec la copie
+func TestCombat_Cloner_ValidateurAvecEtat(t *testing.T) {
+	// Arrange
+	combat, goblin, _, _ := newTestCombatAggro()
+	combat.SetValidationChain(validators.NewValidationChain(commands.NewCommandRegistry()))
+	combat.GetValidationChain().AddValidator(&quotaValidator{quota: 1, compteurs: make(map[domain.UnitID]int)})
+	attente := commands.NewWaitCommand(goblin, combat)
+
+	// Act
+	clone := combat.Cloner()
+	errClone := clone.GetValidationChain().Validate(commands.NewWaitCommand(clone.TrouverUnite(goblin.ID()), clone))
+	errOriginal := combat.GetValidationChain().Validate(attente)
+
+	// Assert
+	assert.NoError(t, errClone)
+	assert.NoError(t, errOriginal, "La validation sur la copie ne devrait pas consommer le quota de l'original")
+	assert.Error(t, combat.GetValidationChain().Validate(attente))
+}
@@ -0,0 +1,70 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_ConclureActiviteTour teste la part de jauge conservée selon l'activité du tour
+func TestCombat_ConclureActiviteTour(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+
+	// Act
+	combat.OuvrirActiviteTour("attente")
+	attente := combat.ConclureActiviteTour("attente")
+
+	combat.OuvrirActiviteTour("deplacement")
+	combat.EnregistrerDeplacementTour("deplacement")
+	deplacement := combat.ConclureActiviteTour("deplacement")
+
+	combat.OuvrirActiviteTour("complet")
+	combat.EnregistrerDeplacementTour("complet")
+	combat.EnregistrerActionTour("complet")
+	complet := combat.ConclureActiviteTour("complet")
+
+	// Assert
+	assert.Equal(t, domain.ConservationJaugeAttente, attente)
+	assert.Equal(t, domain.ConservationJaugeDeplacement, deplacement)
+	assert.Equal(t, domain.ConservationJaugeComplete, complet)
+	assert.Nil(t, combat.ActiviteTour())
+}
+
+// TestCombat_ConclureActiviteTour_ReglesPersonnalisees teste des règles configurées et leur reconstruction
+func TestCombat_ConclureActiviteTour_ReglesPersonnalisees(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	err := combat.SetReglesReinitialisationATB(&domain.ReglesReinitialisationATB{
+		ConservationAttente:     50,
+		ConservationDeplacement: 30,
+		ConservationAction:      10,
+		ConservationComplete:    0,
+	})
+	_ = combat.Demarrer()
+
+	// Act
+	combat.EnregistrerActionTour("hero")
+	reconstruit, errReconstruction := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, errReconstruction)
+	assert.Equal(t, 50, reconstruit.ReglesReinitialisationATB().ConservationAttente)
+	assert.Equal(t, &domain.ActiviteTour{UniteID: "hero", AAgi: true}, reconstruit.ActiviteTour())
+	assert.Equal(t, 10, reconstruit.ConclureActiviteTour("hero"))
+}
+
+// TestCombat_SetReglesReinitialisationATB_Invalide teste le rejet d'une part hors de 0-100
+func TestCombat_SetReglesReinitialisationATB_Invalide(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+
+	// Act
+	err := combat.SetReglesReinitialisationATB(&domain.ReglesReinitialisationATB{ConservationAttente: 120})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, domain.ConservationJaugeAttente, combat.ReglesReinitialisationATB().ConservationAttente)
+}
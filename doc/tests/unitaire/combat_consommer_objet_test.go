@@ -3,6 +3,7 @@ package unitaire
 import (
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,10 +11,21 @@ import (
 func TestCombat_ConsommerObjet(t *testing.T) {
 	// Arrange
 	combat := newTestCombat("combat-1")
+	teamID := domain.TeamID("team-1")
+	combat.AjouterObjet(teamID, domain.ObjetPotion, 2)
 
-	// Act - Méthode void, ne devrait pas crasher
-	combat.ConsommerObjet("potion-1", 1)
+	// Act
+	err := combat.ConsommerObjet(teamID, domain.ObjetPotion, 1)
 
 	// Assert
-	assert.NotNil(t, combat, "Le combat devrait toujours exister")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, combat.ObtenirQuantiteObjet(teamID, domain.ObjetPotion), "Il devrait rester 1 potion")
+
+	events := combat.GetUncommittedEvents()
+	assert.Len(t, events, 1, "La consommation devrait lever un événement")
+	assert.IsType(t, &domain.ObjetConsommeEvent{}, events[0])
+
+	// Quantité insuffisante
+	assert.Error(t, combat.ConsommerObjet(teamID, domain.ObjetPotion, 2), "Consommer plus que le stock devrait échouer")
+	assert.Equal(t, 1, combat.ObtenirQuantiteObjet(teamID, domain.ObjetPotion), "Le stock ne devrait pas changer après un échec")
 }
@@ -0,0 +1,31 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_DebuterAction teste que les événements d'une action partagent son identifiant de corrélation
+func TestCombat_DebuterAction(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	combat.RaiseEvent(domain.NewCombatDemarreEvent("combat-1", 1, []domain.UnitID{}))
+
+	// Act
+	correlationID := combat.DebuterAction()
+	combat.RaiseEvent(domain.NewDegatsInfligesEvent("combat-1", 1, "hero", "goblin", 12))
+	combat.RaiseEvent(domain.NewUniteKOEvent("combat-1", 1, "goblin", 3))
+	combat.TerminerAction()
+	combat.RaiseEvent(domain.NewTourDemarreEvent("combat-1", 1))
+
+	// Assert
+	events := combat.GetUncommittedEvents()
+	assert.Equal(t, "combat-1:2", correlationID)
+	assert.Equal(t, "combat-1:1", events[0].CorrelationID(), "Un événement hors action est sa propre action")
+	assert.Equal(t, correlationID, events[1].CorrelationID())
+	assert.Equal(t, correlationID, events[2].CorrelationID())
+	assert.Equal(t, "combat-1:4", events[3].CorrelationID())
+	assert.Empty(t, combat.ActionEnCours())
+}
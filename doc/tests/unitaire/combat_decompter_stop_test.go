@@ -0,0 +1,46 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_DecompterStop teste que le Stop s'écoule aux tours des autres unités puis se retire
+func TestCombat_DecompterStop(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	chrono := newTestUnite("chrono", "Chronomancien", "team-1", 5, 5)
+	cible := newTestUnite("cible", "Cible", "team-2", 5, 6)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(chrono)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(cible)
+	cible.AjouterStatut(shared.NewStatut(shared.TypeStatutStop, 2, 0))
+
+	// Act
+	premier := combat.DecompterStop(chrono.ID())
+	second := combat.DecompterStop(chrono.ID())
+
+	// Assert
+	assert.Empty(t, premier)
+	assert.Equal(t, []domain.UnitID{cible.ID()}, second)
+	assert.False(t, cible.EstStop())
+	assert.Len(t, combat.GetUncommittedEvents(), 3, "Chaque décompte devrait lever DureeStopDecomptee, le retrait du Stop StatutRetire")
+}
+
+// TestCombat_DecompterStop_ActeurIgnore teste que le tour de l'unité figée ne décompte pas son propre Stop
+func TestCombat_DecompterStop_ActeurIgnore(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	cible := newTestUnite("cible", "Cible", "team-2", 5, 6)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(cible)
+	cible.AjouterStatut(shared.NewStatut(shared.TypeStatutStop, 1, 0))
+
+	// Act
+	liberees := combat.DecompterStop(cible.ID())
+
+	// Assert
+	assert.Empty(t, liberees)
+	assert.True(t, cible.EstStop())
+}
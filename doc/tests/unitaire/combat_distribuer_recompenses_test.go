@@ -3,6 +3,7 @@ package unitaire
 import (
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,3 +18,64 @@ func TestCombat_DistribuerRecompenses(t *testing.T) {
 	// Assert
 	assert.NotNil(t, combat, "Le combat devrait toujours exister après distribution")
 }
+
+// TestCombat_DistribuerRecompenses_Victoire teste l'expérience des survivants et le butin du vainqueur
+func TestCombat_DistribuerRecompenses_Victoire(t *testing.T) {
+	// Arrange - le gobelin meurt et laisse un coffre, le mage est KO
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	combat.SetTypeButinMort(domain.ButinCoffre)
+	_ = combat.Demarrer()
+	goblin.RecevoirDegats(goblin.HPActuels())
+	for !goblin.EstMorte() {
+		combat.AvancerCompteAReboursKO(goblin)
+	}
+	mage.RecevoirDegats(mage.HPActuels())
+	_ = combat.Terminer()
+	potions := combat.ObtenirQuantiteObjet("team-1", domain.ObjetCoffreDefaut)
+
+	// Act
+	combat.DistribuerRecompenses()
+
+	// Assert
+	events := combat.GetUncommittedEvents()
+	recompenses, ok := events[len(events)-1].(*domain.RecompensesDistribueesEvent)
+	if assert.True(t, ok) {
+		assert.Equal(t, domain.TeamID("team-1"), recompenses.Vainqueur)
+		assert.Equal(t, map[domain.UnitID]int{guerrier.ID(): domain.ExperienceParEnnemiVaincu}, recompenses.Experience)
+		assert.Equal(t, []string{"butin-goblin"}, recompenses.Butins)
+	}
+	assert.Empty(t, combat.Butins())
+	assert.Equal(t, potions+1, combat.ObtenirQuantiteObjet("team-1", domain.ObjetCoffreDefaut))
+}
+
+// TestCombat_DistribuerRecompenses_UneSeuleFois teste qu'un combat n'est récompensé qu'une fois
+func TestCombat_DistribuerRecompenses_UneSeuleFois(t *testing.T) {
+	// Arrange
+	combat, goblin, _, _ := newTestCombatAggro()
+	_ = combat.Demarrer()
+	goblin.RecevoirDegats(goblin.HPActuels())
+	_ = combat.Terminer()
+	combat.DistribuerRecompenses()
+	avant := len(combat.GetUncommittedEvents())
+
+	// Act
+	combat.DistribuerRecompenses()
+
+	// Assert
+	assert.Len(t, combat.GetUncommittedEvents(), avant)
+}
+
+// TestCombat_DistribuerRecompenses_Annule teste qu'un combat annulé ne rapporte ni expérience ni butin
+func TestCombat_DistribuerRecompenses_Annule(t *testing.T) {
+	// Arrange
+	combat, _, _, _ := newTestCombatAggro()
+	_ = combat.Demarrer()
+	_ = combat.Annuler("incident serveur")
+	avant := len(combat.GetUncommittedEvents())
+
+	// Act
+	combat.DistribuerRecompenses()
+
+	// Assert
+	assert.Len(t, combat.GetUncommittedEvents(), avant)
+}
@@ -0,0 +1,46 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_EnregistrerPositionMachine teste l'enregistrement de la position de la machine d'états
+func TestCombat_EnregistrerPositionMachine(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	_ = combat.Demarrer()
+	position := domain.PositionMachineEtats{Etat: "ActionSelection", UniteID: "hero"}
+
+	// Act
+	combat.EnregistrerPositionMachine(position)
+	combat.EnregistrerPositionMachine(position)
+
+	// Assert - Une position inchangée n'est enregistrée qu'une fois
+	assert.Equal(t, &position, combat.PositionMachine())
+	nombre := 0
+	for _, evt := range combat.GetUncommittedEvents() {
+		if _, ok := evt.(*domain.MachineEtatsPositionneeEvent); ok {
+			nombre++
+		}
+	}
+	assert.Equal(t, 1, nombre)
+}
+
+// TestCombat_EnregistrerPositionMachine_Reconstruction teste que la dernière position survit au rechargement
+func TestCombat_EnregistrerPositionMachine_Reconstruction(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	_ = combat.Demarrer()
+	combat.EnregistrerPositionMachine(domain.PositionMachineEtats{Etat: "ActionSelection", UniteID: "hero"})
+	combat.EnregistrerPositionMachine(domain.PositionMachineEtats{Etat: "BattleEnded"})
+
+	// Act
+	reconstruit, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, &domain.PositionMachineEtats{Etat: "BattleEnded"}, reconstruit.PositionMachine())
+}
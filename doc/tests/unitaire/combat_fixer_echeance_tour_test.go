@@ -0,0 +1,73 @@
+package unitaire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_FixerEcheanceTour_SansLimite teste qu'aucune échéance n'est fixée sans limite de temps
+func TestCombat_FixerEcheanceTour_SansLimite(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+
+	// Act
+	echeance := combat.FixerEcheanceTour("hero", time.Now())
+
+	// Assert
+	assert.Nil(t, echeance)
+	assert.Nil(t, combat.EcheanceTour())
+	assert.Empty(t, combat.GetUncommittedEvents())
+}
+
+// TestCombat_FixerEcheanceTour teste la fixation de l'échéance et sa reconstruction depuis les événements
+func TestCombat_FixerEcheanceTour(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	maintenant := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_ = combat.SetReglesDelaiTour(&domain.ReglesDelaiTour{
+		Duree:           45 * time.Second,
+		ActionParDefaut: domain.ActionExpirationIA,
+	})
+	_ = combat.Demarrer()
+
+	// Act
+	echeance := combat.FixerEcheanceTour("hero", maintenant)
+
+	// Assert
+	assert.NotNil(t, echeance)
+	assert.Equal(t, domain.UnitID("hero"), echeance.UniteID)
+	assert.Equal(t, maintenant.Add(45*time.Second), echeance.Echeance)
+
+	reconstruit, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+	assert.NoError(t, err)
+	assert.Equal(t, echeance.Echeance, reconstruit.EcheanceTour().Echeance)
+	assert.Equal(t, 45*time.Second, reconstruit.ReglesDelaiTour().Duree)
+	assert.Equal(t, domain.ActionExpirationIA, reconstruit.ReglesDelaiTour().ActionParDefaut)
+}
+
+// TestCombat_ExpirerTour teste que seule l'unité dont le tour est en cours peut expirer
+func TestCombat_ExpirerTour(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	_ = combat.SetReglesDelaiTour(&domain.ReglesDelaiTour{
+		Duree:           time.Minute,
+		ActionParDefaut: domain.ActionExpirationAttente,
+	})
+	combat.FixerEcheanceTour("hero", time.Now())
+
+	// Act
+	errAutre := combat.ExpirerTour("goblin", domain.ActionExpirationAttente)
+	err := combat.ExpirerTour("hero", domain.ActionExpirationAttente)
+
+	// Assert
+	assert.Error(t, errAutre)
+	assert.NoError(t, err)
+	assert.Nil(t, combat.EcheanceTour())
+	events := combat.GetUncommittedEvents()
+	expire, ok := events[len(events)-1].(*domain.TourExpireEvent)
+	assert.True(t, ok)
+	assert.Equal(t, domain.UnitID("hero"), expire.UniteID)
+}
@@ -0,0 +1,85 @@
+package unitaire
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_MettreEnPause_GeleEcheance teste que la pause gèle le délai du tour et que la reprise le restaure
+func TestCombat_MettreEnPause_GeleEcheance(t *testing.T) {
+	// Arrange - 45s par tour, pause 15s après le début du tour
+	combat := newTestCombat("combat-1")
+	debut := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	_ = combat.SetReglesDelaiTour(&domain.ReglesDelaiTour{
+		Duree:           45 * time.Second,
+		ActionParDefaut: domain.ActionExpirationAttente,
+	})
+	_ = combat.Demarrer()
+	combat.FixerEcheanceTour("hero", debut)
+
+	// Act
+	errPause := combat.MettreEnPause("litige", debut.Add(15*time.Second))
+	etatPause := combat.Etat()
+	echeancePause := combat.EcheanceTour()
+	errReprise := combat.Reprendre(debut.Add(10 * time.Minute))
+
+	// Assert
+	assert.NoError(t, errPause)
+	assert.Equal(t, domain.EtatPause, etatPause)
+	assert.Nil(t, echeancePause)
+	assert.NoError(t, errReprise)
+	assert.Equal(t, domain.EtatEnCours, combat.Etat())
+	assert.Nil(t, combat.Pause())
+	assert.Equal(t, debut.Add(10*time.Minute+30*time.Second), combat.EcheanceTour().Echeance)
+
+	reconstruit, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EtatEnCours, reconstruit.Etat())
+	assert.Equal(t, combat.EcheanceTour().Echeance, reconstruit.EcheanceTour().Echeance)
+}
+
+// TestCombat_MettreEnPause_HorsCombatEnCours teste qu'un combat non démarré ou déjà en pause ne peut pas être suspendu
+func TestCombat_MettreEnPause_HorsCombatEnCours(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+
+	// Act
+	errAttente := combat.MettreEnPause("litige", time.Now())
+	_ = combat.Demarrer()
+	_ = combat.MettreEnPause("litige", time.Now())
+	errDejaEnPause := combat.MettreEnPause("litige", time.Now())
+	errReprise := newTestCombat("combat-2").Reprendre(time.Now())
+
+	// Assert
+	assert.Error(t, errAttente)
+	assert.Error(t, errDejaEnPause)
+	assert.Error(t, errReprise)
+	assert.Equal(t, "litige", combat.Pause().Motif)
+}
+
+// TestCombat_Annuler teste l'annulation sans vainqueur et sa reconstruction depuis les événements
+func TestCombat_Annuler(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	_ = combat.Demarrer()
+	_ = combat.MettreEnPause("incident", time.Now())
+
+	// Act
+	err := combat.Annuler("incident serveur")
+	errDeuxFois := combat.Annuler("incident serveur")
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Error(t, errDeuxFois)
+	assert.Equal(t, domain.EtatAnnule, combat.Etat())
+	assert.Equal(t, "CANCELLED", combat.ObtenirResultat())
+	assert.Nil(t, combat.Pause())
+
+	reconstruit, errReconstruction := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+	assert.NoError(t, errReconstruction)
+	assert.Equal(t, domain.EtatAnnule, reconstruit.Etat())
+	assert.Equal(t, "incident serveur", reconstruit.MotifAnnulation())
+}
@@ -3,6 +3,8 @@ package unitaire
 import (
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,8 +14,10 @@ func TestCombat_ObtenirObjet(t *testing.T) {
 	combat := newTestCombat("combat-1")
 
 	// Act
-	objet := combat.ObtenirObjet("potion-1")
+	objet := combat.ObtenirObjet(domain.ObjetPotion)
 
-	// Assert - Placeholder retourne nil
-	assert.Nil(t, objet, "Le placeholder retourne nil")
+	// Assert - Le catalogue par défaut définit la potion
+	assert.NotNil(t, objet, "La potion devrait être dans le catalogue par défaut")
+	assert.Equal(t, shared.ItemTypePotion, objet.GetItemType())
+	assert.Nil(t, combat.ObtenirObjet("inconnu"), "Un objet hors catalogue devrait retourner nil")
 }
@@ -3,6 +3,7 @@ package unitaire
 import (
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,10 +11,12 @@ import (
 func TestCombat_ObtenirQuantiteObjet(t *testing.T) {
 	// Arrange
 	combat := newTestCombat("combat-1")
+	combat.AjouterObjet(domain.TeamID("team-1"), domain.ObjetPotion, 3)
 
 	// Act
-	quantite := combat.ObtenirQuantiteObjet("potion-1")
+	quantite := combat.ObtenirQuantiteObjet(domain.TeamID("team-1"), domain.ObjetPotion)
 
-	// Assert - Placeholder retourne 1
-	assert.Equal(t, 1, quantite, "Le placeholder retourne 1")
+	// Assert
+	assert.Equal(t, 3, quantite, "team-1 devrait avoir 3 potions")
+	assert.Equal(t, 0, combat.ObtenirQuantiteObjet(domain.TeamID("team-2"), domain.ObjetPotion), "team-2 ne devrait pas avoir de potion")
 }
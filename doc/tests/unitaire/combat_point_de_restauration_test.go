@@ -0,0 +1,44 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_RestaurerPointDeRestauration teste que l'état du combat et son flux d'événements reviennent à la capture
+func TestCombat_RestaurerPointDeRestauration(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, _ := newTestCombatAggro()
+	combat.RaiseEvent(domain.NewCombatDemarreEvent("combat-1", 1, []domain.UnitID{}))
+	point := combat.CapturerPointDeRestauration()
+	goblin.RecevoirDegats(10)
+	combat.EnregistrerDegats(guerrier, goblin, 10)
+	combat.RaiseEvent(domain.NewDegatsInfligesEvent("combat-1", 1, guerrier.ID(), goblin.ID(), 10))
+
+	// Act
+	err := combat.RestaurerPointDeRestauration(point)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, combat.GetUncommittedEvents(), 1, "Seul l'événement antérieur à la capture devrait rester")
+	assert.Equal(t, goblin.Stats().HP, goblin.HPActuels(), "Les dégâts devraient être annulés")
+	assert.Equal(t, 0, combat.TableMenace(goblin.ID()).Get(guerrier.ID()), "La menace devrait être annulée")
+}
+
+// TestCombat_RestaurerPointDeRestauration_EvenementsCommittes teste le refus d'annuler des événements déjà enregistrés
+func TestCombat_RestaurerPointDeRestauration_EvenementsCommittes(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	point := combat.CapturerPointDeRestauration()
+	combat.RaiseEvent(domain.NewCombatDemarreEvent("combat-1", 1, []domain.UnitID{}))
+	combat.ClearUncommittedEvents()
+
+	// Act
+	err := combat.RestaurerPointDeRestauration(point)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, 1, combat.Version(), "La version devrait compter les événements committés")
+}
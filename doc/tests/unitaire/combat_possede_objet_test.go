@@ -3,6 +3,7 @@ package unitaire
 import (
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,10 +11,10 @@ import (
 func TestCombat_PossedeObjet(t *testing.T) {
 	// Arrange
 	combat := newTestCombat("combat-1")
+	combat.AjouterObjet(domain.TeamID("team-1"), domain.ObjetPotion, 1)
 
-	// Act
-	possede := combat.PossedeObjet("potion-1")
-
-	// Assert - Placeholder retourne toujours true
-	assert.True(t, possede, "Le placeholder retourne toujours true")
+	// Act & Assert - L'inventaire est propre à chaque équipe
+	assert.True(t, combat.PossedeObjet(domain.TeamID("team-1"), domain.ObjetPotion), "team-1 devrait posséder la potion")
+	assert.False(t, combat.PossedeObjet(domain.TeamID("team-2"), domain.ObjetPotion), "team-2 ne devrait pas posséder la potion")
+	assert.False(t, combat.PossedeObjet(domain.TeamID("team-1"), domain.ObjetEther), "team-1 ne devrait pas posséder d'éther")
 }
@@ -0,0 +1,73 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// tuerUnite fait tomber une unité KO puis écoule son compte à rebours
+func tuerUnite(combat *domain.Combat, unite *domain.Unite) {
+	unite.RecevoirDegats(1000)
+	for unite.EstKO() {
+		combat.AvancerCompteAReboursKO(unite)
+	}
+}
+
+// TestCombat_RamasserButin teste la méthode RamasserButin() avec un cristal
+func TestCombat_RamasserButin(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	morte := newTestUnite("unite-1", "Goblin", "team-2", 2, 2)
+	ramasseur := newTestUnite("unite-2", "Guerrier", "team-1", 1, 2)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(morte)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(ramasseur)
+	tuerUnite(combat, morte)
+
+	ramasseur.RecevoirDegats(60)
+	ramasseur.DeplacerVers(newTestPosition(2, 2))
+
+	// Act
+	butin := combat.RamasserButin(ramasseur)
+
+	// Assert
+	assert.NotNil(t, butin)
+	assert.True(t, butin.EstCristal())
+	assert.Equal(t, ramasseur.Stats().HP, ramasseur.HPActuels(), "Le cristal devrait restaurer les HP")
+	assert.Empty(t, combat.Butins(), "Le butin ramassé devrait disparaître")
+}
+
+// TestCombat_RamasserButin_Coffre teste qu'un coffre ajoute son objet à l'inventaire de l'équipe
+func TestCombat_RamasserButin_Coffre(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	combat.SetTypeButinMort(domain.ButinCoffre)
+	morte := newTestUnite("unite-1", "Goblin", "team-2", 2, 2)
+	ramasseur := newTestUnite("unite-2", "Guerrier", "team-1", 2, 2)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(morte)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(ramasseur)
+	tuerUnite(combat, morte)
+
+	// Act
+	butin := combat.RamasserButin(ramasseur)
+
+	// Assert
+	assert.NotNil(t, butin)
+	assert.True(t, butin.EstCoffre())
+	assert.Equal(t, 1, combat.ObtenirQuantiteObjet(domain.TeamID("team-1"), domain.ObjetCoffreDefaut))
+}
+
+// TestCombat_RamasserButin_CaseVide teste qu'aucun butin n'est ramassé sur une case vide
+func TestCombat_RamasserButin_CaseVide(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	unite := newTestUnite("unite-1", "Guerrier", "team-1", 0, 0)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(unite)
+
+	// Act
+	butin := combat.RamasserButin(unite)
+
+	// Assert
+	assert.Nil(t, butin)
+}
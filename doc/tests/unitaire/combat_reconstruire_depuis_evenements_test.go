@@ -0,0 +1,177 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReconstruireDepuisEvenements_Configuration teste que CombatDemarre recrée équipes, unités, grille et inventaires
+func TestReconstruireDepuisEvenements_Configuration(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	_ = combat.Grille().DefinirTypeCellule(newTestPosition(3, 3), shared.CelluleObstacle)
+	competence := domain.NewCompetence("soin", "Soin", "Soigne un allié", domain.CompetenceSoin, 3,
+		domain.ZoneEffet{}, 10, 0, 2, 25, 1.0, domain.CibleAllies)
+	_ = mage.AjouterCompetence(competence)
+	mage.ActiverCooldown("soin", 2)
+	guerrier.RecevoirDegats(30)
+	combat.AjouterObjet("team-1", "potion", 3)
+	_ = combat.Demarrer()
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, recharge.Equipes(), 2)
+	assert.Len(t, recharge.Equipes()["team-1"].Membres(), 2)
+	assert.False(t, recharge.Equipes()["team-1"].IsIA())
+	for _, unite := range []*domain.Unite{goblin, guerrier, mage} {
+		rechargee := recharge.TrouverUnite(unite.ID())
+		if assert.NotNil(t, rechargee) {
+			assert.Equal(t, unite.CapturerEtat(), rechargee.CapturerEtat())
+			assert.Equal(t, unite.Stats(), rechargee.Stats())
+			assert.Equal(t, unite.EstIA(), rechargee.EstIA())
+		}
+	}
+	soin := recharge.TrouverUnite("mage").ObtenirCompetence("soin")
+	if assert.NotNil(t, soin) {
+		assert.Equal(t, competence.DegatsBase(), soin.DegatsBase())
+		assert.Equal(t, 2, soin.CooldownActuel())
+	}
+	assert.Equal(t, 10, recharge.Grille().Largeur())
+	assert.False(t, recharge.Grille().EstTraversable(newTestPosition(3, 3)))
+	assert.Equal(t, 3, recharge.ObtenirQuantiteObjet("team-1", "potion"))
+}
+
+// TestReconstruireDepuisEvenements_EntamerTourUnite teste le rejeu du début de tour (statuts, cooldowns, régénération)
+func TestReconstruireDepuisEvenements_EntamerTourUnite(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	competence := domain.NewCompetence("soin", "Soin", "Soigne un allié", domain.CompetenceSoin, 3,
+		domain.ZoneEffet{}, 10, 0, 2, 25, 1.0, domain.CibleAllies)
+	_ = mage.AjouterCompetence(competence)
+	mage.ActiverCooldown("soin", 2)
+	_ = goblin.AjouterStatut(shared.NewStatut(shared.TypeStatutPoison, 3, 5))
+	_ = guerrier.ConsommerMP(15)
+	_ = guerrier.ConsommerStamina(10)
+	_ = combat.Demarrer()
+
+	// Act
+	for _, unite := range []*domain.Unite{goblin, guerrier, mage} {
+		combat.EntamerTourUnite(unite)
+	}
+	recharge, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, err)
+	for _, unite := range []*domain.Unite{goblin, guerrier, mage} {
+		assert.Equal(t, unite.CapturerEtat(), recharge.TrouverUnite(unite.ID()).CapturerEtat())
+	}
+	assert.Less(t, goblin.HPActuels(), goblin.Stats().HP, "Le poison devrait avoir agi")
+}
+
+// TestReconstruireDepuisEvenements_ProvocationCharmeEtMenace teste le rejeu de la provocation, du charme et de la menace
+func TestReconstruireDepuisEvenements_ProvocationCharmeEtMenace(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	_ = combat.Demarrer()
+	_, errProvocation := combat.AppliquerProvocation(guerrier, goblin, 2)
+	combat.EnregistrerDegats(guerrier, goblin, 10)
+	combat.EnregistrerSoin(mage, 40)
+	_, errCharme := combat.AppliquerCharme(mage, goblin, 2)
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, errProvocation)
+	assert.NoError(t, errCharme)
+	assert.NoError(t, err)
+	gobelinRecharge := recharge.TrouverUnite(goblin.ID())
+	assert.Equal(t, goblin.CapturerEtat(), gobelinRecharge.CapturerEtat())
+	assert.Equal(t, guerrier.ID(), recharge.CibleForcee(gobelinRecharge).ID())
+	assert.Equal(t, combat.EquipeEffective(goblin), recharge.EquipeEffective(gobelinRecharge))
+	assert.Equal(t, combat.TableMenace(goblin.ID()).Get(guerrier.ID()), recharge.TableMenace(goblin.ID()).Get(guerrier.ID()))
+	assert.Equal(t, combat.TableMenace(goblin.ID()).Get(mage.ID()), recharge.TableMenace(goblin.ID()).Get(mage.ID()))
+}
+
+// TestReconstruireDepuisEvenements_DecompteStop teste le rejeu de l'écoulement du Stop puis de son retrait
+func TestReconstruireDepuisEvenements_DecompteStop(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, _ := newTestCombatAggro()
+	_ = goblin.AjouterStatut(shared.NewStatut(shared.TypeStatutStop, 2, 0))
+	_ = combat.Demarrer()
+
+	// Act
+	combat.DecompterStop(guerrier.ID())
+	apresDecompte, errDecompte := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+	combat.DecompterStop(guerrier.ID())
+	apresRetrait, errRetrait := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, errDecompte)
+	assert.NoError(t, errRetrait)
+	if stop := apresDecompte.TrouverUnite(goblin.ID()).ObtenirStatut(shared.TypeStatutStop); assert.NotNil(t, stop) {
+		assert.Equal(t, 1, stop.Duree())
+	}
+	assert.False(t, apresRetrait.TrouverUnite(goblin.ID()).EstStop())
+}
+
+// TestCombat_EnregistrerDegats_SansMenace teste qu'aucune menace n'est levée pour une cible sans table (unité joueur)
+func TestCombat_EnregistrerDegats_SansMenace(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, _ := newTestCombatAggro()
+	_ = combat.Demarrer()
+	avant := len(combat.GetUncommittedEvents())
+
+	// Act
+	combat.EnregistrerDegats(goblin, guerrier, 10)
+
+	// Assert
+	assert.Len(t, combat.GetUncommittedEvents(), avant)
+}
+
+// TestReconstruireDepuisEvenements_EvenementsUnites teste le rejeu des dégâts, statuts et déplacements
+func TestReconstruireDepuisEvenements_EvenementsUnites(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, _ := newTestCombatAggro()
+	_ = combat.Demarrer()
+	provocation := shared.NewStatut(shared.TypeStatutProvocation, 2, 0)
+	combat.RaiseEvent(domain.NewDegatsInfligesEvent(combat.ID(), 1, guerrier.ID(), goblin.ID(), 40))
+	combat.RaiseEvent(domain.NewUniteProvoqueeEvent(combat.ID(), 1, guerrier.ID(), goblin.ID(), provocation))
+	combat.RaiseEvent(domain.NewUniteDeplaceeEvent(combat.ID(), 1, guerrier.ID(), guerrier.Position(), newTestPosition(5, 7), 1))
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements(combat.GetUncommittedEvents())
+
+	// Assert
+	assert.NoError(t, err)
+	gobelinRecharge := recharge.TrouverUnite(goblin.ID())
+	assert.Equal(t, 60, gobelinRecharge.HPActuels())
+	assert.True(t, gobelinRecharge.EstProvoquee())
+	assert.Equal(t, guerrier.ID(), recharge.CibleForcee(gobelinRecharge).ID())
+	assert.Equal(t, 7, recharge.TrouverUnite(guerrier.ID()).Position().Y())
+}
+
+// TestReconstruireDepuisEvenements_SansConfiguration teste qu'un flux antérieur aux configurations reste rechargeable
+func TestReconstruireDepuisEvenements_SansConfiguration(t *testing.T) {
+	// Arrange
+	demarre := domain.NewCombatDemarreEvent("combat-1", 1, nil)
+	demarre.SetAggregateID("combat-1")
+	demarre.SetAggregateVersion(1)
+	degats := domain.NewDegatsInfligesEvent("combat-1", 1, "guerrier", "goblin", 10)
+	degats.SetAggregateVersion(2)
+
+	// Act
+	recharge, err := domain.ReconstruireDepuisEvenements([]domain.Evenement{demarre, degats})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, recharge.Equipes())
+	assert.Equal(t, domain.EtatEnCours, recharge.Etat())
+	assert.Equal(t, 2, recharge.Version())
+}
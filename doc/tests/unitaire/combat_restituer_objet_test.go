@@ -0,0 +1,28 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_RestituerObjet teste la méthode RestituerObjet()
+func TestCombat_RestituerObjet(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	teamID := domain.TeamID("team-1")
+	combat.AjouterObjet(teamID, domain.ObjetPotion, 1)
+	_ = combat.ConsommerObjet(teamID, domain.ObjetPotion, 1)
+
+	// Act
+	err := combat.RestituerObjet(teamID, domain.ObjetPotion, 1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 1, combat.ObtenirQuantiteObjet(teamID, domain.ObjetPotion), "La potion devrait être rendue")
+
+	events := combat.GetUncommittedEvents()
+	assert.Len(t, events, 2, "Consommation et restitution devraient être tracées")
+	assert.IsType(t, &domain.ObjetRestitueEvent{}, events[1])
+}
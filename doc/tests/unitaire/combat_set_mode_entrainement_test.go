@@ -0,0 +1,44 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_SetModeEntrainement teste que le mode entraînement est enregistré dans CombatDemarre et rejoué
+func TestCombat_SetModeEntrainement(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+
+	// Act
+	err := combat.SetModeEntrainement(true)
+	_ = combat.Demarrer()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, combat.ModeEntrainement())
+	events := combat.GetUncommittedEvents()
+	demarre, ok := events[0].(*domain.CombatDemarreEvent)
+	assert.True(t, ok)
+	assert.True(t, demarre.Entrainement)
+
+	reconstruit, err := domain.ReconstruireDepuisEvenements(events)
+	assert.NoError(t, err)
+	assert.True(t, reconstruit.ModeEntrainement())
+}
+
+// TestCombat_SetModeEntrainement_ApresDemarrage teste qu'un combat démarré ne peut pas devenir un entraînement
+func TestCombat_SetModeEntrainement_ApresDemarrage(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	_ = combat.Demarrer()
+
+	// Act
+	err := combat.SetModeEntrainement(true)
+
+	// Assert
+	assert.Error(t, err)
+	assert.False(t, combat.ModeEntrainement())
+}
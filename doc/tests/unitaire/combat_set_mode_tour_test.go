@@ -0,0 +1,46 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_SetModeTour teste que le mode de tour est enregistré dans CombatDemarre et rejoué
+func TestCombat_SetModeTour(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+
+	// Act
+	err := combat.SetModeTour(domain.ModeTourPhaseEquipes)
+	_ = combat.Demarrer()
+
+	// Assert
+	assert.NoError(t, err)
+	events := combat.GetUncommittedEvents()
+	assert.Len(t, events, 1)
+	demarre, ok := events[0].(*domain.CombatDemarreEvent)
+	assert.True(t, ok)
+	assert.Equal(t, domain.ModeTourPhaseEquipes, demarre.ModeTour)
+
+	reconstruit, err := domain.ReconstruireDepuisEvenements(events)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ModeTourPhaseEquipes, reconstruit.ModeTour())
+}
+
+// TestCombat_SetModeTour_Invalide teste le rejet d'un mode inconnu ou d'un changement après démarrage
+func TestCombat_SetModeTour_Invalide(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+
+	// Act
+	errInconnu := combat.SetModeTour(domain.ModeTour("TEMPS_REEL"))
+	_ = combat.Demarrer()
+	errDemarre := combat.SetModeTour(domain.ModeTourCTB)
+
+	// Assert
+	assert.Error(t, errInconnu)
+	assert.Error(t, errDemarre)
+	assert.Equal(t, domain.ModeTourATB, combat.ModeTour())
+}
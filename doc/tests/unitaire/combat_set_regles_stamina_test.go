@@ -0,0 +1,42 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_SetReglesStamina teste la méthode SetReglesStamina()
+func TestCombat_SetReglesStamina(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	unite := newTestUnite("unite-1", "Gladiateur", "team-1", 0, 0)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(unite)
+
+	regles := domain.NewReglesStaminaDefaut()
+	regles.TauxRegeneration = 50
+
+	// Act
+	err := combat.SetReglesStamina(regles)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, regles, combat.ReglesStamina())
+	assert.Equal(t, 50, unite.TauxRegenStamina(), "Le taux devrait être appliqué aux unités du combat")
+}
+
+// TestCombat_SetReglesStamina_Invalide teste le rejet de règles incohérentes
+func TestCombat_SetReglesStamina_Invalide(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	regles := domain.NewReglesStaminaDefaut()
+	regles.TauxRegeneration = 150
+
+	// Act
+	err := combat.SetReglesStamina(regles)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, domain.TauxRegenStaminaDefaut, combat.ReglesStamina().TauxRegeneration, "Les règles par défaut devraient être conservées")
+}
@@ -0,0 +1,41 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_Terminer teste que la fin du combat enregistre l'équipe encore debout comme vainqueur
+func TestCombat_Terminer(t *testing.T) {
+	// Arrange
+	combat, goblin, _, _ := newTestCombatAggro()
+	_ = combat.Demarrer()
+	goblin.RecevoirDegats(goblin.HPActuels())
+
+	// Act
+	err := combat.Terminer()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EtatTermine, combat.Etat())
+	events := combat.GetUncommittedEvents()
+	termine, ok := events[len(events)-1].(*domain.CombatTermineEvent)
+	assert.True(t, ok)
+	assert.Equal(t, domain.TeamID("team-1"), *termine.Vainqueur)
+}
+
+// TestCombat_Terminer_EquipesEnLice teste qu'un combat ne se termine pas tant que deux équipes sont debout
+func TestCombat_Terminer_EquipesEnLice(t *testing.T) {
+	// Arrange
+	combat, _, _, _ := newTestCombatAggro()
+	_ = combat.Demarrer()
+
+	// Act
+	err := combat.Terminer()
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, domain.EtatEnCours, combat.Etat())
+}
@@ -0,0 +1,31 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_TirerAleatoire teste que le générateur du combat est rejouable à graine égale
+func TestCombat_TirerAleatoire(t *testing.T) {
+	// Arrange
+	combat1 := newTestCombat("combat-1")
+	combat2 := newTestCombat("combat-2")
+	combat1.SetGraineAleatoire(42)
+	combat2.SetGraineAleatoire(42)
+
+	// Act
+	tirages1 := make([]int, 0, 10)
+	tirages2 := make([]int, 0, 10)
+	for i := 0; i < 10; i++ {
+		tirages1 = append(tirages1, combat1.TirerAleatoire(6))
+		tirages2 = append(tirages2, combat2.TirerAleatoire(6))
+	}
+
+	// Assert
+	assert.Equal(t, tirages1, tirages2)
+	for _, tirage := range tirages1 {
+		assert.True(t, tirage >= 0 && tirage < 6)
+	}
+	assert.Equal(t, 0, combat1.TirerAleatoire(0))
+}
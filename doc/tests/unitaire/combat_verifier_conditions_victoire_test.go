@@ -26,3 +26,24 @@ func TestCombat_VerifierConditionsVictoire(t *testing.T) {
 	// Assert
 	assert.Equal(t, "CONTINUE", resultat, "Le combat devrait continuer quand les deux équipes ont des unités vivantes")
 }
+
+// TestCombat_VerifierConditionsVictoire_EquipeKO teste qu'une équipe entièrement KO est vaincue
+func TestCombat_VerifierConditionsVictoire_EquipeKO(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	equipes := combat.Equipes()
+
+	unite1 := newTestUnite(domain.UnitID("u1"), "Guerrier", domain.TeamID("team-1"), 0, 0)
+	unite2 := newTestUnite(domain.UnitID("u2"), "Goblin", domain.TeamID("team-2"), 1, 0)
+	equipes[domain.TeamID("team-1")].AjouterMembre(unite1)
+	equipes[domain.TeamID("team-2")].AjouterMembre(unite2)
+
+	unite2.RecevoirDegats(500) // KO, compte à rebours non écoulé
+
+	// Act
+	resultat := combat.VerifierConditionsVictoire()
+
+	// Assert
+	assert.True(t, unite2.EstKO(), "L'unité devrait être KO et non morte")
+	assert.Equal(t, "VICTORY", resultat, "Une équipe entièrement KO devrait être vaincue")
+}
@@ -0,0 +1,45 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCombat_VerifierProvocation teste qu'une unité provoquée ne peut viser que son provocateur
+func TestCombat_VerifierProvocation(t *testing.T) {
+	// Arrange
+	combat := newTestCombat("combat-1")
+	paladin := newTestUnite("paladin", "Paladin", "team-2", 5, 5)
+	goblin := newTestUnite("goblin", "Goblin", "team-2", 5, 7)
+	heros := newTestUnite("heros", "Héros", "team-1", 5, 6)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(paladin)
+	combat.Equipes()[domain.TeamID("team-2")].AjouterMembre(goblin)
+	combat.Equipes()[domain.TeamID("team-1")].AjouterMembre(heros)
+	combat.AppliquerProvocation(paladin, heros, 2)
+
+	// Act
+	errAutre := combat.VerifierProvocation(heros, []*domain.Unite{goblin})
+	errProvocateur := combat.VerifierProvocation(heros, []*domain.Unite{paladin})
+
+	// Assert
+	assert.Error(t, errAutre, "Une unité provoquée ne devrait pas pouvoir viser un autre ennemi")
+	assert.NoError(t, errProvocateur)
+	assert.NoError(t, combat.VerifierProvocation(heros, []*domain.Unite{heros}), "Viser soi-même reste permis")
+}
+
+// TestCombat_VerifierProvocation_Charme teste qu'une unité provoquée et charmée peut viser ses nouveaux alliés
+func TestCombat_VerifierProvocation_Charme(t *testing.T) {
+	// Arrange
+	combat, goblin, guerrier, mage := newTestCombatAggro()
+	combat.AppliquerCharme(goblin, guerrier, 2)
+	_, err := combat.AppliquerProvocation(mage, guerrier, 2)
+
+	// Act
+	errAncienEnnemi := combat.VerifierProvocation(guerrier, []*domain.Unite{goblin})
+
+	// Assert
+	assert.NoError(t, err, "Le mage devrait pouvoir provoquer son allié charmé")
+	assert.NoError(t, errAncienEnnemi, "Le gobelin est devenu un allié du guerrier charmé")
+}
@@ -0,0 +1,52 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCompetence_DegatsHPManquants teste la méthode DegatsHPManquants()
+func TestCompetence_DegatsHPManquants(t *testing.T) {
+	// Arrange
+	lanceur := newTestUnite("u1", "Berserker", "team-1", 0, 0)
+	lanceur.RecevoirDegats(60)
+	competence := newTestCompetence("rage", "Rage", domain.CompetenceAttaque)
+	competence.AjouterEffet(domain.NewEffetCompetence(domain.EffetDegatsHPManquants, 0, 0, nil))
+
+	// Act
+	degats, ok := competence.DegatsHPManquants(lanceur)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, 60, degats, "Les dégâts devraient égaler les HP manquants")
+}
+
+// TestCompetence_DegatsHPManquants_Pourcentage teste une part des HP manquants
+func TestCompetence_DegatsHPManquants_Pourcentage(t *testing.T) {
+	// Arrange
+	lanceur := newTestUnite("u1", "Berserker", "team-1", 0, 0)
+	lanceur.RecevoirDegats(60)
+	competence := newTestCompetence("rage", "Rage", domain.CompetenceAttaque)
+	competence.AjouterEffet(domain.NewEffetCompetence(domain.EffetDegatsHPManquants, 50, 0, nil))
+
+	// Act
+	degats, _ := competence.DegatsHPManquants(lanceur)
+
+	// Assert
+	assert.Equal(t, 30, degats)
+}
+
+// TestCompetence_DegatsHPManquants_SansEffet teste une compétence sans l'effet
+func TestCompetence_DegatsHPManquants_SansEffet(t *testing.T) {
+	// Arrange
+	lanceur := newTestUnite("u1", "Berserker", "team-1", 0, 0)
+	competence := newTestCompetence("coup", "Coup", domain.CompetenceAttaque)
+
+	// Act
+	_, ok := competence.DegatsHPManquants(lanceur)
+
+	// Assert
+	assert.False(t, ok)
+}
@@ -0,0 +1,52 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestThreatTable_Highest teste la méthode Highest()
+func TestThreatTable_Highest(t *testing.T) {
+	// Arrange
+	table := domain.NewThreatTable("goblin")
+	table.Add("mage", 30)
+	table.Add("guerrier", 20)
+	table.Add("guerrier", 15)
+
+	// Act
+	id, ok := table.Highest([]domain.UnitID{"mage", "guerrier"})
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, domain.UnitID("guerrier"), id, "La menace devrait être cumulée")
+}
+
+// TestThreatTable_Highest_Egalite teste le départage déterministe par ID
+func TestThreatTable_Highest_Egalite(t *testing.T) {
+	// Arrange
+	table := domain.NewThreatTable("goblin")
+	table.Add("mage", 10)
+	table.Add("archer", 10)
+
+	// Act
+	id, ok := table.Highest([]domain.UnitID{"mage", "archer"})
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, domain.UnitID("archer"), id)
+}
+
+// TestThreatTable_Highest_HorsCandidats teste qu'une unité non candidate est ignorée
+func TestThreatTable_Highest_HorsCandidats(t *testing.T) {
+	// Arrange
+	table := domain.NewThreatTable("goblin")
+	table.Add("mage", 50)
+
+	// Act
+	_, ok := table.Highest([]domain.UnitID{"guerrier"})
+
+	// Assert
+	assert.False(t, ok, "Aucune candidate n'a généré de menace")
+}
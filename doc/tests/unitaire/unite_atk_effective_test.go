@@ -0,0 +1,23 @@
+package unitaire
+
+import (
+	"testing"
+
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnite_ATKEffective teste la méthode ATKEffective() avec le bonus du Berserk
+func TestUnite_ATKEffective(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("u1", "Barbare", "team-1", 0, 0)
+	atkBase := unite.Stats().ATK
+
+	// Act
+	unite.AjouterStatut(shared.NewStatut(shared.TypeStatutBerserk, 2, 10))
+
+	// Assert
+	assert.True(t, unite.EstBerserk())
+	assert.Equal(t, atkBase+10, unite.ATKEffective())
+	assert.Equal(t, atkBase, unite.Stats().ATK, "Les stats de base ne devraient pas changer")
+}
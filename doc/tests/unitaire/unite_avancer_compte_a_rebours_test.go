@@ -0,0 +1,41 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnite_AvancerCompteARebours teste la méthode AvancerCompteARebours()
+func TestUnite_AvancerCompteARebours(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("unite-1", "Guerrier", "team-1", 5, 5)
+	unite.RecevoirDegats(200)
+	assert.Equal(t, domain.CompteAReboursKO, unite.CompteAReboursKO(), "Le compte à rebours devrait démarrer au KO")
+
+	// Act
+	morts := make([]bool, 0, domain.CompteAReboursKO)
+	for i := 0; i < domain.CompteAReboursKO; i++ {
+		morts = append(morts, unite.AvancerCompteARebours())
+	}
+
+	// Assert
+	assert.Equal(t, []bool{false, false, true}, morts, "L'unité devrait mourir au dernier tour du compte à rebours")
+	assert.True(t, unite.EstMorte())
+	assert.False(t, unite.EstKO())
+	assert.True(t, unite.EstEliminee())
+}
+
+// TestUnite_AvancerCompteARebours_UniteDebout teste que le compte à rebours ignore une unité debout
+func TestUnite_AvancerCompteARebours_UniteDebout(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("unite-1", "Guerrier", "team-1", 5, 5)
+
+	// Act
+	morte := unite.AvancerCompteARebours()
+
+	// Assert
+	assert.False(t, morte)
+	assert.False(t, unite.EstMorte())
+}
@@ -3,6 +3,7 @@ package unitaire
 import (
 	"testing"
 
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,3 +21,17 @@ func TestUnite_ConsommerStamina(t *testing.T) {
 	staminaApres := unite.StatsActuelles().Stamina
 	assert.Equal(t, staminaInitial-5, staminaApres, "Stamina devrait diminuer de 5")
 }
+
+// TestUnite_ConsommerStamina_Epuisement teste l'épuisement quand la Stamina tombe à 0
+func TestUnite_ConsommerStamina_Epuisement(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("unite-1", "Gladiateur", "team-1", 5, 5)
+
+	// Act
+	err := unite.ConsommerStamina(unite.StatsActuelles().Stamina)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, unite.EstEpuise(), "L'unité devrait être épuisée à 0 Stamina")
+	assert.Equal(t, unite.Stats().MOV/domain.DiviseurDeplacementEpuise, unite.PorteeDeplacement(), "La portée de déplacement devrait être réduite")
+}
@@ -22,3 +22,17 @@ func TestUnite_RecevoirDegats(t *testing.T) {
 	assert.Equal(t, 70, unite.HPActuels(), "Les HP devraient être réduits de 30 (100 - 30 = 70)")
 	assert.False(t, unite.EstEliminee(), "L'unité ne devrait pas être éliminée")
 }
+
+// TestUnite_RecevoirDegats_ReveilleSommeil teste que les dégâts réveillent une unité endormie
+func TestUnite_RecevoirDegats_ReveilleSommeil(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("u1", "Guerrier", "team-1", 0, 0)
+	unite.AjouterStatut(shared.NewStatut(shared.TypeStatutSommeil, 3, 0))
+
+	// Act
+	unite.RecevoirDegats(10)
+
+	// Assert
+	assert.False(t, unite.EstEndormie(), "Les dégâts devraient réveiller l'unité")
+	assert.True(t, unite.PeutAgir())
+}
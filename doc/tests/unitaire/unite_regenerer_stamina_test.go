@@ -0,0 +1,36 @@
+package unitaire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnite_RegenererStamina teste la méthode RegenererStamina()
+func TestUnite_RegenererStamina(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("unite-1", "Gladiateur", "team-1", 5, 5)
+	unite.SetStamina(0)
+	unite.SetTauxRegenStamina(25)
+
+	// Act
+	regen := unite.RegenererStamina()
+
+	// Assert
+	assert.Equal(t, 20, regen, "25% de 80 Stamina devrait régénérer 20")
+	assert.Equal(t, 20, unite.StatsActuelles().Stamina)
+}
+
+// TestUnite_RegenererStamina_Plafond teste que la régénération ne dépasse pas le maximum
+func TestUnite_RegenererStamina_Plafond(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("unite-1", "Gladiateur", "team-1", 5, 5)
+	unite.SetStamina(75)
+
+	// Act
+	regen := unite.RegenererStamina()
+
+	// Assert
+	assert.Equal(t, 5, regen, "La régénération devrait être plafonnée à la Stamina max")
+	assert.Equal(t, unite.Stats().Stamina, unite.StatsActuelles().Stamina)
+}
@@ -19,3 +19,20 @@ func TestUnite_Ressusciter(t *testing.T) {
 	assert.False(t, unite.EstEliminee(), "L'unité ne devrait plus être éliminée")
 	assert.Equal(t, 50, unite.HPActuels(), "HP devrait être restauré à 50")
 }
+
+// TestUnite_Ressusciter_Morte teste qu'une unité définitivement morte ne peut pas être ressuscitée
+func TestUnite_Ressusciter_Morte(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("unite-1", "Phénix", "team-1", 5, 5)
+	unite.RecevoirDegats(200)
+	for unite.EstKO() {
+		unite.AvancerCompteARebours()
+	}
+
+	// Act
+	unite.Ressusciter(50)
+
+	// Assert
+	assert.True(t, unite.EstMorte(), "L'unité devrait rester morte")
+	assert.Equal(t, 0, unite.HPActuels())
+}
@@ -0,0 +1,34 @@
+package unitaire
+
+import (
+	"testing"
+
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestUnite_SPDEffective teste la méthode SPDEffective() avec le malus de la Paralysie
+func TestUnite_SPDEffective(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("u1", "Éclaireur", "team-1", 0, 0)
+	spdBase := unite.Stats().SPD
+
+	// Act
+	unite.AjouterStatut(shared.NewStatut(shared.StatutParalysie, 2, 3))
+
+	// Assert
+	assert.Equal(t, spdBase-3, unite.SPDEffective())
+	assert.Equal(t, spdBase, unite.Stats().SPD, "Les stats de base ne devraient pas changer")
+}
+
+// TestUnite_SPDEffective_Minimum teste que la SPD effective ne descend pas sous 1
+func TestUnite_SPDEffective_Minimum(t *testing.T) {
+	// Arrange
+	unite := newTestUnite("u1", "Éclaireur", "team-1", 0, 0)
+
+	// Act
+	unite.AjouterStatut(shared.NewStatut(shared.StatutParalysie, 2, unite.Stats().SPD+50))
+
+	// Assert
+	assert.Equal(t, 1, unite.SPDEffective())
+}
@@ -2,11 +2,16 @@ package application
 
 import (
 	"errors"
+	"fmt"
+	"log"
+	"time"
 
 	"github.com/aether-engine/aether-engine/internal/combat/combatfacade"
 	"github.com/aether-engine/aether-engine/internal/combat/combatinitializer"
 	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/states"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/validators"
 	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
 
@@ -18,28 +23,76 @@ type CombatEngine interface {
 	// ExecuterAction exécute une action dans un combat
 	ExecuterAction(cmd CommandeExecuterAction) (*ResultatActionDTO, error)
 
+	// PrevisualiserAction simule une action sans la jouer (dégâts, chances, cases, coûts)
+	PrevisualiserAction(cmd CommandeExecuterAction) (*PrevisualisationActionDTO, error)
+
 	// PasserTour passe au tour suivant
 	PasserTour(cmd CommandePasserTour) error
 
 	// TerminerCombat termine un combat
 	TerminerCombat(cmd CommandeTerminerCombat) error
 
+	// MettreEnPause suspend un combat (délai du tour gelé, actions refusées)
+	MettreEnPause(cmd CommandeMettreEnPause) error
+
+	// ReprendreCombat relance un combat en pause
+	ReprendreCombat(cmd CommandeReprendreCombat) error
+
+	// AnnulerCombat annule un combat sans vainqueur ni récompenses
+	AnnulerCombat(cmd CommandeAnnulerCombat) error
+
+	// AnnulerAction annule la dernière action d'un combat d'entraînement (tours joués depuis compris)
+	AnnulerAction(cmd CommandeAnnulerAction) error
+
+	// RetablirAction refait la dernière action annulée d'un combat d'entraînement
+	RetablirAction(cmd CommandeRetablirAction) error
+
 	// ObtenirCombat récupère l'état d'un combat
 	ObtenirCombat(query QueryObtenirCombat) (*CombatDTO, error)
+
+	// ObtenirOrdreTours prévoit l'ordre des prochains tours d'un combat
+	ObtenirOrdreTours(query QueryObtenirOrdreTours) (*OrdreToursDTO, error)
+
+	// ExpirerTour joue l'action par défaut d'un tour dont le délai est écoulé
+	ExpirerTour(cmd CommandeExpirerTour) error
+
+	// RestaurerEcheances reprogramme les minuteurs de tour depuis les échéances persistées
+	RestaurerEcheances() error
 }
 
 // CombatEngineImpl implémente CombatEngine
 type CombatEngineImpl struct {
-	eventStore EventStore
-	publisher  EventPublisher
+	eventStore  EventStore
+	publisher   EventPublisher
+	registry    *commands.CommandRegistry
+	validateurs []validators.Validator // Règles ajoutées à la chaîne de chaque combat, à chaque chargement
+	minuteur    *TurnTimer
+	verrous     *verrousCombat
 }
 
-// NewCombatEngine crée une nouvelle instance du moteur de combat
+// NewCombatEngine crée une nouvelle instance du moteur de combat, sans action personnalisée
 func NewCombatEngine(eventStore EventStore, publisher EventPublisher) CombatEngine {
-	return &CombatEngineImpl{
-		eventStore: eventStore,
-		publisher:  publisher,
-	}
+	return NewCombatEngineWithRegistry(eventStore, publisher, commands.NewCommandRegistry())
+}
+
+// NewCombatEngineWithRegistry crée un moteur dont les combats acceptent les actions personnalisées du registre
+func NewCombatEngineWithRegistry(eventStore EventStore, publisher EventPublisher, registry *commands.CommandRegistry) CombatEngine {
+	return NewCombatEngineWithValidators(eventStore, publisher, registry)
+}
+
+// NewCombatEngineWithValidators crée un moteur dont chaque combat valide les actions avec les règles données
+// (règles de tournoi, etc.), en fin de chaîne. La chaîne étant reconstruite à chaque chargement depuis
+// l'Event Store, chaque combat reçoit une copie (Validator.Clone) des validateurs donnés
+func NewCombatEngineWithValidators(eventStore EventStore, publisher EventPublisher, registry *commands.CommandRegistry, validateurs ...validators.Validator) CombatEngine {
+	engine := &CombatEngineImpl{
+		eventStore:  eventStore,
+		publisher:   publisher,
+		registry:    registry,
+		validateurs: validateurs,
+		verrous:     newVerrousCombat(),
+	}
+	engine.minuteur = NewTurnTimer(engine.expirerTourProgramme)
+	return engine
 }
 
 // DemarrerCombat démarre un nouveau combat
@@ -49,6 +102,7 @@ func (e *CombatEngineImpl) DemarrerCombat(cmd CommandeDemarrerCombat) (*CombatDT
 	if err := validateDemarrerCommand(cmd); err != nil {
 		return nil, err
 	}
+	defer e.verrous.verrouiller(cmd.CombatID)()
 
 	// Construire le domain model
 	equipes, grille, err := buildDomainModel(cmd)
@@ -62,16 +116,59 @@ func (e *CombatEngineImpl) DemarrerCombat(cmd CommandeDemarrerCombat) (*CombatDT
 		return nil, err
 	}
 
+	// Charger le catalogue d'objets et les inventaires initiaux
+	if err := setupInventaires(combat, cmd); err != nil {
+		return nil, err
+	}
+
+	// Appliquer les règles de Stamina personnalisées
+	if cmd.ReglesStamina != nil {
+		if err := combat.SetReglesStamina(cmd.ReglesStamina.ToReglesStamina()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Limiter le temps de chaque tour (enregistré dans CombatDemarre)
+	if cmd.DelaiTour != nil {
+		if err := combat.SetReglesDelaiTour(cmd.DelaiTour.ToReglesDelaiTour()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Part de jauge ATB conservée selon l'activité du tour (enregistrée dans CombatDemarre)
+	if cmd.ReglesJaugeATB != nil {
+		if err := combat.SetReglesReinitialisationATB(cmd.ReglesJaugeATB.ToReglesReinitialisationATB()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Choisir le système d'ordonnancement des tours (enregistré dans CombatDemarre)
+	if cmd.ModeTour != "" {
+		if err := combat.SetModeTour(domain.ModeTour(cmd.ModeTour)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Combat d'entraînement: annuler/refaire autorisés (enregistré dans CombatDemarre)
+	if err := combat.SetModeEntrainement(cmd.Entrainement); err != nil {
+		return nil, err
+	}
+
 	if err := combat.Demarrer(); err != nil {
 		return nil, err
 	}
 
 	// Initialiser les patterns Step C (State Machine, Commands, Observers, Validation)
-	initializer := combatinitializer.NewCombatInitializer(combat)
+	initializer := combatinitializer.NewCombatInitializerWithValidators(combat, e.registry, e.validateurs...)
 	if err := initializer.InitializeAll(); err != nil {
 		return nil, err
 	}
 
+	// Avancer jusqu'au premier tour d'un joueur (les tours de l'IA qui précèdent sont joués)
+	if err := combatfacade.DemarrerCombat(combat); err != nil {
+		return nil, err
+	}
+
 	// Sauvegarder et publier les événements
 	if err := e.saveAndPublishEvents(cmd.CombatID, combat); err != nil {
 		return nil, err
@@ -85,28 +182,16 @@ func (e *CombatEngineImpl) DemarrerCombat(cmd CommandeDemarrerCombat) (*CombatDT
 // ExecuterAction exécute une action dans un combat via la State Machine
 // Refactoré avec Extract Method Pattern pour réduire la complexité
 func (e *CombatEngineImpl) ExecuterAction(cmd CommandeExecuterAction) (*ResultatActionDTO, error) {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
 	// Charger le combat depuis l'Event Store
 	combat, err := e.loadCombatFromEvents(cmd.CombatID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Convertir le type d'action vers CommandType de la facade
-	actionType := convertToCommandType(parseTypeAction(cmd.TypeAction))
-
-	// Préparer les paramètres pour ExecutePlayerAction
-	params, err := buildActionParameters(cmd)
-	if err != nil {
-		return nil, err
-	}
-
-	// Exécuter via combatfacade (architecture Step C)
-	result, err := combatfacade.ExecutePlayerAction(
-		combat,
-		domain.UnitID(cmd.ActeurID),
-		actionType,
-		params,
-	)
+	// Exécuter via combatfacade: la State Machine joue le tour puis passe à la prochaine unité prête
+	result, err := executerActionJoueur(combat, cmd)
 	if err != nil {
 		return nil, err
 	}
@@ -126,9 +211,33 @@ func (e *CombatEngineImpl) ExecuterAction(cmd CommandeExecuterAction) (*Resultat
 	return resultat, nil
 }
 
+// PrevisualiserAction simule une action sur une copie du combat
+// Rien n'est sauvegardé ni publié: le combat chargé est jeté après la simulation
+func (e *CombatEngineImpl) PrevisualiserAction(cmd CommandeExecuterAction) (*PrevisualisationActionDTO, error) {
+	combat, err := e.loadCombatFromEvents(cmd.CombatID)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := buildTypedParameters(e.registry, cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := combatfacade.PreviewPlayerAction(combat, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dto := FromCommandPreview(preview)
+	return &dto, nil
+}
+
 // PasserTour passe au tour suivant via la State Machine
 // Refactoré avec Extract Method Pattern pour réduire la duplication
 func (e *CombatEngineImpl) PasserTour(cmd CommandePasserTour) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
 	// Charger le combat
 	combat, err := e.loadCombatFromEvents(cmd.CombatID)
 	if err != nil {
@@ -141,9 +250,12 @@ func (e *CombatEngineImpl) PasserTour(cmd CommandePasserTour) error {
 		return errors.New("state machine non initialisée")
 	}
 
-	// Déclencher l'événement de fin de tour
-	event := states.StateEvent{Type: states.EventTurnComplete}
-	if err := sm.HandleEvent(event); err != nil {
+	// L'unité active attend: son tour se termine et la State Machine passe à la prochaine unité prête
+	unite := sm.UniteActive()
+	if unite == nil {
+		return &states.ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	if _, err := combatfacade.ExecutePlayerActionTyped(combat, combatfacade.NewWaitAction(unite.ID())); err != nil {
 		return err
 	}
 
@@ -154,6 +266,8 @@ func (e *CombatEngineImpl) PasserTour(cmd CommandePasserTour) error {
 // TerminerCombat termine un combat via la State Machine
 // Refactoré avec Extract Method Pattern pour réduire la duplication
 func (e *CombatEngineImpl) TerminerCombat(cmd CommandeTerminerCombat) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
 	// Charger le combat
 	combat, err := e.loadCombatFromEvents(cmd.CombatID)
 	if err != nil {
@@ -166,19 +280,96 @@ func (e *CombatEngineImpl) TerminerCombat(cmd CommandeTerminerCombat) error {
 		return errors.New("state machine non initialisée")
 	}
 
-	// Déclencher l'événement de finalisation
-	event := states.StateEvent{Type: states.EventFinalizeCombat}
-	if err := sm.HandleEvent(event); err != nil {
+	// Déclencher la finalisation: l'état Finalizing distribue les récompenses (position enregistrée)
+	if err := sm.Finaliser(); err != nil {
 		return err
 	}
 
-	// Distribuer les récompenses
-	combat.DistribuerRecompenses()
-
 	// Sauvegarder et publier les événements
 	return e.saveAndPublishEvents(cmd.CombatID, combat)
 }
 
+// MettreEnPause suspend un combat: le minuteur du tour est annulé, le temps restant est conservé
+func (e *CombatEngineImpl) MettreEnPause(cmd CommandeMettreEnPause) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
+	combat, err := e.loadCombatFromEvents(cmd.CombatID)
+	if err != nil {
+		return err
+	}
+
+	if err := combatfacade.MettreEnPause(combat, cmd.Motif); err != nil {
+		return err
+	}
+
+	return e.saveAndPublishEvents(cmd.CombatID, combat)
+}
+
+// ReprendreCombat relance un combat en pause: le minuteur repart avec le temps restant
+func (e *CombatEngineImpl) ReprendreCombat(cmd CommandeReprendreCombat) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
+	combat, err := e.loadCombatFromEvents(cmd.CombatID)
+	if err != nil {
+		return err
+	}
+
+	if err := combatfacade.ReprendreCombat(combat); err != nil {
+		return err
+	}
+
+	return e.saveAndPublishEvents(cmd.CombatID, combat)
+}
+
+// AnnulerCombat annule un combat: aucun vainqueur, aucune récompense distribuée
+func (e *CombatEngineImpl) AnnulerCombat(cmd CommandeAnnulerCombat) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
+	combat, err := e.loadCombatFromEvents(cmd.CombatID)
+	if err != nil {
+		return err
+	}
+
+	if err := combatfacade.AnnulerCombat(combat, cmd.Motif); err != nil {
+		return err
+	}
+
+	return e.saveAndPublishEvents(cmd.CombatID, combat)
+}
+
+// AnnulerAction annule la dernière action d'un combat d'entraînement
+// L'annulation est ajoutée au flux d'événements: le combat rechargé est rejoué sans l'action
+func (e *CombatEngineImpl) AnnulerAction(cmd CommandeAnnulerAction) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
+	combat, err := e.loadCombatFromEvents(cmd.CombatID)
+	if err != nil {
+		return err
+	}
+
+	if err := combatfacade.UndoLastCommand(combat); err != nil {
+		return err
+	}
+
+	return e.saveAndPublishEvents(cmd.CombatID, combat)
+}
+
+// RetablirAction refait la dernière action annulée d'un combat d'entraînement
+func (e *CombatEngineImpl) RetablirAction(cmd CommandeRetablirAction) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
+	combat, err := e.loadCombatFromEvents(cmd.CombatID)
+	if err != nil {
+		return err
+	}
+
+	if err := combatfacade.RedoLastCommand(combat); err != nil {
+		return err
+	}
+
+	return e.saveAndPublishEvents(cmd.CombatID, combat)
+}
+
 // ObtenirCombat récupère l'état d'un combat
 func (e *CombatEngineImpl) ObtenirCombat(query QueryObtenirCombat) (*CombatDTO, error) {
 	// TODO: Lire depuis une projection (read model) plutôt que reconstruire
@@ -197,6 +388,105 @@ func (e *CombatEngineImpl) ObtenirCombat(query QueryObtenirCombat) (*CombatDTO,
 	return &dto, nil
 }
 
+// ObtenirOrdreTours prévoit l'ordre des prochains tours par simulation ATB
+func (e *CombatEngineImpl) ObtenirOrdreTours(query QueryObtenirOrdreTours) (*OrdreToursDTO, error) {
+	nombre := query.Nombre
+	if nombre <= 0 {
+		nombre = NombreToursPrevusDefaut
+	}
+	if nombre > NombreToursPrevusMax {
+		nombre = NombreToursPrevusMax
+	}
+
+	combat, err := e.loadCombatFromEvents(query.CombatID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pendant un tour, l'unité active est créditée de la part qu'elle conserverait en terminant
+	// maintenant (attendre sans bouger ni agir la fait rejouer plus tôt)
+	scheduler := buildTurnScheduler(combat)
+	if activite := combat.ActiviteTour(); activite != nil {
+		scheduler.RetainGauge(activite.UniteID, combat.ReglesReinitialisationATB().Conservation(*activite))
+	}
+
+	dto := FromForecast(combat, scheduler.Forecast(nombre))
+	return &dto, nil
+}
+
+// ExpirerTour joue l'action par défaut d'un tour dont le délai est écoulé (EventTimeout)
+// L'échéance est revérifiée sous le verrou du combat: une échéance périmée (tour déjà joué,
+// autre unité, délai repoussé par une pause) est ignorée
+func (e *CombatEngineImpl) ExpirerTour(cmd CommandeExpirerTour) error {
+	defer e.verrous.verrouiller(cmd.CombatID)()
+
+	combat, err := e.loadCombatFromEvents(cmd.CombatID)
+	if err != nil {
+		return err
+	}
+
+	echeance := combat.EcheanceTour()
+	if !cmd.concerne(echeance, time.Now()) {
+		return nil
+	}
+
+	sm := combatfacade.GetStateMachine(combat)
+	if sm == nil {
+		return errors.New("state machine non initialisée")
+	}
+
+	if err := sm.ExpirerTour(echeance.UniteID); err != nil {
+		return err
+	}
+
+	return e.saveAndPublishEvents(cmd.CombatID, combat)
+}
+
+// RestaurerEcheances reprogramme les minuteurs après un redémarrage du processus
+// Nécessite un EventStore capable de lister les combats avec échéance (IndexEcheances)
+func (e *CombatEngineImpl) RestaurerEcheances() error {
+	index, ok := e.eventStore.(IndexEcheances)
+	if !ok {
+		return nil
+	}
+
+	combatIDs, err := index.CombatsAvecEcheance()
+	if err != nil {
+		return err
+	}
+
+	for _, combatID := range combatIDs {
+		if err := e.restaurerEcheance(combatID); err != nil {
+			return fmt.Errorf("combat %s: %w", combatID, err)
+		}
+	}
+	return nil
+}
+
+// restaurerEcheance reprogramme le minuteur d'un combat sous son verrou
+func (e *CombatEngineImpl) restaurerEcheance(combatID string) error {
+	defer e.verrous.verrouiller(combatID)()
+
+	combat, err := e.loadCombatFromEvents(combatID)
+	if err != nil {
+		return err
+	}
+	e.minuteur.Programmer(combatID, combat.EcheanceTour())
+	return nil
+}
+
+// expirerTourProgramme est appelé par le minuteur à l'échéance d'un tour
+func (e *CombatEngineImpl) expirerTourProgramme(combatID string, echeance domain.EcheanceTour) {
+	cmd := CommandeExpirerTour{
+		CombatID: combatID,
+		UniteID:  string(echeance.UniteID),
+		Tour:     echeance.Tour,
+	}
+	if err := e.ExpirerTour(cmd); err != nil {
+		log.Printf("expiration du tour de %s (combat %s): %v", echeance.UniteID, combatID, err)
+	}
+}
+
 // parseTypeAction convertit une string en TypeAction
 func parseTypeAction(typeStr string) domain.TypeAction {
 	switch typeStr {
@@ -239,10 +529,14 @@ func (e *CombatEngineImpl) saveAndPublishEvents(combatID string, combat *domain.
 
 	// Clear uncommitted events
 	combat.ClearUncommittedEvents()
+
+	// Programmer (ou annuler) le minuteur du tour en cours
+	e.minuteur.Programmer(combatID, combat.EcheanceTour())
 	return nil
 }
 
 // loadCombatFromEvents charge un combat depuis l'Event Store
+// La State Machine est recréée et reprend la dernière position enregistrée
 func (e *CombatEngineImpl) loadCombatFromEvents(combatID string) (*domain.Combat, error) {
 	events, err := e.eventStore.LoadEvents(combatID)
 	if err != nil {
@@ -254,9 +548,78 @@ func (e *CombatEngineImpl) loadCombatFromEvents(combatID string) (*domain.Combat
 		return nil, err
 	}
 
+	if err := restaurerMachineEtats(combat, e.registry, e.validateurs); err != nil {
+		return nil, fmt.Errorf("restauration de la state machine: %w", err)
+	}
+
 	return combat, nil
 }
 
+// restaurerMachineEtats initialise les patterns Step C d'un combat rechargé et repositionne sa State Machine
+// Un combat sans position enregistrée (jamais démarré par la State Machine) reste sans State Machine
+func restaurerMachineEtats(combat *domain.Combat, registry *commands.CommandRegistry, validateurs []validators.Validator) error {
+	position := combat.PositionMachine()
+	if position == nil {
+		return nil
+	}
+
+	initializer := combatinitializer.NewCombatInitializerWithValidators(combat, registry, validateurs...)
+	if err := initializer.InitializeAll(); err != nil {
+		return err
+	}
+
+	return combatfacade.GetStateMachine(combat).Restaurer(*position)
+}
+
+// buildTurnScheduler construit l'ordonnanceur des tours selon le mode du combat
+// Hors ATB, la prévision reprend à la position enregistrée du round/de la phase
+func buildTurnScheduler(combat *domain.Combat) states.TurnScheduler {
+	if combat.ModeTour() == domain.ModeTourATB {
+		return buildATBSystem(combat)
+	}
+
+	scheduler := states.NewTurnScheduler(combat.ModeTour(), nil)
+	for _, equipe := range combat.Equipes() {
+		for _, unite := range equipe.Membres() {
+			if !unite.EstMorte() {
+				scheduler.InitializeUnit(unite)
+			}
+		}
+	}
+	if round, ok := scheduler.(*states.RoundScheduler); ok && combat.CurseurRound() != nil {
+		round.Restore(*combat.CurseurRound())
+	}
+	return scheduler
+}
+
+// buildATBSystem construit les jauges ATB et les incantations depuis le dernier état enregistré
+// Les unités sans jauge enregistrée partent de 0, les vitesses suivent les statuts actuels
+func buildATBSystem(combat *domain.Combat) *states.ATBSystem {
+	atb := states.NewATBSystem()
+	atb.Restore(combat.JaugesATB())
+	atb.RestoreCharges(combat.ChargesATB())
+
+	enregistrees := make(map[domain.UnitID]bool)
+	for _, jauge := range combat.JaugesATB() {
+		enregistrees[jauge.UniteID] = true
+	}
+
+	for _, equipe := range combat.Equipes() {
+		for _, unite := range equipe.Membres() {
+			if unite.EstMorte() {
+				atb.DeactivateGauge(unite.ID())
+				continue
+			}
+			if !enregistrees[unite.ID()] {
+				atb.InitializeUnitGauge(unite)
+			}
+		}
+	}
+	atb.SyncSpeeds(combat)
+	atb.SetForecastRetention(combat.ReglesReinitialisationATB().ConservationComplete)
+	return atb
+}
+
 // validateDemarrerCommand valide la commande DemarrerCombat
 func validateDemarrerCommand(cmd CommandeDemarrerCombat) error {
 	if cmd.CombatID == "" {
@@ -291,6 +654,25 @@ func buildDomainModel(cmd CommandeDemarrerCombat) ([]*domain.Equipe, *shared.Gri
 	return equipes, grille, nil
 }
 
+// setupInventaires charge le catalogue et remplit l'inventaire de chaque équipe
+func setupInventaires(combat *domain.Combat, cmd CommandeDemarrerCombat) error {
+	combat.SetCatalogueObjets(ToItemCatalogue(cmd.Objets))
+
+	for _, equipeDTO := range cmd.Equipes {
+		for objetID, quantite := range equipeDTO.Inventaire {
+			if combat.ObtenirObjet(objetID) == nil {
+				return fmt.Errorf("objet %s absent du catalogue", objetID)
+			}
+			if quantite < 0 {
+				return fmt.Errorf("quantité invalide pour l'objet %s", objetID)
+			}
+			combat.AjouterObjet(domain.TeamID(equipeDTO.ID), objetID, quantite)
+		}
+	}
+
+	return nil
+}
+
 // convertToCommandType convertit TypeAction vers CommandType de la facade
 func convertToCommandType(typeAction domain.TypeAction) combatfacade.CommandType {
 	switch typeAction {
@@ -309,6 +691,125 @@ func convertToCommandType(typeAction domain.TypeAction) combatfacade.CommandType
 	}
 }
 
+// executerActionJoueur joue une action simple, ou une action composée si des étapes sont fournies
+// Les actions personnalisées passent par les paramètres typés pour transmettre leurs paramètres propres
+func executerActionJoueur(combat *domain.Combat, cmd CommandeExecuterAction) (*commands.CommandResult, error) {
+	acteurID := domain.UnitID(cmd.ActeurID)
+	registry := registreCommandes(combat)
+
+	if len(cmd.Etapes) > 0 || estActionPersonnalisee(registry, cmd.TypeAction) {
+		params, err := buildTypedParameters(registry, cmd)
+		if err != nil {
+			return nil, err
+		}
+		return combatfacade.ExecutePlayerActionTyped(combat, params)
+	}
+
+	// Convertir le type d'action vers CommandType de la facade
+	actionType := convertToCommandType(parseTypeAction(cmd.TypeAction))
+
+	// Préparer les paramètres pour ExecutePlayerAction
+	params, err := buildActionParameters(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return combatfacade.ExecutePlayerAction(combat, acteurID, actionType, params)
+}
+
+// buildTypedParameters construit les paramètres typés d'une action simple ou composée
+func buildTypedParameters(registry *commands.CommandRegistry, cmd CommandeExecuterAction) (combatfacade.ActionParameters, error) {
+	acteurID := domain.UnitID(cmd.ActeurID)
+
+	if len(cmd.Etapes) > 0 {
+		etapes := make([]combatfacade.ActionParameters, 0, len(cmd.Etapes))
+		for i, etape := range cmd.Etapes {
+			params, err := buildEtapeParameters(registry, acteurID, etape)
+			if err != nil {
+				return combatfacade.ActionParameters{}, fmt.Errorf("étape %d: %w", i+1, err)
+			}
+			etapes = append(etapes, params)
+		}
+		return combatfacade.NewCompositeAction(acteurID, etapes...), nil
+	}
+
+	return buildEtapeParameters(registry, acteurID, EtapeActionDTO{
+		TypeAction:    cmd.TypeAction,
+		CibleID:       cmd.CibleID,
+		PositionCible: cmd.PositionCible,
+		CompetenceID:  cmd.CompetenceID,
+		ObjetID:       cmd.ObjetID,
+		Parametres:    cmd.Parametres,
+	})
+}
+
+// registreCommandes retourne le registre des actions personnalisées du combat (celui de sa CommandFactory)
+func registreCommandes(combat *domain.Combat) *commands.CommandRegistry {
+	if factory := combatfacade.GetCommandFactory(combat); factory != nil {
+		return factory.Registry()
+	}
+	return nil
+}
+
+// estActionPersonnalisee indique si le type d'action est un type de commande enregistré (vol, saut...)
+func estActionPersonnalisee(registry *commands.CommandRegistry, typeAction string) bool {
+	_, ok := registry.Lookup(commands.CommandType(typeAction))
+	return ok
+}
+
+// buildCustomParameters construit les paramètres d'une action personnalisée
+// La cible et la position de l'étape sont ajoutées sous les clés communes si elles sont absentes
+func buildCustomParameters(etape EtapeActionDTO) commands.CommandParams {
+	params := make(commands.CommandParams, len(etape.Parametres)+3)
+	for cle, valeur := range etape.Parametres {
+		params[cle] = valeur
+	}
+	if _, ok := params[commands.ParamTargetID]; !ok && etape.CibleID != nil {
+		params[commands.ParamTargetID] = *etape.CibleID
+	}
+	if etape.PositionCible != nil {
+		if _, ok := params[commands.ParamTargetX]; !ok {
+			params[commands.ParamTargetX] = etape.PositionCible.X
+		}
+		if _, ok := params[commands.ParamTargetY]; !ok {
+			params[commands.ParamTargetY] = etape.PositionCible.Y
+		}
+	}
+	return params
+}
+
+// buildEtapeParameters construit les paramètres typés d'une étape d'action composée
+func buildEtapeParameters(registry *commands.CommandRegistry, acteurID domain.UnitID, etape EtapeActionDTO) (combatfacade.ActionParameters, error) {
+	if estActionPersonnalisee(registry, etape.TypeAction) {
+		return combatfacade.NewCustomAction(acteurID, combatfacade.CommandType(etape.TypeAction), buildCustomParameters(etape)), nil
+	}
+
+	params := combatfacade.ActionParameters{
+		ActorID: acteurID,
+		Type:    convertToCommandType(parseTypeAction(etape.TypeAction)),
+		SkillID: etape.CompetenceID,
+		ItemID:  etape.ObjetID,
+	}
+
+	if etape.CibleID != nil {
+		cibleID := domain.UnitID(*etape.CibleID)
+		params.TargetID = &cibleID
+		params.TargetIDs = []domain.UnitID{cibleID}
+	}
+
+	if etape.PositionCible != nil {
+		pos, err := etape.PositionCible.ToPosition()
+		if err != nil {
+			return params, err
+		}
+		x, y := pos.X(), pos.Y()
+		params.TargetX = &x
+		params.TargetY = &y
+	}
+
+	return params, nil
+}
+
 // buildActionParameters construit les paramètres pour ExecutePlayerAction
 func buildActionParameters(cmd CommandeExecuterAction) (map[string]interface{}, error) {
 	params := make(map[string]interface{})
@@ -361,6 +862,12 @@ type EventStore interface {
 	LoadSnapshot(aggregateID string) (version int, data []byte, err error)
 }
 
+// IndexEcheances liste les combats dont le tour en cours a une échéance persistée
+// Implémentation optionnelle de l'EventStore, utilisée par RestaurerEcheances
+type IndexEcheances interface {
+	CombatsAvecEcheance() ([]string, error)
+}
+
 // EventPublisher interface pour publier les événements
 type EventPublisher interface {
 	// Publish publie un événement
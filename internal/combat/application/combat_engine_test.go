@@ -1,9 +1,17 @@
 package application
 
 import (
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/aether-engine/aether-engine/internal/combat/combatfacade"
 	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/validators"
 )
 
 // MockEventStore pour les tests
@@ -143,3 +151,220 @@ func TestDemarrerCombatWithStepC(t *testing.T) {
 	t.Logf("✅ Combat démarré avec Step C: %s (État: %s, Tour: %d)",
 		combatDTO.ID, combatDTO.Etat, combatDTO.TourActuel)
 }
+
+// TestExpirerTour_EcheancePerimee vérifie que le minuteur revérifie l'échéance avant d'expirer un tour
+func TestExpirerTour_EcheancePerimee(t *testing.T) {
+	maintenant := time.Now()
+	echeance := &domain.EcheanceTour{Tour: 3, UniteID: "unit1", Echeance: maintenant}
+
+	cas := []struct {
+		nom      string
+		cmd      CommandeExpirerTour
+		echeance *domain.EcheanceTour
+		attendu  bool
+	}{
+		{"échéance atteinte", CommandeExpirerTour{UniteID: "unit1", Tour: 3}, echeance, true},
+		{"sans tour précisé", CommandeExpirerTour{UniteID: "unit1"}, echeance, true},
+		{"aucune échéance", CommandeExpirerTour{UniteID: "unit1", Tour: 3}, nil, false},
+		{"autre unité", CommandeExpirerTour{UniteID: "unit2", Tour: 3}, echeance, false},
+		{"tour déjà joué", CommandeExpirerTour{UniteID: "unit1", Tour: 2}, echeance, false},
+		{"échéance repoussée", CommandeExpirerTour{UniteID: "unit1", Tour: 3},
+			&domain.EcheanceTour{Tour: 3, UniteID: "unit1", Echeance: maintenant.Add(time.Minute)}, false},
+	}
+
+	for _, c := range cas {
+		if got := c.cmd.concerne(c.echeance, maintenant); got != c.attendu {
+			t.Errorf("%s: concerne = %v, attendu %v", c.nom, got, c.attendu)
+		}
+	}
+}
+
+// TestVerrousCombat_SerialiseParCombat vérifie que les opérations d'un même combat ne se chevauchent pas
+func TestVerrousCombat_SerialiseParCombat(t *testing.T) {
+	verrous := newVerrousCombat()
+	var enCours, maximum int32
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer verrous.verrouiller("combat-1")()
+			n := atomic.AddInt32(&enCours, 1)
+			if n > atomic.LoadInt32(&maximum) {
+				atomic.StoreInt32(&maximum, n)
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&enCours, -1)
+		}()
+	}
+	wg.Wait()
+
+	if maximum != 1 {
+		t.Errorf("%d opérations simultanées sur le même combat (attendu: 1)", maximum)
+	}
+
+	// Un autre combat n'attend pas le verrou du premier
+	libere := verrous.verrouiller("combat-1")
+	verrous.verrouiller("combat-2")()
+	libere()
+}
+
+// demarrerDuelJoueurs démarre un duel entre deux joueurs: unit1 (0,0) face à unit2 (5,5)
+func demarrerDuelJoueurs(t *testing.T, engine CombatEngine, combatID string, entrainement bool) *CombatDTO {
+	t.Helper()
+	joueur1 := "player1"
+	joueur2 := "player2"
+	cmd := CommandeDemarrerCombat{
+		CombatID: combatID,
+		Equipes: []EquipeDTO{
+			{ID: "team1", Nom: "Test", JoueurID: &joueur1, Membres: []UniteDTO{{
+				ID: "unit1", Nom: "Test Unit", TeamID: "team1",
+				Stats:    StatsDTO{HP: 100, MP: 50, Stamina: 20, SPD: 12, MOV: 3, ATK: 10, DEF: 5},
+				Position: PositionDTO{X: 0, Y: 0},
+			}}},
+			{ID: "team2", Nom: "Test2", JoueurID: &joueur2, Membres: []UniteDTO{{
+				ID: "unit2", Nom: "Test Unit 2", TeamID: "team2",
+				Stats:    StatsDTO{HP: 100, MP: 50, Stamina: 20, SPD: 10, MOV: 3, ATK: 10, DEF: 5},
+				Position: PositionDTO{X: 5, Y: 5},
+			}}},
+		},
+		Grille:       GrilleDTO{Largeur: 10, Hauteur: 10},
+		Entrainement: entrainement,
+	}
+
+	combatDTO, err := engine.DemarrerCombat(cmd)
+	if err != nil {
+		t.Fatalf("Erreur démarrage: %v", err)
+	}
+	return combatDTO
+}
+
+// uniteActive recharge le combat et retourne l'unité dont c'est le tour
+func uniteActive(t *testing.T, engine CombatEngine, combatID string) string {
+	t.Helper()
+	combat, err := engine.(*CombatEngineImpl).loadCombatFromEvents(combatID)
+	if err != nil {
+		t.Fatalf("Erreur chargement: %v", err)
+	}
+	unite := combatfacade.GetStateMachine(combat).UniteActive()
+	if unite == nil {
+		t.Fatalf("Aucune unité active")
+	}
+	return string(unite.ID())
+}
+
+// deplacementVoisin retourne un déplacement d'une case pour l'unité active du duel
+func deplacementVoisin(combatID, uniteActive string) CommandeExecuterAction {
+	cible := &PositionDTO{X: 1, Y: 0}
+	if uniteActive == "unit2" {
+		cible = &PositionDTO{X: 5, Y: 4}
+	}
+	return CommandeExecuterAction{CombatID: combatID, ActeurID: uniteActive, TypeAction: "deplacement", PositionCible: cible}
+}
+
+// TestNewCombatEngineWithValidators_RegleConserveeAuRechargement vérifie qu'une règle du moteur
+// s'applique encore après chaque sauvegarde et rechargement du combat
+func TestNewCombatEngineWithValidators_RegleConserveeAuRechargement(t *testing.T) {
+	sansDeplacement := validators.NewRuleValidator("sans déplacement", func(cmd commands.Command) error {
+		if cmd.GetType() == commands.CommandTypeMove {
+			return errors.New("déplacement interdit")
+		}
+		return nil
+	})
+	engine := NewCombatEngineWithValidators(NewMockEventStore(), NewMockEventPublisher(), commands.NewCommandRegistry(), sansDeplacement)
+	combatDTO := demarrerDuelJoueurs(t, engine, "combat-regles", false)
+	premier := uniteActive(t, engine, combatDTO.ID)
+
+	// Premier rechargement: la règle refuse le déplacement
+	if _, err := engine.ExecuterAction(deplacementVoisin(combatDTO.ID, premier)); err == nil || !strings.Contains(err.Error(), "déplacement interdit") {
+		t.Fatalf("Le déplacement devrait être refusé par la règle, obtenu: %v", err)
+	}
+
+	// Les autres actions restent possibles et sont sauvegardées
+	if _, err := engine.ExecuterAction(CommandeExecuterAction{CombatID: combatDTO.ID, ActeurID: premier, TypeAction: "passer"}); err != nil {
+		t.Fatalf("Passer devrait être accepté: %v", err)
+	}
+
+	// Rechargement suivant: la règle s'applique toujours, à l'unité suivante
+	suivant := uniteActive(t, engine, combatDTO.ID)
+	if suivant == premier {
+		t.Fatalf("Le tour devrait être passé à l'autre unité")
+	}
+	if _, err := engine.ExecuterAction(deplacementVoisin(combatDTO.ID, suivant)); err == nil || !strings.Contains(err.Error(), "déplacement interdit") {
+		t.Errorf("La règle devrait encore s'appliquer après rechargement, obtenu: %v", err)
+	}
+}
+
+// TestAnnulerAction_SurvitAuRechargement vérifie qu'une action sauvegardée d'un combat d'entraînement
+// peut être annulée puis refaite après rechargement depuis l'Event Store
+func TestAnnulerAction_SurvitAuRechargement(t *testing.T) {
+	engine := NewCombatEngine(NewMockEventStore(), NewMockEventPublisher())
+	combatDTO := demarrerDuelJoueurs(t, engine, "combat-entrainement", true)
+	premier := uniteActive(t, engine, combatDTO.ID)
+	position := func() PositionDTO {
+		combat, err := engine.(*CombatEngineImpl).loadCombatFromEvents(combatDTO.ID)
+		if err != nil {
+			t.Fatalf("Erreur chargement: %v", err)
+		}
+		pos := combat.TrouverUnite(domain.UnitID(premier)).Position()
+		return PositionDTO{X: pos.X(), Y: pos.Y()}
+	}
+	depart := position()
+
+	// Action sauvegardée: le tour passe à l'autre unité
+	if _, err := engine.ExecuterAction(deplacementVoisin(combatDTO.ID, premier)); err != nil {
+		t.Fatalf("Erreur action: %v", err)
+	}
+	arrivee := position()
+	if arrivee == depart || uniteActive(t, engine, combatDTO.ID) == premier {
+		t.Fatalf("Le déplacement devrait être joué et le tour passé à l'autre unité")
+	}
+
+	// Annuler sur le combat rechargé: la main revient à la première unité, à son point de départ
+	if err := engine.AnnulerAction(CommandeAnnulerAction{CombatID: combatDTO.ID}); err != nil {
+		t.Fatalf("Erreur annulation: %v", err)
+	}
+	if position() != depart || uniteActive(t, engine, combatDTO.ID) != premier {
+		t.Errorf("Le déplacement devrait être annulé après rechargement, position: %+v", position())
+	}
+	dto, err := engine.ObtenirCombat(QueryObtenirCombat{CombatID: combatDTO.ID})
+	if err != nil {
+		t.Fatalf("Erreur lecture: %v", err)
+	}
+	if dto.PeutAnnuler || !dto.PeutRetablir {
+		t.Errorf("Seule l'action annulée devrait pouvoir être refaite, obtenu: %+v", dto)
+	}
+
+	// Refaire sur le combat rechargé: le déplacement est rejoué
+	if err := engine.RetablirAction(CommandeRetablirAction{CombatID: combatDTO.ID}); err != nil {
+		t.Fatalf("Erreur redo: %v", err)
+	}
+	if position() != arrivee || uniteActive(t, engine, combatDTO.ID) == premier {
+		t.Errorf("Le déplacement devrait être refait après rechargement, position: %+v", position())
+	}
+	dto, err = engine.ObtenirCombat(QueryObtenirCombat{CombatID: combatDTO.ID})
+	if err != nil {
+		t.Fatalf("Erreur lecture: %v", err)
+	}
+	if !dto.PeutAnnuler || dto.PeutRetablir {
+		t.Errorf("L'action refaite devrait pouvoir être annulée à nouveau, obtenu: %+v", dto)
+	}
+}
+
+// TestAnnulerAction_RefuseeHorsEntrainement vérifie qu'un combat classé ne permet pas d'annuler
+func TestAnnulerAction_RefuseeHorsEntrainement(t *testing.T) {
+	engine := NewCombatEngine(NewMockEventStore(), NewMockEventPublisher())
+	combatDTO := demarrerDuelJoueurs(t, engine, "combat-classe", false)
+	premier := uniteActive(t, engine, combatDTO.ID)
+	if _, err := engine.ExecuterAction(deplacementVoisin(combatDTO.ID, premier)); err != nil {
+		t.Fatalf("Erreur action: %v", err)
+	}
+
+	if err := engine.AnnulerAction(CommandeAnnulerAction{CombatID: combatDTO.ID}); err == nil {
+		t.Errorf("L'annulation devrait être refusée hors entraînement")
+	}
+	if uniteActive(t, engine, combatDTO.ID) == premier {
+		t.Errorf("Le déplacement ne devrait pas être annulé hors entraînement")
+	}
+}
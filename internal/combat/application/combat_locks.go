@@ -0,0 +1,30 @@
+package application
+
+import "sync"
+
+// verrousCombat sérialise les opérations d'un même combat
+// Chaque opération recharge le flux, joue sur sa copie puis enregistre: sans verrou, une action
+// du joueur et l'expiration du minuteur joueraient le même tour sur deux copies du combat
+type verrousCombat struct {
+	mu      sync.Mutex
+	verrous map[string]*sync.Mutex
+}
+
+// newVerrousCombat crée un registre de verrous vide
+func newVerrousCombat() *verrousCombat {
+	return &verrousCombat{verrous: make(map[string]*sync.Mutex)}
+}
+
+// verrouiller prend le verrou du combat et retourne la fonction qui le relâche
+func (v *verrousCombat) verrouiller(combatID string) func() {
+	v.mu.Lock()
+	verrou, exists := v.verrous[combatID]
+	if !exists {
+		verrou = &sync.Mutex{}
+		v.verrous[combatID] = verrou
+	}
+	v.mu.Unlock()
+
+	verrou.Lock()
+	return verrou.Unlock
+}
@@ -1,7 +1,12 @@
 package application
 
 import (
+	"sort"
+	"time"
+
 	"github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/states"
 	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
 
@@ -10,16 +15,60 @@ type CommandeDemarrerCombat struct {
 	CombatID string
 	Equipes  []EquipeDTO
 	Grille   GrilleDTO
+	Objets   []ObjetDTO // Catalogue d'objets (catalogue par défaut si vide)
+
+	ReglesStamina *ReglesStaminaDTO // Règles de Stamina (règles par défaut si nil)
+	ModeTour      string            // "ATB" (défaut), "CTB", "PHASE_EQUIPES"
+	DelaiTour     *DelaiTourDTO     // Temps accordé par tour (pas de limite si nil)
+
+	ReglesJaugeATB *ReglesJaugeATBDTO // Part de jauge ATB conservée en fin de tour (règles par défaut si nil)
+
+	Entrainement bool // Combat d'entraînement: annuler/refaire illimités (jamais en classé)
+}
+
+// ReglesJaugeATBDTO représente la part de jauge ATB (%) conservée selon l'activité du tour
+type ReglesJaugeATBDTO struct {
+	ConservationAttente     int // Ni déplacement ni action
+	ConservationDeplacement int // Déplacement seul
+	ConservationAction      int // Action sans déplacement
+	ConservationComplete    int // Déplacement et action
+}
+
+// DelaiTourDTO représente le temps accordé à chaque tour d'un joueur
+type DelaiTourDTO struct {
+	DureeSecondes   int
+	ActionParDefaut string // "ATTENTE" (défaut), "IA"
+}
+
+// ReglesStaminaDTO représente les coûts et la régénération de Stamina
+type ReglesStaminaDTO struct {
+	CoutParCase            int
+	CoutAttaque            int
+	CoutCompetencePhysique int
+	TauxRegeneration       int // % de la Stamina max régénéré par tour
+}
+
+// ObjetDTO représente la définition d'un objet du catalogue
+type ObjetDTO struct {
+	ID          string
+	Nom         string
+	Description string
+	Type        string // "Potion", "Ether", "Antidote", "Revive", "Bomb"
+	Valeur      int
+	Portee      int  // Portée de lancer
+	Rayon       int  // Rayon de zone (0 = cible unique)
+	TirAmi      bool // La zone touche aussi les alliés
 }
 
 // EquipeDTO représente une équipe dans les commandes
 type EquipeDTO struct {
-	ID       string
-	Nom      string
-	Couleur  string
-	IsIA     bool
-	JoueurID *string
-	Membres  []UniteDTO
+	ID         string
+	Nom        string
+	Couleur    string
+	IsIA       bool
+	JoueurID   *string
+	Membres    []UniteDTO
+	Inventaire map[string]int // ObjetID → quantité (initiale en entrée, restante en sortie)
 }
 
 // UniteDTO représente une unité dans les commandes
@@ -29,6 +78,19 @@ type UniteDTO struct {
 	TeamID   string
 	Stats    StatsDTO
 	Position PositionDTO
+
+	// Sortie uniquement
+	Etat             string // "ACTIVE", "KO", "MORTE"
+	CompteAReboursKO int    // Tours restants avant la mort définitive (si KO)
+}
+
+// ButinDTO représente un cristal ou un coffre posé sur la grille
+type ButinDTO struct {
+	ID       string
+	Type     string // "CRISTAL", "COFFRE"
+	Position PositionDTO
+	SourceID string
+	ObjetID  string
 }
 
 // StatsDTO représente des stats dans les commandes
@@ -61,11 +123,28 @@ type GrilleDTO struct {
 type CommandeExecuterAction struct {
 	CombatID      string
 	ActeurID      string
-	TypeAction    string // "attaque", "competence", "deplacement", "objet", "passer"
+	TypeAction    string // "attaque", "competence", "deplacement", "objet", "passer" ou type personnalisé
 	CibleID       *string
 	PositionCible *PositionDTO
 	CompetenceID  *string
 	ObjetID       *string
+
+	// Paramètres propres à une action personnalisée (TypeAction enregistré, ex: "vol")
+	Parametres map[string]interface{}
+
+	// Action composée jouée d'un bloc dans le tour (ex: deplacement, competence, passer)
+	// Si non vide, les champs d'action ci-dessus sont ignorés
+	Etapes []EtapeActionDTO
+}
+
+// EtapeActionDTO représente une étape d'une action composée
+type EtapeActionDTO struct {
+	TypeAction    string // "attaque", "competence", "deplacement", "objet", "passer" ou type personnalisé
+	CibleID       *string
+	PositionCible *PositionDTO
+	CompetenceID  *string
+	ObjetID       *string
+	Parametres    map[string]interface{}
 }
 
 // CommandePasserTour - Commande pour passer au tour suivant
@@ -73,6 +152,53 @@ type CommandePasserTour struct {
 	CombatID string
 }
 
+// CommandeExpirerTour - Commande émise par le minuteur quand le délai d'un tour est écoulé
+// Tour identifie le tour de l'échéance programmée (zéro: seule l'unité est vérifiée)
+type CommandeExpirerTour struct {
+	CombatID string
+	UniteID  string
+	Tour     int
+}
+
+// concerne indique si la commande vise l'échéance en cours et si celle-ci est atteinte
+// (une échéance repoussée, par une pause par exemple, n'est pas encore atteinte)
+func (cmd CommandeExpirerTour) concerne(echeance *domain.EcheanceTour, maintenant time.Time) bool {
+	if echeance == nil || echeance.UniteID != domain.UnitID(cmd.UniteID) {
+		return false
+	}
+	if cmd.Tour != 0 && echeance.Tour != cmd.Tour {
+		return false
+	}
+	return !maintenant.Before(echeance.Echeance)
+}
+
+// CommandeMettreEnPause - Commande du MJ pour suspendre un combat (litige)
+type CommandeMettreEnPause struct {
+	CombatID string
+	Motif    string
+}
+
+// CommandeReprendreCombat - Commande du MJ pour relancer un combat en pause
+type CommandeReprendreCombat struct {
+	CombatID string
+}
+
+// CommandeAnnulerCombat - Commande du MJ pour annuler un combat (incident serveur)
+type CommandeAnnulerCombat struct {
+	CombatID string
+	Motif    string
+}
+
+// CommandeAnnulerAction - Commande d'un joueur pour annuler sa dernière action (entraînement uniquement)
+type CommandeAnnulerAction struct {
+	CombatID string
+}
+
+// CommandeRetablirAction - Commande d'un joueur pour refaire la dernière action annulée (entraînement uniquement)
+type CommandeRetablirAction struct {
+	CombatID string
+}
+
 // CommandeTerminerCombat - Commande pour terminer un combat
 type CommandeTerminerCombat struct {
 	CombatID string
@@ -83,6 +209,35 @@ type QueryObtenirCombat struct {
 	CombatID string
 }
 
+// Bornes de la prévision d'ordre des tours
+const (
+	NombreToursPrevusDefaut = 10
+	NombreToursPrevusMax    = 50
+)
+
+// QueryObtenirOrdreTours - Query pour prévoir l'ordre des prochains tours
+type QueryObtenirOrdreTours struct {
+	CombatID string
+	Nombre   int // Nombre d'entrées à prévoir (NombreToursPrevusDefaut si <= 0)
+}
+
+// OrdreToursDTO représente la prévision d'ordre des tours (timeline)
+type OrdreToursDTO struct {
+	CombatID string
+	ModeTour string
+	Tours    []TourPrevuDTO
+}
+
+// TourPrevuDTO représente une entrée de la timeline
+type TourPrevuDTO struct {
+	Rang    int
+	UniteID string
+	Nom     string
+	Type    string // "TURN", "CHARGE"
+	Libelle string // Action chargée (vide pour un tour)
+	Ticks   int    // Ticks ATB (ou rounds/phases en CTB/phases d'équipes) avant l'entrée
+}
+
 // CombatDTO représente l'état d'un combat (Read Model)
 type CombatDTO struct {
 	ID          string
@@ -92,7 +247,13 @@ type CombatDTO struct {
 	TourActuel  int
 	UniteActive string
 	Phase       string
+	ModeTour    string
 	Version     int
+	Butins      []ButinDTO
+
+	Entrainement bool
+	PeutAnnuler  bool // Entraînement: une action peut être annulée
+	PeutRetablir bool // Entraînement: une action annulée peut être refaite
 }
 
 // ResultatActionDTO représente le résultat d'une action
@@ -109,6 +270,42 @@ type EffetDTO struct {
 	Valeur  int
 }
 
+// PrevisualisationActionDTO représente la prévision d'une action simulée sans être jouée
+type PrevisualisationActionDTO struct {
+	Valide  bool
+	Raison  string // Raison du refus si l'action est invalide
+	Message string
+
+	CoutMP          int
+	CoutStamina     int
+	CoutDeplacement int
+
+	Cases  []PositionDTO
+	Unites []PrevisionUniteDTO
+}
+
+// PrevisionUniteDTO représente les effets attendus d'une action sur une unité
+type PrevisionUniteDTO struct {
+	UniteID       string
+	DegatsMin     int // Fourchette égale aux dégâts simulés (pas de critique tiré)
+	DegatsMax     int
+	Soins         int
+	ChanceToucher int // %, fixe: 100 pour une unité touchée, 0 pour un effet contré ou renvoyé
+
+	HPAvant      int
+	HPApres      int
+	MPAvant      int
+	MPApres      int
+	StaminaAvant int
+	StaminaApres int
+	Position     PositionDTO
+
+	KO               bool
+	Relevee          bool
+	StatutsAppliques []shared.TypeStatut
+	StatutsRetires   []shared.TypeStatut
+}
+
 // Conversion helpers
 
 // ToUnite convertit UniteDTO vers domain.Unite
@@ -191,6 +388,65 @@ func (dto EquipeDTO) ToEquipe() (*domain.Equipe, error) {
 	return equipe, nil
 }
 
+// ToItem convertit ObjetDTO vers shared.Item
+func (dto ObjetDTO) ToItem() *shared.Item {
+	return &shared.Item{
+		ID:           dto.ID,
+		Name:         dto.Nom,
+		Description:  dto.Description,
+		ItemType:     dto.Type,
+		EffectVal:    dto.Valeur,
+		Range:        dto.Portee,
+		AreaRadius:   dto.Rayon,
+		FriendlyFire: dto.TirAmi,
+	}
+}
+
+// ToReglesStamina convertit ReglesStaminaDTO vers domain.ReglesStamina
+func (dto ReglesStaminaDTO) ToReglesStamina() *domain.ReglesStamina {
+	return &domain.ReglesStamina{
+		CoutParCase:            dto.CoutParCase,
+		CoutAttaque:            dto.CoutAttaque,
+		CoutCompetencePhysique: dto.CoutCompetencePhysique,
+		TauxRegeneration:       dto.TauxRegeneration,
+	}
+}
+
+// ToReglesReinitialisationATB convertit ReglesJaugeATBDTO vers domain.ReglesReinitialisationATB
+func (dto ReglesJaugeATBDTO) ToReglesReinitialisationATB() *domain.ReglesReinitialisationATB {
+	return &domain.ReglesReinitialisationATB{
+		ConservationAttente:     dto.ConservationAttente,
+		ConservationDeplacement: dto.ConservationDeplacement,
+		ConservationAction:      dto.ConservationAction,
+		ConservationComplete:    dto.ConservationComplete,
+	}
+}
+
+// ToReglesDelaiTour convertit DelaiTourDTO vers domain.ReglesDelaiTour
+func (dto DelaiTourDTO) ToReglesDelaiTour() *domain.ReglesDelaiTour {
+	action := domain.ActionExpiration(dto.ActionParDefaut)
+	if action == "" {
+		action = domain.ActionExpirationAttente
+	}
+	return &domain.ReglesDelaiTour{
+		Duree:           time.Duration(dto.DureeSecondes) * time.Second,
+		ActionParDefaut: action,
+	}
+}
+
+// ToItemCatalogue construit le catalogue d'objets du combat
+func ToItemCatalogue(objets []ObjetDTO) *domain.ItemCatalogue {
+	if len(objets) == 0 {
+		return domain.NewDefaultItemCatalogue()
+	}
+
+	catalogue := domain.NewItemCatalogue()
+	for _, objet := range objets {
+		_ = catalogue.Register(objet.ToItem())
+	}
+	return catalogue
+}
+
 // FromUnite convertit domain.Unite vers UniteDTO
 func FromUnite(unite *domain.Unite) UniteDTO {
 	return UniteDTO{
@@ -202,6 +458,34 @@ func FromUnite(unite *domain.Unite) UniteDTO {
 			X: unite.Position().X(),
 			Y: unite.Position().Y(),
 		},
+		Etat:             etatUnite(unite),
+		CompteAReboursKO: unite.CompteAReboursKO(),
+	}
+}
+
+// etatUnite retourne l'état de vie d'une unité pour les DTOs
+func etatUnite(unite *domain.Unite) string {
+	switch {
+	case unite.EstMorte():
+		return "MORTE"
+	case unite.EstKO():
+		return "KO"
+	default:
+		return "ACTIVE"
+	}
+}
+
+// FromButin convertit domain.Butin vers ButinDTO
+func FromButin(butin *domain.Butin) ButinDTO {
+	return ButinDTO{
+		ID:   butin.ID(),
+		Type: string(butin.Type()),
+		Position: PositionDTO{
+			X: butin.Position().X(),
+			Y: butin.Position().Y(),
+		},
+		SourceID: string(butin.SourceID()),
+		ObjetID:  string(butin.ObjetID()),
 	}
 }
 
@@ -237,10 +521,57 @@ func FromEquipe(equipe *domain.Equipe) EquipeDTO {
 	}
 }
 
+// FromInventaire convertit domain.TeamInventory vers les quantités restantes
+func FromInventaire(inventaire *domain.TeamInventory) map[string]int {
+	quantites := make(map[string]int)
+	if inventaire == nil {
+		return quantites
+	}
+	for objetID, quantite := range inventaire.Quantities() {
+		quantites[string(objetID)] = quantite
+	}
+	return quantites
+}
+
+// FromForecast convertit la prévision ATB vers OrdreToursDTO
+func FromForecast(combat *domain.Combat, forecast []states.TurnForecast) OrdreToursDTO {
+	tours := make([]TourPrevuDTO, 0, len(forecast))
+	for _, entree := range forecast {
+		nom := ""
+		if unite := combat.TrouverUnite(entree.UnitID); unite != nil {
+			nom = unite.Nom()
+		}
+		tours = append(tours, TourPrevuDTO{
+			Rang:    entree.Rank,
+			UniteID: string(entree.UnitID),
+			Nom:     nom,
+			Type:    string(entree.Type),
+			Libelle: entree.Label,
+			Ticks:   entree.Ticks,
+		})
+	}
+
+	return OrdreToursDTO{
+		CombatID: combat.ID(),
+		ModeTour: string(combat.ModeTour()),
+		Tours:    tours,
+	}
+}
+
 // FromCombat convertit domain.Combat vers CombatDTO
 func FromCombat(combat *domain.Combat) CombatDTO {
-	equipes := make([]EquipeDTO, 0)
-	// TODO: Récupérer les équipes du combat
+	equipes := make([]EquipeDTO, 0, len(combat.Equipes()))
+	for _, equipe := range combat.Equipes() {
+		dto := FromEquipe(equipe)
+		dto.Inventaire = FromInventaire(combat.InventaireEquipe(equipe.ID()))
+		equipes = append(equipes, dto)
+	}
+	sort.Slice(equipes, func(i, j int) bool { return equipes[i].ID < equipes[j].ID })
+
+	butins := make([]ButinDTO, 0)
+	for _, butin := range combat.Butins() {
+		butins = append(butins, FromButin(butin))
+	}
 
 	return CombatDTO{
 		ID:          combat.ID(),
@@ -249,6 +580,53 @@ func FromCombat(combat *domain.Combat) CombatDTO {
 		TourActuel:  combat.TourActuel(),
 		UniteActive: "", // LEGACY - Géré par State Machine maintenant
 		Phase:       "", // LEGACY - Géré par State Machine maintenant
+		ModeTour:    string(combat.ModeTour()),
 		Version:     combat.Version(),
+		Butins:      butins,
+
+		Entrainement: combat.ModeEntrainement(),
+		PeutAnnuler:  combat.PeutAnnulerAction(),
+		PeutRetablir: combat.PeutRetablirAction(),
+	}
+}
+
+// FromCommandPreview convertit commands.CommandPreview vers PrevisualisationActionDTO
+func FromCommandPreview(preview *commands.CommandPreview) PrevisualisationActionDTO {
+	cases := make([]PositionDTO, 0, len(preview.Tiles))
+	for _, tile := range preview.Tiles {
+		cases = append(cases, PositionDTO{X: tile.X(), Y: tile.Y()})
+	}
+
+	unites := make([]PrevisionUniteDTO, 0, len(preview.Units))
+	for _, unit := range preview.Units {
+		unites = append(unites, PrevisionUniteDTO{
+			UniteID:          string(unit.UnitID),
+			DegatsMin:        unit.DamageMin,
+			DegatsMax:        unit.DamageMax,
+			Soins:            unit.Healing,
+			ChanceToucher:    unit.HitChance,
+			HPAvant:          unit.HPBefore,
+			HPApres:          unit.HPAfter,
+			MPAvant:          unit.MPBefore,
+			MPApres:          unit.MPAfter,
+			StaminaAvant:     unit.StaminaBefore,
+			StaminaApres:     unit.StaminaAfter,
+			Position:         PositionDTO{X: unit.Position.X(), Y: unit.Position.Y()},
+			KO:               unit.KO,
+			Relevee:          unit.Revived,
+			StatutsAppliques: unit.StatusesApplied,
+			StatutsRetires:   unit.StatusesRemoved,
+		})
+	}
+
+	return PrevisualisationActionDTO{
+		Valide:          preview.Valid,
+		Raison:          preview.Reason,
+		Message:         preview.Message,
+		CoutMP:          preview.CostMP,
+		CoutStamina:     preview.CostStamina,
+		CoutDeplacement: preview.CostMovement,
+		Cases:           cases,
+		Unites:          unites,
 	}
 }
@@ -0,0 +1,70 @@
+package application
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain"
+)
+
+// TurnTimer programme en mémoire l'expiration des tours (un minuteur par combat)
+// Les échéances sont persistées dans le flux d'événements: au redémarrage,
+// CombatEngine.RestaurerEcheances reprogramme les minuteurs
+type TurnTimer struct {
+	mu      sync.Mutex
+	timers  map[string]*time.Timer
+	expirer func(combatID string, echeance domain.EcheanceTour)
+}
+
+// NewTurnTimer crée un programmateur d'échéances appelant expirer à chaque échéance atteinte
+// expirer s'exécute hors du verrou du programmateur: il doit revérifier l'échéance sous celui du combat
+func NewTurnTimer(expirer func(combatID string, echeance domain.EcheanceTour)) *TurnTimer {
+	return &TurnTimer{
+		timers:  make(map[string]*time.Timer),
+		expirer: expirer,
+	}
+}
+
+// Programmer remplace le minuteur du combat par l'échéance fournie (nil annule)
+// Une échéance déjà dépassée expire immédiatement
+func (t *TurnTimer) Programmer(combatID string, echeance *domain.EcheanceTour) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if timer, exists := t.timers[combatID]; exists {
+		timer.Stop()
+		delete(t.timers, combatID)
+	}
+	if echeance == nil {
+		return
+	}
+
+	programmee := *echeance
+	var timer *time.Timer
+	timer = time.AfterFunc(time.Until(echeance.Echeance), func() {
+		t.mu.Lock()
+		if t.timers[combatID] != timer {
+			// Remplacé ou annulé entre-temps
+			t.mu.Unlock()
+			return
+		}
+		delete(t.timers, combatID)
+		t.mu.Unlock()
+
+		t.expirer(combatID, programmee)
+	})
+	t.timers[combatID] = timer
+}
+
+// Annuler supprime le minuteur d'un combat
+func (t *TurnTimer) Annuler(combatID string) {
+	t.Programmer(combatID, nil)
+}
+
+// EstProgramme indique si une échéance est programmée pour un combat
+func (t *TurnTimer) EstProgramme(combatID string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, exists := t.timers[combatID]
+	return exists
+}
@@ -3,11 +3,13 @@ package combatfacade
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/observers"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/states"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/validators"
 )
 
 // Step C - Fonctions de Facade pour éviter les cycles d'imports
@@ -36,6 +38,11 @@ func SetupObservers(c *domain.Combat, subject *observers.CombatSubject) {
 	c.SetObserverSubject(subject)
 }
 
+// SetupValidation initialise la chaîne de validation (appelé depuis combatinitializer)
+func SetupValidation(c *domain.Combat, chain *validators.ValidationChain) {
+	c.SetValidationChain(chain)
+}
+
 // GetStateMachine retourne la state machine (type concret pour les tests)
 // Type assertion sécurisée avec vérification
 func GetStateMachine(c *domain.Combat) *states.CombatStateMachine {
@@ -85,6 +92,39 @@ func GetObserverSubject(c *domain.Combat) *observers.CombatSubject {
 	return nil
 }
 
+// GetValidationChain retourne la chaîne de validation
+func GetValidationChain(c *domain.Combat) *validators.ValidationChain {
+	provider := c.GetValidationChain()
+	if provider == nil {
+		return nil
+	}
+	if chain, ok := provider.(*validators.ValidationChain); ok {
+		return chain
+	}
+	return nil
+}
+
+// AddValidator ajoute un validateur personnalisé en fin de chaîne (règles propres au combat)
+func AddValidator(c *domain.Combat, validator validators.Validator) error {
+	chain := GetValidationChain(c)
+	if chain == nil {
+		return errors.New("chaîne de validation non initialisée")
+	}
+	chain.Add(validator)
+	return nil
+}
+
+// ValidateCommand valide une commande avant exécution
+// Chaîne du combat (Status → Cost → Range → Target → règles personnalisées), puis règles propres à la commande
+func ValidateCommand(c *domain.Combat, cmd commands.Command) error {
+	if chain := c.GetValidationChain(); chain != nil {
+		if err := chain.Validate(cmd); err != nil {
+			return err
+		}
+	}
+	return cmd.Validate()
+}
+
 // InitializeCombatWithStateMachine initialise le combat avec la state machine
 // Configure les listeners pour les événements de transition d'état
 func InitializeCombatWithStateMachine(c *domain.Combat) error {
@@ -99,7 +139,63 @@ func InitializeCombatWithStateMachine(c *domain.Combat) error {
 	return nil
 }
 
+// DemarrerCombat lance la state machine et avance jusqu'au premier tour d'un joueur
+// Les tours de l'IA qui précèdent sont joués
+func DemarrerCombat(c *domain.Combat) error {
+	sm := GetStateMachine(c)
+	if sm == nil {
+		return errors.New("state machine non initialisée")
+	}
+	return sm.Demarrer()
+}
+
+// SoumettreCommande fait jouer une commande par la state machine du combat
+// Validating → Confirmed → Executing → ApplyingEffects → CheckVictory → TurnEnd, puis avance
+// jusqu'à la prochaine unité qui attend l'action d'un joueur
+// Erreurs typées: *states.ErreurHorsTour (pas le tour de l'acteur), *states.ErreurEtatInvalide
+func SoumettreCommande(c *domain.Combat, cmd commands.Command) (*commands.CommandResult, error) {
+	sm := GetStateMachine(c)
+	if sm == nil {
+		return nil, errors.New("state machine non initialisée")
+	}
+	result, err := sm.SoumettreCommande(cmd)
+	// Une commande exécutée entre dans l'historique (annuler/refaire en entraînement)
+	if result != nil {
+		if invoker := GetCommandInvoker(c); invoker != nil {
+			invoker.Record(cmd)
+		}
+	}
+	return result, err
+}
+
+// MettreEnPause suspend le combat (litige): délai du tour gelé, ATB figée, actions refusées
+// Sans state machine (combat rechargé depuis l'Event Store), seul l'agrégat est suspendu
+func MettreEnPause(c *domain.Combat, motif string) error {
+	if sm := GetStateMachine(c); sm != nil {
+		return sm.MettreEnPause(motif)
+	}
+	return c.MettreEnPause(motif, time.Now())
+}
+
+// ReprendreCombat relance un combat en pause avec le temps de tour qu'il restait
+func ReprendreCombat(c *domain.Combat) error {
+	if sm := GetStateMachine(c); sm != nil {
+		return sm.Reprendre()
+	}
+	return c.Reprendre(time.Now())
+}
+
+// AnnulerCombat met fin au combat sans vainqueur ni récompenses (incident serveur)
+func AnnulerCombat(c *domain.Combat, motif string) error {
+	if sm := GetStateMachine(c); sm != nil {
+		return sm.Annuler(motif)
+	}
+	return c.Annuler(motif)
+}
+
 // CommandType représente le type d'action à exécuter
+// Les types personnalisés (vol, saut...) sont ceux du registre de la CommandFactory du combat,
+// sans distinction de casse: CommandType("steal") crée une commande de type "STEAL"
 type CommandType string
 
 const (
@@ -109,6 +205,9 @@ const (
 	CommandTypeItem   CommandType = "item"
 	CommandTypeFlee   CommandType = "flee"
 	CommandTypeWait   CommandType = "wait"
+
+	// CommandTypeComposite joue plusieurs actions en un seul tour (déplacement, compétence, fin de tour)
+	CommandTypeComposite CommandType = "composite"
 )
 
 // ActionParameters regroupe les paramètres d'une action de joueur
@@ -131,6 +230,12 @@ type ActionParameters struct {
 
 	// Paramètres d'item
 	ItemID *string
+
+	// Étapes d'une action composite, jouées dans l'ordre par le même acteur
+	Steps []ActionParameters
+
+	// Paramètres d'une action personnalisée, passés à son décodeur
+	Params commands.CommandParams
 }
 
 // NewMoveAction crée des paramètres pour une action de déplacement
@@ -172,6 +277,17 @@ func NewItemAction(actorID domain.UnitID, itemID string, targetID domain.UnitID)
 	}
 }
 
+// NewItemActionAtPosition crée des paramètres pour lancer un item sur une case
+func NewItemActionAtPosition(actorID domain.UnitID, itemID string, targetX, targetY int) ActionParameters {
+	return ActionParameters{
+		ActorID: actorID,
+		Type:    CommandTypeItem,
+		ItemID:  &itemID,
+		TargetX: &targetX,
+		TargetY: &targetY,
+	}
+}
+
 // NewFleeAction crée des paramètres pour fuir
 func NewFleeAction(actorID domain.UnitID) ActionParameters {
 	return ActionParameters{
@@ -188,7 +304,26 @@ func NewWaitAction(actorID domain.UnitID) ActionParameters {
 	}
 }
 
-// ExecutePlayerAction exécute une action de joueur via le système de commandes
+// NewCompositeAction crée des paramètres pour enchaîner plusieurs actions en un seul tour
+// Les étapes sont validées d'avance et annulées ensemble si l'une échoue
+func NewCompositeAction(actorID domain.UnitID, steps ...ActionParameters) ActionParameters {
+	return ActionParameters{
+		ActorID: actorID,
+		Type:    CommandTypeComposite,
+		Steps:   steps,
+	}
+}
+
+// NewCustomAction crée des paramètres pour une action d'un type personnalisé
+func NewCustomAction(actorID domain.UnitID, actionType CommandType, params commands.CommandParams) ActionParameters {
+	return ActionParameters{
+		ActorID: actorID,
+		Type:    actionType,
+		Params:  params,
+	}
+}
+
+// ExecutePlayerAction exécute une action de joueur via le système de commandes et la state machine
 // SOLID Principles appliqués:
 // - SRP: Fonction focalisée sur la création et l'exécution de commandes
 // - OCP: Extensible via ajout de nouveaux CommandType sans modifier le code existant
@@ -208,9 +343,18 @@ func ExecutePlayerAction(c *domain.Combat, actorID domain.UnitID, actionType Com
 	}
 
 	// Factory Pattern: création polymorphique de commandes
-	var cmd commands.Command
-	var err error
+	cmd, err := createCommand(factory, actor, actionType, params)
+	if err != nil {
+		return nil, fmt.Errorf("échec de création de commande: %w", err)
+	}
+
+	// State Pattern: la commande passe par les états du tour (validation, exécution, victoire)
+	return SoumettreCommande(c, cmd)
+}
 
+// createCommand crée la commande correspondant à une action décrite par une map de paramètres
+// Une action composite liste ses étapes sous "steps", chacune avec son "type" et ses propres paramètres
+func createCommand(factory *commands.CommandFactory, actor *domain.Unite, actionType CommandType, params map[string]interface{}) (commands.Command, error) {
 	switch actionType {
 	case CommandTypeMove:
 		targetX, okX := params["targetX"].(int)
@@ -218,14 +362,14 @@ func ExecutePlayerAction(c *domain.Combat, actorID domain.UnitID, actionType Com
 		if !okX || !okY {
 			return nil, errors.New("coordonnées cibles invalides pour Move")
 		}
-		cmd, err = factory.CreateMoveCommand(actor, targetX, targetY)
+		return factory.CreateMoveCommand(actor, targetX, targetY)
 
 	case CommandTypeAttack:
 		targetID, ok := params["targetID"].(domain.UnitID)
 		if !ok {
 			return nil, errors.New("ID cible invalide pour Attack")
 		}
-		cmd, err = factory.CreateAttackCommand(actor, targetID)
+		return factory.CreateAttackCommand(actor, targetID)
 
 	case CommandTypeSkill:
 		skillID, ok := params["skillID"].(string)
@@ -240,40 +384,57 @@ func ExecutePlayerAction(c *domain.Combat, actorID domain.UnitID, actionType Com
 				return nil, errors.New("IDs cibles invalides pour Skill")
 			}
 		}
-		cmd, err = factory.CreateSkillCommand(actor, skillID, targetIDs)
+		return factory.CreateSkillCommand(actor, skillID, targetIDs)
 
 	case CommandTypeItem:
 		itemID, ok := params["itemID"].(string)
 		if !ok {
 			return nil, errors.New("ID item invalide pour Item")
 		}
-		targetID, ok := params["targetID"].(domain.UnitID)
-		if !ok {
-			return nil, errors.New("ID cible invalide pour Item")
+		if targetID, ok := params["targetID"].(domain.UnitID); ok {
+			return factory.CreateItemCommand(actor, itemID, targetID)
+		}
+		targetX, okX := params["targetX"].(int)
+		targetY, okY := params["targetY"].(int)
+		if !okX || !okY {
+			return nil, errors.New("cible ou coordonnées invalides pour Item")
 		}
-		cmd, err = factory.CreateItemCommand(actor, itemID, targetID)
+		return factory.CreateItemCommandAtPosition(actor, itemID, targetX, targetY)
 
 	case CommandTypeFlee:
-		cmd, err = factory.CreateFleeCommand(actor)
+		return factory.CreateFleeCommand(actor)
 
 	case CommandTypeWait:
-		cmd, err = factory.CreateWaitCommand(actor)
-
-	default:
-		return nil, fmt.Errorf("type d'action inconnu: %s", actionType)
-	}
+		return factory.CreateWaitCommand(actor)
 
-	if err != nil {
-		return nil, fmt.Errorf("échec de création de commande: %w", err)
-	}
+	case CommandTypeComposite:
+		etapes, ok := params["steps"].([]map[string]interface{})
+		if !ok {
+			return nil, errors.New("étapes invalides pour Composite")
+		}
+		steps := make([]commands.Command, 0, len(etapes))
+		for i, etape := range etapes {
+			stepType, ok := etape["type"].(CommandType)
+			if !ok {
+				return nil, fmt.Errorf("étape %d: type d'action invalide", i+1)
+			}
+			if stepType == CommandTypeComposite {
+				return nil, fmt.Errorf("étape %d: une action composite ne peut pas en contenir une autre", i+1)
+			}
+			step, err := createCommand(factory, actor, stepType, etape)
+			if err != nil {
+				return nil, fmt.Errorf("étape %d: %w", i+1, err)
+			}
+			steps = append(steps, step)
+		}
+		return factory.CreateCompositeCommand(actor, steps...)
 
-	// Command Pattern: exécution polymorphique
-	result, err := cmd.Execute()
-	if err != nil {
-		return nil, fmt.Errorf("échec d'exécution: %w", err)
+	default:
+		if _, ok := factory.Registry().Lookup(commands.CommandType(actionType)); !ok {
+			return nil, fmt.Errorf("type d'action inconnu: %s", actionType)
+		}
+		return factory.CreateRegisteredCommand(actor, commands.CommandType(actionType), params)
 	}
-
-	return result, nil
 }
 
 // ExecutePlayerActionTyped version typée utilisant ActionParameters (recommandé)
@@ -288,21 +449,49 @@ func ExecutePlayerActionTyped(c *domain.Combat, params ActionParameters) (*comma
 		return nil, fmt.Errorf("acteur %s introuvable", params.ActorID)
 	}
 
-	var cmd commands.Command
-	var err error
+	cmd, err := createCommandTyped(factory, actor, params)
+	if err != nil {
+		return nil, fmt.Errorf("échec de création de commande: %w", err)
+	}
+
+	return SoumettreCommande(c, cmd)
+}
+
+// PreviewPlayerAction simule une action sur une copie du combat sans la jouer (dry-run)
+// Le combat n'est pas modifié, aucun événement n'est émis et le tour ne se termine pas
+func PreviewPlayerAction(c *domain.Combat, params ActionParameters) (*commands.CommandPreview, error) {
+	if c.TrouverUnite(params.ActorID) == nil {
+		return nil, fmt.Errorf("acteur %s introuvable", params.ActorID)
+	}
+
+	var registry *commands.CommandRegistry
+	if factory := GetCommandFactory(c); factory != nil {
+		registry = factory.Registry()
+	}
+
+	return commands.PreviewCommand(c, registry, func(clone *domain.Combat, factory *commands.CommandFactory) (commands.Command, error) {
+		cmd, err := createCommandTyped(factory, clone.TrouverUnite(params.ActorID), params)
+		if err != nil {
+			return nil, fmt.Errorf("échec de création de commande: %w", err)
+		}
+		return cmd, nil
+	})
+}
 
+// createCommandTyped crée la commande correspondant aux paramètres d'une action
+func createCommandTyped(factory *commands.CommandFactory, actor *domain.Unite, params ActionParameters) (commands.Command, error) {
 	switch params.Type {
 	case CommandTypeMove:
 		if params.TargetX == nil || params.TargetY == nil {
 			return nil, errors.New("coordonnées cibles manquantes pour Move")
 		}
-		cmd, err = factory.CreateMoveCommand(actor, *params.TargetX, *params.TargetY)
+		return factory.CreateMoveCommand(actor, *params.TargetX, *params.TargetY)
 
 	case CommandTypeAttack:
 		if params.TargetID == nil {
 			return nil, errors.New("ID cible manquant pour Attack")
 		}
-		cmd, err = factory.CreateAttackCommand(actor, *params.TargetID)
+		return factory.CreateAttackCommand(actor, *params.TargetID)
 
 	case CommandTypeSkill:
 		if params.SkillID == nil {
@@ -311,34 +500,47 @@ func ExecutePlayerActionTyped(c *domain.Combat, params ActionParameters) (*comma
 		if len(params.TargetIDs) == 0 {
 			return nil, errors.New("IDs cibles manquants pour Skill")
 		}
-		cmd, err = factory.CreateSkillCommand(actor, *params.SkillID, params.TargetIDs)
+		return factory.CreateSkillCommand(actor, *params.SkillID, params.TargetIDs)
 
 	case CommandTypeItem:
-		if params.ItemID == nil || params.TargetID == nil {
-			return nil, errors.New("ID item ou cible manquant pour Item")
+		if params.ItemID == nil {
+			return nil, errors.New("ID item manquant pour Item")
+		}
+		switch {
+		case params.TargetID != nil:
+			return factory.CreateItemCommand(actor, *params.ItemID, *params.TargetID)
+		case params.TargetX != nil && params.TargetY != nil:
+			return factory.CreateItemCommandAtPosition(actor, *params.ItemID, *params.TargetX, *params.TargetY)
+		default:
+			return nil, errors.New("cible ou coordonnées manquantes pour Item")
 		}
-		cmd, err = factory.CreateItemCommand(actor, *params.ItemID, *params.TargetID)
 
 	case CommandTypeFlee:
-		cmd, err = factory.CreateFleeCommand(actor)
+		return factory.CreateFleeCommand(actor)
 
 	case CommandTypeWait:
-		cmd, err = factory.CreateWaitCommand(actor)
-
-	default:
-		return nil, fmt.Errorf("type d'action inconnu: %s", params.Type)
-	}
+		return factory.CreateWaitCommand(actor)
 
-	if err != nil {
-		return nil, fmt.Errorf("échec de création de commande: %w", err)
-	}
+	case CommandTypeComposite:
+		steps := make([]commands.Command, 0, len(params.Steps))
+		for i, stepParams := range params.Steps {
+			if stepParams.Type == CommandTypeComposite {
+				return nil, fmt.Errorf("étape %d: une action composite ne peut pas en contenir une autre", i+1)
+			}
+			step, err := createCommandTyped(factory, actor, stepParams)
+			if err != nil {
+				return nil, fmt.Errorf("étape %d: %w", i+1, err)
+			}
+			steps = append(steps, step)
+		}
+		return factory.CreateCompositeCommand(actor, steps...)
 
-	result, err := cmd.Execute()
-	if err != nil {
-		return nil, fmt.Errorf("échec d'exécution: %w", err)
+	default:
+		if _, ok := factory.Registry().Lookup(commands.CommandType(params.Type)); !ok {
+			return nil, fmt.Errorf("type d'action inconnu: %s", params.Type)
+		}
+		return factory.CreateRegisteredCommand(actor, commands.CommandType(params.Type), params.Params)
 	}
-
-	return result, nil
 }
 
 // AttachObserver attache un observateur au système
@@ -396,38 +598,86 @@ func GetCommandHistoryList(c *domain.Combat) []commands.Command {
 	return invoker.GetHistory()
 }
 
-// UndoLastCommand annule la dernière commande exécutée
+// UndoLastCommand annule la dernière commande exécutée (combat d'entraînement uniquement)
+// Le combat revient à son état d'avant la commande (unités, inventaires, butins, aggro) et la state
+// machine au tour de l'unité qui l'avait jouée: les tours joués depuis (IA comprise) sont annulés avec
+// elle. L'annulation est enregistrée dans le flux d'événements (ActionAnnulee): elle survit au rechargement
 func UndoLastCommand(c *domain.Combat) error {
 	invoker := GetCommandInvoker(c)
 	if invoker == nil {
 		return errors.New("command invoker non initialisé")
 	}
-	return errors.New("undo non implémenté")
+	if !c.ModeEntrainement() {
+		return errors.New("annuler une action est réservé aux combats d'entraînement")
+	}
+
+	sm := GetStateMachine(c)
+	if sm == nil {
+		c.DebuterAction()
+		defer c.TerminerAction()
+		return invoker.Undo()
+	}
+	if !sm.PeutRembobiner() {
+		return &states.ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	c.DebuterAction()
+	defer c.TerminerAction()
+	if err := c.AnnulerAction(); err != nil {
+		return err
+	}
+	return sm.Rembobiner()
 }
 
-// RedoLastCommand refait la dernière commande annulée
+// RedoLastCommand refait la dernière commande annulée (combat d'entraînement uniquement)
+// Les événements de la commande et des tours qui l'avaient suivie sont réappliqués: le combat revient
+// à l'identique là où l'annulation l'avait pris (ActionRetablie dans le flux d'événements)
 func RedoLastCommand(c *domain.Combat) error {
 	invoker := GetCommandInvoker(c)
 	if invoker == nil {
 		return errors.New("command invoker non initialisé")
 	}
-	return errors.New("redo non implémenté")
+	if !c.ModeEntrainement() {
+		return errors.New("refaire une action est réservé aux combats d'entraînement")
+	}
+
+	sm := GetStateMachine(c)
+	if sm == nil {
+		c.DebuterAction()
+		defer c.TerminerAction()
+		return invoker.Redo()
+	}
+	if !sm.PeutRembobiner() {
+		return &states.ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	c.DebuterAction()
+	defer c.TerminerAction()
+	if err := c.RetablirAction(); err != nil {
+		return err
+	}
+	return sm.Rembobiner()
 }
 
 // CanUndo vérifie si une commande peut être annulée
+// Avec une state machine, les actions annulables sont celles du flux d'événements du combat
 func CanUndo(c *domain.Combat) bool {
+	if GetStateMachine(c) != nil {
+		return c.PeutAnnulerAction()
+	}
 	invoker := GetCommandInvoker(c)
 	if invoker == nil {
 		return false
 	}
-	return len(invoker.GetHistory()) > 0
+	return c.ModeEntrainement() && invoker.CanUndo()
 }
 
 // CanRedo vérifie si une commande peut être refaite
 func CanRedo(c *domain.Combat) bool {
+	if GetStateMachine(c) != nil {
+		return c.PeutRetablirAction()
+	}
 	invoker := GetCommandInvoker(c)
 	if invoker == nil {
 		return false
 	}
-	return false
+	return c.ModeEntrainement() && invoker.CanRedo()
 }
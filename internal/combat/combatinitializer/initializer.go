@@ -6,18 +6,35 @@ import (
 	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/observers"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/states"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/validators"
 )
 
 // CombatInitializer permet d'initialiser un Combat avec les patterns Step C
 // sans créer de cycle d'imports
 type CombatInitializer struct {
-	combat *domain.Combat
+	combat      *domain.Combat
+	registry    *commands.CommandRegistry
+	validateurs []validators.Validator // Règles propres au moteur, copiées dans chaque chaîne
 }
 
-// NewCombatInitializer crée un nouvel initialiseur
+// NewCombatInitializer crée un nouvel initialiseur, sans type de commande personnalisé
 func NewCombatInitializer(combat *domain.Combat) *CombatInitializer {
+	return NewCombatInitializerWithRegistry(combat, commands.NewCommandRegistry())
+}
+
+// NewCombatInitializerWithRegistry crée un initialiseur dont la factory et la chaîne de validation
+// reconnaissent les types de commandes personnalisés du registre donné
+func NewCombatInitializerWithRegistry(combat *domain.Combat, registry *commands.CommandRegistry) *CombatInitializer {
+	return NewCombatInitializerWithValidators(combat, registry)
+}
+
+// NewCombatInitializerWithValidators crée un initialiseur dont la chaîne de validation se termine
+// par une copie (Validator.Clone) de chacun des validateurs donnés, dans l'ordre
+func NewCombatInitializerWithValidators(combat *domain.Combat, registry *commands.CommandRegistry, validateurs ...validators.Validator) *CombatInitializer {
 	return &CombatInitializer{
-		combat: combat,
+		combat:      combat,
+		registry:    registry,
+		validateurs: validateurs,
 	}
 }
 
@@ -27,11 +44,16 @@ func (ci *CombatInitializer) InitializeStateMachine() error {
 }
 
 // InitializeCommandSystem initialise le système de commandes
+// Historique illimité en entraînement pour annuler/refaire à volonté
 func (ci *CombatInitializer) InitializeCommandSystem() {
+	maxHistory := 100
+	if ci.combat.ModeEntrainement() {
+		maxHistory = 0
+	}
 	combatfacade.SetupCommandSystem(
 		ci.combat,
-		commands.NewCommandInvoker(100),
-		commands.NewCommandFactory(ci.combat),
+		commands.NewCommandInvoker(maxHistory),
+		commands.NewCommandFactoryWithRegistry(ci.combat, ci.registry),
 	)
 }
 
@@ -40,8 +62,19 @@ func (ci *CombatInitializer) InitializeObservers() {
 	combatfacade.SetupObservers(ci.combat, observers.NewCombatSubject())
 }
 
-// InitializeValidation initialise la chaîne de validation
+// InitializeValidation initialise la chaîne de validation (Status → Cost → Range → Target → règles)
 func (ci *CombatInitializer) InitializeValidation() {
+	chain := validators.NewValidationChain(ci.registry)
+	for _, validateur := range ci.validateurs {
+		chain.Add(validateur.Clone())
+	}
+	combatfacade.SetupValidation(ci.combat, chain)
+}
+
+// AddValidator ajoute une règle en fin de chaîne de cette instance du combat uniquement
+// (perdue au rechargement: voir NewCombatInitializerWithValidators pour une règle permanente)
+func (ci *CombatInitializer) AddValidator(validator validators.Validator) error {
+	return combatfacade.AddValidator(ci.combat, validator)
 }
 
 // InitializeAll initialise tous les systèmes Step C
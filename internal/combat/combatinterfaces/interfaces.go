@@ -1,5 +1,7 @@
 package combatinterfaces
 
+import "github.com/aether-engine/aether-engine/internal/combat/domain"
+
 // CombatRepository interface pour éviter les cycles d'imports
 // Les commands/observers/validators utilisent cette interface au lieu de *domain.Combat
 type CombatRepository interface {
@@ -25,10 +27,11 @@ type CombatRepository interface {
 	DistribuerRecompenses()
 
 	// Inventaire
-	PossedeObjet(itemID string) bool
-	ObtenirQuantiteObjet(itemID string) int
-	ConsommerObjet(itemID string, quantite int)
-	AjouterObjet(itemID string, quantite int)
+	PossedeObjet(teamID domain.TeamID, itemID string) bool
+	ObtenirQuantiteObjet(teamID domain.TeamID, itemID string) int
+	ConsommerObjet(teamID domain.TeamID, itemID string, quantite int) error
+	RestituerObjet(teamID domain.TeamID, itemID string, quantite int) error
+	AjouterObjet(teamID domain.TeamID, itemID string, quantite int)
 	ObtenirObjet(itemID string) ItemInterface
 }
 
@@ -0,0 +1,53 @@
+package domain
+
+import "errors"
+
+// ReglesReinitialisationATB définit la part de jauge ATB conservée en fin de tour
+// Une unité qui attend rejoue plus tôt qu'une unité qui s'est déplacée et a agi (règles FFT)
+type ReglesReinitialisationATB struct {
+	ConservationAttente     int // % conservé sans déplacement ni action
+	ConservationDeplacement int // % conservé après un déplacement seul
+	ConservationAction      int // % conservé après une action sans déplacement
+	ConservationComplete    int // % conservé après un déplacement et une action
+}
+
+// NewReglesReinitialisationATBDefaut crée les règles de réinitialisation par défaut
+func NewReglesReinitialisationATBDefaut() *ReglesReinitialisationATB {
+	return &ReglesReinitialisationATB{
+		ConservationAttente:     ConservationJaugeAttente,
+		ConservationDeplacement: ConservationJaugeDeplacement,
+		ConservationAction:      ConservationJaugeAction,
+		ConservationComplete:    ConservationJaugeComplete,
+	}
+}
+
+// Valider vérifie la cohérence des règles
+func (r *ReglesReinitialisationATB) Valider() error {
+	for _, part := range []int{r.ConservationAttente, r.ConservationDeplacement, r.ConservationAction, r.ConservationComplete} {
+		if part < 0 || part > 100 {
+			return errors.New("la part de jauge ATB conservée doit être entre 0 et 100")
+		}
+	}
+	return nil
+}
+
+// Conservation retourne la part de jauge conservée pour l'activité d'un tour
+func (r *ReglesReinitialisationATB) Conservation(activite ActiviteTour) int {
+	switch {
+	case activite.ADeplace && activite.AAgi:
+		return r.ConservationComplete
+	case activite.AAgi:
+		return r.ConservationAction
+	case activite.ADeplace:
+		return r.ConservationDeplacement
+	default:
+		return r.ConservationAttente
+	}
+}
+
+// ActiviteTour retrace ce qu'une unité a fait pendant son tour en cours
+type ActiviteTour struct {
+	UniteID  UnitID
+	ADeplace bool
+	AAgi     bool
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// TypeButin énumère les types de butin laissés par une unité morte
+type TypeButin string
+
+const (
+	// ButinCristal restaure complètement HP et MP de l'unité qui le ramasse
+	ButinCristal TypeButin = "CRISTAL"
+
+	// ButinCoffre ajoute un objet à l'inventaire de l'équipe qui le ramasse
+	ButinCoffre TypeButin = "COFFRE"
+)
+
+// Butin représente un cristal ou un coffre posé sur la grille (Value Object)
+// Laissé par une unité définitivement morte, ramassé en se déplaçant sur sa case
+type Butin struct {
+	id        string
+	typeButin TypeButin
+	position  *shared.Position
+	sourceID  UnitID         // Unité morte ayant laissé le butin
+	objetID   shared.ObjetID // Contenu du coffre (vide pour un cristal)
+}
+
+// NewButin crée un nouveau butin
+func NewButin(id string, typeButin TypeButin, position *shared.Position, sourceID UnitID, objetID shared.ObjetID) *Butin {
+	return &Butin{
+		id:        id,
+		typeButin: typeButin,
+		position:  position,
+		sourceID:  sourceID,
+		objetID:   objetID,
+	}
+}
+
+// Getters
+func (b *Butin) ID() string                 { return b.id }
+func (b *Butin) Type() TypeButin            { return b.typeButin }
+func (b *Butin) Position() *shared.Position { return b.position }
+func (b *Butin) SourceID() UnitID           { return b.sourceID }
+func (b *Butin) ObjetID() shared.ObjetID    { return b.objetID }
+
+// EstCristal vérifie si le butin est un cristal
+func (b *Butin) EstCristal() bool {
+	return b.typeButin == ButinCristal
+}
+
+// EstCoffre vérifie si le butin est un coffre
+func (b *Butin) EstCoffre() bool {
+	return b.typeButin == ButinCoffre
+}
@@ -2,6 +2,9 @@ package domain
 
 import (
 	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
 	"time"
 
 	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
@@ -31,6 +34,62 @@ type Combat struct {
 	validationChain ValidationProvider
 	fuiteAutorisee  bool            // Indique si la fuite est autorisée
 	equipesFuites   map[TeamID]bool // Équipes ayant fui le combat
+
+	// Inventaire - Sac d'objets par équipe, définitions dans le catalogue
+	catalogueObjets *ItemCatalogue
+	inventaires     map[TeamID]*TeamInventory
+
+	// Stamina - Coûts physiques et régénération
+	reglesStamina *ReglesStamina
+
+	// KO et mort définitive - Butins laissés sur la grille
+	butins        map[string]*Butin
+	typeButinMort TypeButin
+
+	// Aggro - Tables de menace des unités IA et provocateurs actifs (cible provoquée -> provocateur)
+	tablesMenace map[UnitID]*ThreatTable
+	provocations map[UnitID]UnitID
+
+	// Charme - Unités charmées et équipe pour laquelle elles combattent
+	charmes map[UnitID]TeamID
+
+	// Générateur aléatoire du combat (Confusion), initialisable pour rejouer un combat
+	rng *rand.Rand
+
+	// Délai de tour - Temps accordé aux joueurs et échéance du tour en cours
+	reglesDelai  *ReglesDelaiTour
+	echeanceTour *EcheanceTour
+
+	// Ordonnancement des tours (ATB, CTB, phases d'équipes) et dernier état enregistré
+	// des jauges ATB ou du round/de la phase en cours
+	modeTour     ModeTour
+	jaugesATB    []JaugeATB
+	chargesATB   []ChargeATB
+	curseurRound *CurseurRound
+
+	// Entraînement - Annuler/refaire illimités, jamais pour un combat classé
+	// Actions annulables et actions annulées refaisables, reconstruites depuis le flux d'événements
+	entrainement       bool
+	actionsAnnulables  []*actionEntrainement
+	actionsRefaisables []*actionEntrainement
+
+	// Réinitialisation des jauges ATB - Part conservée selon l'activité du tour en cours
+	reglesATB    *ReglesReinitialisationATB
+	activiteTour *ActiviteTour
+
+	// Arbitrage - Pause en cours (litige) et motif d'annulation
+	pause           *PauseCombat
+	motifAnnulation string
+
+	// Fin de combat - Équipe victorieuse et récompenses déjà distribuées
+	vainqueur              *TeamID
+	recompensesDistribuees bool
+
+	// Machine d'états - Dernière position stable enregistrée (reprise d'un combat rechargé)
+	positionMachine *PositionMachineEtats
+
+	// Corrélation - Action en cours, dont les événements levés partagent l'identifiant
+	correlationAction string
 }
 
 // NewCombat crée une nouvelle instance de combat
@@ -39,11 +98,25 @@ func NewCombat(id string, equipes []*Equipe, grille *shared.GrilleCombat) (*Comb
 		return nil, errors.New("minimum 2 équipes requises")
 	}
 
-	combat := &Combat{
+	combat := nouveauCombat(id)
+	combat.grille = grille
+
+	// Ajouter les équipes
+	for _, equipe := range equipes {
+		combat.equipes[equipe.ID()] = equipe
+		combat.inventaires[equipe.ID()] = NewTeamInventory(equipe.ID())
+	}
+
+	return combat, nil
+}
+
+// nouveauCombat crée un combat en attente, sans équipes, avec les règles et stratégies par défaut
+// Partagé par NewCombat et ReconstruireDepuisEvenements
+func nouveauCombat(id string) *Combat {
+	return &Combat{
 		id:                id,
 		etat:              EtatAttente,
 		equipes:           make(map[TeamID]*Equipe),
-		grille:            grille,
 		tourActuel:        0,
 		version:           0,
 		evenements:        make([]Evenement, 0),
@@ -55,14 +128,25 @@ func NewCombat(id string, equipes []*Equipe, grille *shared.GrilleCombat) (*Comb
 		// Step C - Les patterns seront initialisés via CombatInitializer
 		fuiteAutorisee: true, // Par défaut, fuite autorisée
 		equipesFuites:  make(map[TeamID]bool),
-	}
 
-	// Ajouter les équipes
-	for _, equipe := range equipes {
-		combat.equipes[equipe.ID()] = equipe
-	}
+		catalogueObjets: NewDefaultItemCatalogue(),
+		inventaires:     make(map[TeamID]*TeamInventory),
 
-	return combat, nil
+		reglesStamina: NewReglesStaminaDefaut(),
+		reglesDelai:   NewReglesDelaiTourDefaut(),
+		reglesATB:     NewReglesReinitialisationATBDefaut(),
+
+		butins:        make(map[string]*Butin),
+		typeButinMort: ButinCristal,
+
+		tablesMenace: make(map[UnitID]*ThreatTable),
+		provocations: make(map[UnitID]UnitID),
+		charmes:      make(map[UnitID]TeamID),
+
+		rng: rand.New(rand.NewSource(time.Now().UnixNano())),
+
+		modeTour: ModeTourATB,
+	}
 }
 
 // Getters
@@ -152,6 +236,8 @@ func (c *Combat) ObtenirEnnemis(teamID TeamID) []*Unite {
 }
 
 // VerifierConditionsVictoire vérifie les conditions de victoire/défaite
+// Une équipe dont tous les membres sont KO ou morts est vaincue,
+// même si des compteurs KO ne sont pas écoulés (personne ne peut plus les ranimer)
 func (c *Combat) VerifierConditionsVictoire() string {
 	// Vérifier les équipes ayant fui
 	equipesActives := 0
@@ -160,7 +246,7 @@ func (c *Combat) VerifierConditionsVictoire() string {
 		if c.equipesFuites[teamID] {
 			continue
 		}
-		// Si l'équipe a des membres vivants, elle est active
+		// Si l'équipe a des membres debout (ni KO ni morts), elle est active
 		if equipe.ADesMembresVivants() {
 			equipesActives++
 		}
@@ -183,39 +269,911 @@ func (c *Combat) VerifierConditionsVictoire() string {
 	return "CONTINUE"
 }
 
-// ObtenirResultat retourne le résultat du combat
+// ObtenirUnitesDansZone retourne les unités (vivantes ou KO) à distance <= rayon d'un point
+// Les unités définitivement mortes ont quitté la grille et sont ignorées
+// Triées par ID pour un ordre de résolution déterministe
+func (c *Combat) ObtenirUnitesDansZone(centre *shared.Position, rayon int) []*Unite {
+	unites := make([]*Unite, 0)
+	for _, equipe := range c.equipes {
+		for _, membre := range equipe.Membres() {
+			if membre.EstMorte() {
+				continue
+			}
+			if membre.Position() != nil && membre.Position().Distance(centre) <= rayon {
+				unites = append(unites, membre)
+			}
+		}
+	}
+	sort.Slice(unites, func(i, j int) bool { return unites[i].ID() < unites[j].ID() })
+	return unites
+}
+
+// TypeButinMort retourne le type de butin laissé par une unité morte
+func (c *Combat) TypeButinMort() TypeButin {
+	return c.typeButinMort
+}
+
+// SetTypeButinMort définit le type de butin laissé par une unité morte (cristal ou coffre)
+func (c *Combat) SetTypeButinMort(typeButin TypeButin) {
+	c.typeButinMort = typeButin
+}
+
+// Butins retourne les butins présents sur la grille, triés par ID
+func (c *Combat) Butins() []*Butin {
+	butins := make([]*Butin, 0, len(c.butins))
+	for _, butin := range c.butins {
+		butins = append(butins, butin)
+	}
+	sort.Slice(butins, func(i, j int) bool { return butins[i].ID() < butins[j].ID() })
+	return butins
+}
+
+// ObtenirButin retourne le butin posé sur une case (nil si aucun)
+func (c *Combat) ObtenirButin(position *shared.Position) *Butin {
+	for _, butin := range c.butins {
+		if butin.Position().Equals(position) {
+			return butin
+		}
+	}
+	return nil
+}
+
+// DemarrerTourUnite enregistre le début du tour d'une unité dans le flux d'événements
+func (c *Combat) DemarrerTourUnite(uniteID UnitID) {
+	evt := NewTourDemarreEvent(c.id, c.tourActuel)
+	evt.UniteID = uniteID
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// EntamerTourUnite ouvre le tour d'une unité: compteurs du tour, statuts, cooldowns et régénération
+// selon les règles de Stamina du combat. Retourne les effets périodiques des statuts
+func (c *Combat) EntamerTourUnite(unite *Unite) []shared.EffetStatut {
+	evt := NewTourUniteEntameEvent(c.id, c.tourActuel, unite.ID(), c.ReglesStamina().TauxRegeneration)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+	return evt.effets
+}
+
+// entamerTour applique le début de tour d'une unité (Apply de TourUniteEntameEvent)
+// Les statuts sont traités par NouveauTour puis une seconde fois pour leurs effets périodiques
+func entamerTour(unite *Unite, tauxRegenStamina int) []shared.EffetStatut {
+	unite.SetTauxRegenStamina(tauxRegenStamina)
+	unite.NouveauTour()
+	return unite.TraiterStatuts()
+}
+
+// AvancerCompteAReboursKO fait avancer le compte à rebours d'une unité KO (un de ses tours)
+// À zéro, l'unité meurt définitivement et laisse un butin sur sa case
+func (c *Combat) AvancerCompteAReboursKO(unite *Unite) {
+	if !unite.EstKO() {
+		return
+	}
+
+	restants := max(unite.CompteAReboursKO()-1, 0)
+	decompte := NewCompteAReboursKOEvent(c.id, c.tourActuel, unite.ID(), restants)
+	_ = c.Apply(decompte)
+	c.RaiseEvent(decompte)
+	if restants > 0 {
+		return
+	}
+
+	mort := NewUniteMorteEvent(c.id, c.tourActuel, unite.ID(), unite.Position())
+	_ = c.Apply(mort)
+	c.RaiseEvent(mort)
+
+	var objetID shared.ObjetID
+	if c.typeButinMort == ButinCoffre {
+		objetID = ObjetCoffreDefaut
+	}
+	butin := NewButin("butin-"+string(unite.ID()), c.typeButinMort, unite.Position(), unite.ID(), objetID)
+	evt := NewButinDeposeEvent(c.id, c.tourActuel, butin)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// RamasserButin fait ramasser à une unité le butin posé sur sa case
+// Cristal: restaure HP et MP, Coffre: ajoute l'objet à l'inventaire de l'équipe
+// Retourne nil si aucun butin n'est présent
+func (c *Combat) RamasserButin(unite *Unite) *Butin {
+	if unite.EstEliminee() {
+		return nil
+	}
+	butin := c.ObtenirButin(unite.Position())
+	if butin == nil {
+		return nil
+	}
+
+	evt := NewButinRamasseEvent(c.id, c.tourActuel, butin, unite)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+	return butin
+}
+
+// Méthodes pour le système d'aggro (menace et provocation)
+// Chaque unité IA tient une table de menace, nourrie par les dégâts, les soins et les provocations
+
+// TableMenace retourne la table de menace d'une unité IA (nil pour une unité joueur)
+func (c *Combat) TableMenace(uniteID UnitID) *ThreatTable {
+	unite := c.trouverUnite(uniteID)
+	if unite == nil || !unite.EstIA() {
+		return nil
+	}
+	table, exists := c.tablesMenace[uniteID]
+	if !exists {
+		table = NewThreatTable(uniteID)
+		c.tablesMenace[uniteID] = table
+	}
+	return table
+}
+
+// EnregistrerDegats ajoute la menace générée par des dégâts dans la table de la cible
+func (c *Combat) EnregistrerDegats(source, cible *Unite, degats int) {
+	menaces := make(map[UnitID]int)
+	if table := c.TableMenace(cible.ID()); table != nil {
+		menaces[cible.ID()] = degats * MenaceParDegat
+	}
+	c.genererMenace(source, menaces)
+}
+
+// EnregistrerSoin ajoute la menace générée par des soins dans la table de chaque unité IA ennemie du soigneur
+// (camps effectifs: un soigneur charmé menace les unités de son équipe d'origine)
+func (c *Combat) EnregistrerSoin(source *Unite, soin int) {
+	menace := soin / MenaceSoinDiviseur
+	menaces := make(map[UnitID]int)
+	for _, ennemi := range c.ObtenirEnnemisDe(source) {
+		if table := c.TableMenace(ennemi.ID()); table != nil {
+			menaces[ennemi.ID()] = menace
+		}
+	}
+	c.genererMenace(source, menaces)
+}
+
+// genererMenace lève MenaceGenereeEvent pour la menace ajoutée envers la source
+// (rien si aucune table ne change: menace nulle ou table de la source elle-même)
+func (c *Combat) genererMenace(source *Unite, menaces map[UnitID]int) {
+	for id, menace := range menaces {
+		if menace <= 0 || id == source.ID() {
+			delete(menaces, id)
+		}
+	}
+	if len(menaces) == 0 {
+		return
+	}
+	evt := NewMenaceGenereeEvent(c.id, c.tourActuel, source.ID(), menaces)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// AppliquerProvocation provoque une cible: elle doit attaquer la source tant que le statut dure
+// Lève UniteProvoqueeEvent et retourne le statut appliqué
+func (c *Combat) AppliquerProvocation(source, cible *Unite, duree int) (*shared.Statut, error) {
+	if cible.EstEliminee() {
+		return nil, fmt.Errorf("impossible de provoquer %s: unité hors de combat", cible.Nom())
+	}
+	if !c.SontEnnemis(source, cible) {
+		return nil, fmt.Errorf("impossible de provoquer un allié")
+	}
+	if duree <= 0 {
+		duree = DureeProvocationDefaut
+	}
+
+	evt := NewUniteProvoqueeEvent(c.id, c.tourActuel, source.ID(), cible.ID(), shared.NewStatut(shared.TypeStatutProvocation, duree, 0))
+	if err := c.Apply(evt); err != nil {
+		return nil, err
+	}
+	c.RaiseEvent(evt)
+	return cible.ObtenirStatut(shared.TypeStatutProvocation), nil
+}
+
+// CibleForcee retourne le provocateur que l'unité doit cibler (nil si elle n'est pas provoquée)
+// La provocation cesse si le statut a expiré ou si le provocateur est hors de combat
+func (c *Combat) CibleForcee(unite *Unite) *Unite {
+	provocateurID, exists := c.provocations[unite.ID()]
+	if !exists {
+		return nil
+	}
+	if !unite.EstProvoquee() {
+		delete(c.provocations, unite.ID())
+		return nil
+	}
+	provocateur := c.trouverUnite(provocateurID)
+	if provocateur == nil || provocateur.EstEliminee() {
+		return nil
+	}
+	return provocateur
+}
+
+// VerifierProvocation vérifie qu'une unité provoquée ne vise aucun autre ennemi que son provocateur
+func (c *Combat) VerifierProvocation(acteur *Unite, cibles []*Unite) error {
+	provocateur := c.CibleForcee(acteur)
+	if provocateur == nil {
+		return nil
+	}
+	for _, cible := range cibles {
+		if c.SontEnnemis(acteur, cible) && cible.ID() != provocateur.ID() {
+			return shared.NewDomainError(
+				fmt.Sprintf("%s est provoquée et doit cibler %s", acteur.Nom(), provocateur.Nom()),
+				"TAUNTED",
+			)
+		}
+	}
+	return nil
+}
+
+// ChoisirCibleIA choisit la cible d'une unité IA
+// Priorité: provocateur, puis ennemi le plus menaçant, puis ennemi le plus proche (égalité: ID le plus petit)
+func (c *Combat) ChoisirCibleIA(unite *Unite) *Unite {
+	if provocateur := c.CibleForcee(unite); provocateur != nil {
+		return provocateur
+	}
+
+	ennemis := c.ObtenirEnnemisDe(unite)
+	if len(ennemis) == 0 {
+		return nil
+	}
+
+	if table := c.TableMenace(unite.ID()); table != nil {
+		ids := make([]UnitID, 0, len(ennemis))
+		for _, ennemi := range ennemis {
+			ids = append(ids, ennemi.ID())
+		}
+		if id, ok := table.Highest(ids); ok {
+			return c.trouverUnite(id)
+		}
+	}
+
+	return c.EnnemiLePlusProche(unite)
+}
+
+// EnnemiLePlusProche retourne l'ennemi debout le plus proche d'une unité (égalité: ID le plus petit)
+func (c *Combat) EnnemiLePlusProche(unite *Unite) *Unite {
+	var plusProche *Unite
+	for _, ennemi := range c.ObtenirEnnemisDe(unite) {
+		if plusProche == nil || unite.Position().Distance(ennemi.Position()) < unite.Position().Distance(plusProche.Position()) {
+			plusProche = ennemi
+		}
+	}
+	return plusProche
+}
+
+// AppliquerStatut applique un statut à une cible et lève StatutAppliqueEvent (ActeurID = source)
+// La cible porte une copie du statut: l'événement n'est pas modifié quand sa durée s'écoule.
+// Retourne le statut appliqué
+func (c *Combat) AppliquerStatut(source, cible *Unite, statut *shared.Statut) (*shared.Statut, error) {
+	if cible.EstEliminee() {
+		return nil, fmt.Errorf("impossible d'affecter %s: unité hors de combat", cible.Nom())
+	}
+
+	evt := NewStatutAppliqueEvent(c.id, c.tourActuel, source.ID(), cible.ID(), statut)
+	if err := c.Apply(evt); err != nil {
+		return nil, err
+	}
+	c.RaiseEvent(evt)
+	return cible.ObtenirStatut(statut.Type()), nil
+}
+
+// Méthodes pour les statuts de comportement (Charme, Confusion)
+
+// AppliquerCharme charme une cible: elle combat pour l'équipe de la source tant que le statut dure
+// Lève StatutAppliqueEvent (ActeurID = charmeur) et retourne le statut appliqué
+func (c *Combat) AppliquerCharme(source, cible *Unite, duree int) (*shared.Statut, error) {
+	if cible.EstEliminee() {
+		return nil, fmt.Errorf("impossible de charmer %s: unité hors de combat", cible.Nom())
+	}
+	if !c.SontEnnemis(source, cible) {
+		return nil, fmt.Errorf("impossible de charmer un allié")
+	}
+	if duree <= 0 {
+		duree = DureeStatutDefaut
+	}
+
+	evt := NewUniteCharmeeEvent(c.id, c.tourActuel, source.ID(), cible.ID(), source.TeamID(), shared.NewStatut(shared.TypeStatutCharme, duree, 0))
+	if err := c.Apply(evt); err != nil {
+		return nil, err
+	}
+	c.RaiseEvent(evt)
+	return cible.ObtenirStatut(shared.TypeStatutCharme), nil
+}
+
+// EquipeEffective retourne l'équipe pour laquelle une unité combat (celle du charmeur si elle est charmée)
+func (c *Combat) EquipeEffective(unite *Unite) TeamID {
+	teamID, exists := c.charmes[unite.ID()]
+	if !exists {
+		return unite.TeamID()
+	}
+	if !unite.EstCharmee() {
+		delete(c.charmes, unite.ID())
+		return unite.TeamID()
+	}
+	return teamID
+}
+
+// SontEnnemis vérifie si deux unités combattent dans des camps opposés (en tenant compte du Charme)
+func (c *Combat) SontEnnemis(a, b *Unite) bool {
+	return a.ID() != b.ID() && c.EquipeEffective(a) != c.EquipeEffective(b)
+}
+
+// ObtenirEnnemisDe retourne les ennemis debout d'une unité (en tenant compte du Charme), triés par ID
+func (c *Combat) ObtenirEnnemisDe(unite *Unite) []*Unite {
+	ennemis := make([]*Unite, 0)
+	for _, equipe := range c.equipes {
+		for _, membre := range equipe.Membres() {
+			if !membre.EstEliminee() && c.SontEnnemis(unite, membre) {
+				ennemis = append(ennemis, membre)
+			}
+		}
+	}
+	sort.Slice(ennemis, func(i, j int) bool { return ennemis[i].ID() < ennemis[j].ID() })
+	return ennemis
+}
+
+// CibleRenvoi retourne la nouvelle cible d'un sort renvoyé par un réflecteur (nil si le renvoi échoue)
+// Selon la puissance du Reflet: le lanceur (RenvoiVersLanceur) ou un ennemi du réflecteur au hasard (RenvoiAleatoire)
+func (c *Combat) CibleRenvoi(lanceur, reflecteur *Unite) *Unite {
+	reflet := reflecteur.ObtenirStatut(shared.TypeStatutReflet)
+	if reflet == nil {
+		return nil
+	}
+
+	if reflet.Puissance() == RenvoiAleatoire {
+		ennemis := c.ObtenirEnnemisDe(reflecteur)
+		if len(ennemis) == 0 {
+			return nil
+		}
+		return ennemis[c.TirerAleatoire(len(ennemis))]
+	}
+
+	if lanceur.EstEliminee() {
+		return nil
+	}
+	return lanceur
+}
+
+// DecompterStop fait s'écouler le Stop des unités figées au début du tour d'une autre unité
+// Une unité figée ne joue pas: la durée de son Stop se compte en tours des autres unités
+// Retourne les unités libérées
+func (c *Combat) DecompterStop(acteurID UnitID) []UnitID {
+	figees := make([]UnitID, 0)
+	for _, equipe := range c.equipes {
+		for _, unite := range equipe.Membres() {
+			if unite.ID() != acteurID && unite.EstStop() {
+				figees = append(figees, unite.ID())
+			}
+		}
+	}
+	liberees := make([]UnitID, 0)
+	if len(figees) == 0 {
+		return liberees
+	}
+	sort.Slice(figees, func(i, j int) bool { return figees[i] < figees[j] })
+
+	evt := NewDureeStopDecompteeEvent(c.id, c.tourActuel, acteurID, figees)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+
+	for _, id := range figees {
+		if stop := c.trouverUnite(id).ObtenirStatut(shared.TypeStatutStop); stop.EstExpire() {
+			retrait := NewStatutRetireEvent(c.id, c.tourActuel, acteurID, id, shared.TypeStatutStop)
+			_ = c.Apply(retrait)
+			c.RaiseEvent(retrait)
+			liberees = append(liberees, id)
+		}
+	}
+	return liberees
+}
+
+// ModeTour retourne le système d'ordonnancement des tours (ATB par défaut)
+func (c *Combat) ModeTour() ModeTour {
+	if c.modeTour == "" {
+		return ModeTourATB
+	}
+	return c.modeTour
+}
+
+// SetModeTour choisit le système d'ordonnancement des tours (avant le démarrage uniquement)
+func (c *Combat) SetModeTour(mode ModeTour) error {
+	if !mode.EstValide() {
+		return fmt.Errorf("mode de tour inconnu: %s", mode)
+	}
+	if c.etat != EtatAttente {
+		return errors.New("le mode de tour ne peut être changé qu'avant le démarrage")
+	}
+	c.modeTour = mode
+	return nil
+}
+
+// ModeEntrainement indique si le combat est un entraînement (annuler/refaire autorisés)
+func (c *Combat) ModeEntrainement() bool {
+	return c.entrainement
+}
+
+// SetModeEntrainement marque le combat comme entraînement (avant le démarrage uniquement)
+// Les joueurs y testent leurs combos en annulant et refaisant leurs actions à volonté
+func (c *Combat) SetModeEntrainement(entrainement bool) error {
+	if c.etat != EtatAttente {
+		return errors.New("le mode entraînement ne peut être changé qu'avant le démarrage")
+	}
+	c.entrainement = entrainement
+	return nil
+}
+
+// ordreInitiative retourne les unités triées par SPD décroissante puis par ID
+func (c *Combat) ordreInitiative() []UnitID {
+	unites := make([]*Unite, 0)
+	for _, equipe := range c.equipes {
+		unites = append(unites, equipe.Membres()...)
+	}
+	sort.Slice(unites, func(i, j int) bool {
+		if unites[i].Stats().SPD != unites[j].Stats().SPD {
+			return unites[i].Stats().SPD > unites[j].Stats().SPD
+		}
+		return unites[i].ID() < unites[j].ID()
+	})
+
+	ordre := make([]UnitID, len(unites))
+	for i, unite := range unites {
+		ordre[i] = unite.ID()
+	}
+	return ordre
+}
+
+// JaugesATB retourne le dernier état enregistré des jauges ATB (vide si jamais enregistré)
+func (c *Combat) JaugesATB() []JaugeATB {
+	return c.jaugesATB
+}
+
+// ChargesATB retourne les incantations en cours lors du dernier enregistrement des jauges ATB
+func (c *Combat) ChargesATB() []ChargeATB {
+	return c.chargesATB
+}
+
+// EnregistrerJaugesATB enregistre l'état des jauges ATB et des incantations en cours dans le flux d'événements
+func (c *Combat) EnregistrerJaugesATB(jauges []JaugeATB, charges []ChargeATB) {
+	evt := NewJaugesATBEnregistreesEvent(c.id, c.tourActuel, jauges, charges)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// copierChargesATB copie les incantations et leurs cibles
+func copierChargesATB(charges []ChargeATB) []ChargeATB {
+	if charges == nil {
+		return nil
+	}
+	copie := make([]ChargeATB, len(charges))
+	for i, charge := range charges {
+		copie[i] = charge
+		copie[i].Cibles = append([]UnitID(nil), charge.Cibles...)
+	}
+	return copie
+}
+
+// CurseurRound retourne la dernière position enregistrée du round/de la phase (nil si jamais enregistrée)
+func (c *Combat) CurseurRound() *CurseurRound {
+	return c.curseurRound
+}
+
+// EnregistrerCurseurRound enregistre la position du round/de la phase dans le flux d'événements
+// Event Sourcing - lève CurseurRoundEnregistreEvent, appliqué immédiatement à l'agrégat
+func (c *Combat) EnregistrerCurseurRound(curseur CurseurRound) {
+	evt := NewCurseurRoundEnregistreEvent(c.id, c.tourActuel, curseur)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// ReglesReinitialisationATB retourne les règles de réinitialisation des jauges ATB
+func (c *Combat) ReglesReinitialisationATB() *ReglesReinitialisationATB {
+	if c.reglesATB == nil {
+		return NewReglesReinitialisationATBDefaut()
+	}
+	return c.reglesATB
+}
+
+// SetReglesReinitialisationATB remplace les règles de réinitialisation des jauges ATB
+func (c *Combat) SetReglesReinitialisationATB(regles *ReglesReinitialisationATB) error {
+	if regles == nil {
+		return errors.New("règles de réinitialisation ATB nil")
+	}
+	if err := regles.Valider(); err != nil {
+		return err
+	}
+	c.reglesATB = regles
+	return nil
+}
+
+// ActiviteTour retourne l'activité du tour en cours (nil si aucun tour ouvert)
+func (c *Combat) ActiviteTour() *ActiviteTour {
+	return c.activiteTour
+}
+
+// OuvrirActiviteTour commence le suivi de l'activité du tour d'une unité
+// Sans effet si le tour de cette unité est déjà ouvert (nouvelle tentative après un échec)
+func (c *Combat) OuvrirActiviteTour(uniteID UnitID) {
+	if c.activiteTour != nil && c.activiteTour.UniteID == uniteID {
+		return
+	}
+	c.enregistrerActiviteTour(ActiviteTour{UniteID: uniteID})
+}
+
+// EnregistrerDeplacementTour note que l'unité s'est déplacée pendant son tour
+func (c *Combat) EnregistrerDeplacementTour(uniteID UnitID) {
+	activite := c.activiteCourante(uniteID)
+	activite.ADeplace = true
+	c.enregistrerActiviteTour(activite)
+}
+
+// EnregistrerActionTour note que l'unité a agi pendant son tour (attaque, compétence, objet, fuite)
+func (c *Combat) EnregistrerActionTour(uniteID UnitID) {
+	activite := c.activiteCourante(uniteID)
+	activite.AAgi = true
+	c.enregistrerActiviteTour(activite)
+}
+
+// DemarrerIncantation enregistre le début d'une incantation: elle compte comme l'action du tour du lanceur
+// Coûts, cooldown et effets de la compétence s'appliquent à sa résolution
+func (c *Combat) DemarrerIncantation(lanceurID UnitID, competenceID CompetenceID, cibles []UnitID, ticks int) {
+	c.EnregistrerActionTour(lanceurID)
+	c.RaiseEvent(NewIncantationDemarreeEvent(c.id, c.tourActuel, lanceurID, competenceID, cibles, ticks))
+}
+
+// InterrompreIncantation enregistre une incantation arrivée à terme qui n'a pas pu être résolue
+func (c *Combat) InterrompreIncantation(lanceurID UnitID, competenceID CompetenceID, raison string) {
+	c.RaiseEvent(NewIncantationInterrompueEvent(c.id, c.tourActuel, lanceurID, competenceID, raison))
+}
+
+// ConclureActiviteTour clôt le tour d'une unité et retourne la part de jauge ATB conservée
+func (c *Combat) ConclureActiviteTour(uniteID UnitID) int {
+	activite := c.activiteCourante(uniteID)
+	conservation := c.ReglesReinitialisationATB().Conservation(activite)
+	evt := NewActiviteTourConclueEvent(c.id, c.tourActuel, activite, conservation)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+	return conservation
+}
+
+// activiteCourante retourne l'activité du tour de l'unité (vierge si son tour n'est pas ouvert)
+func (c *Combat) activiteCourante(uniteID UnitID) ActiviteTour {
+	if c.activiteTour == nil || c.activiteTour.UniteID != uniteID {
+		return ActiviteTour{UniteID: uniteID}
+	}
+	return *c.activiteTour
+}
+
+// enregistrerActiviteTour met à jour l'activité du tour en cours et l'enregistre
+func (c *Combat) enregistrerActiviteTour(activite ActiviteTour) {
+	evt := NewActiviteTourEnregistreeEvent(c.id, c.tourActuel, activite)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// SetGraineAleatoire fixe la graine du générateur aléatoire du combat (combats rejouables, tests)
+func (c *Combat) SetGraineAleatoire(graine int64) {
+	c.rng = rand.New(rand.NewSource(graine))
+}
+
+// TirerAleatoire retourne un entier aléatoire dans [0, n) tiré par le générateur du combat
+func (c *Combat) TirerAleatoire(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return c.rng.Intn(n)
+}
+
+// ObtenirResultat retourne le résultat du combat (CANCELLED pour un combat annulé, sans vainqueur)
 func (c *Combat) ObtenirResultat() string {
+	if c.etat == EtatAnnule {
+		return "CANCELLED"
+	}
 	return c.VerifierConditionsVictoire()
 }
 
-// DistribuerRecompenses distribue les récompenses (XP, loots)
+// Vainqueur retourne l'équipe victorieuse d'un combat terminé (nil sans vainqueur)
+func (c *Combat) Vainqueur() *TeamID {
+	return c.vainqueur
+}
+
+// DistribuerRecompenses distribue les récompenses d'un combat terminé à l'équipe victorieuse
+// Chaque membre encore debout gagne ExperienceParEnnemiVaincu par ennemi vaincu (KO ou mort);
+// les coffres restés sur la grille rejoignent l'inventaire de l'équipe, les cristaux sont perdus
+// Un combat annulé, sans vainqueur ou déjà récompensé ne rapporte rien
+// Event Sourcing - lève RecompensesDistribueesEvent, appliqué immédiatement à l'agrégat
 func (c *Combat) DistribuerRecompenses() {
-	// TODO: Implémenter la distribution de récompenses
-	// Pour l'instant, juste un placeholder
+	if c.etat != EtatTermine || c.vainqueur == nil || c.recompensesDistribuees {
+		return
+	}
+
+	vaincus := 0
+	for teamID, equipe := range c.equipes {
+		if teamID == *c.vainqueur {
+			continue
+		}
+		for _, membre := range equipe.Membres() {
+			if membre.EstEliminee() {
+				vaincus++
+			}
+		}
+	}
+
+	evt := NewRecompensesDistribueesEvent(c.id, c.tourActuel, *c.vainqueur)
+	if equipe := c.equipes[*c.vainqueur]; equipe != nil {
+		for _, membre := range equipe.Membres() {
+			if !membre.EstEliminee() {
+				evt.Experience[membre.ID()] = vaincus * ExperienceParEnnemiVaincu
+			}
+		}
+	}
+	for _, butin := range c.Butins() {
+		evt.Butins = append(evt.Butins, butin.ID())
+		if butin.EstCoffre() {
+			evt.Objets[butin.ObjetID()]++
+		}
+	}
+
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
 }
 
 // Méthodes pour le système d'inventaire (Item commands)
-func (c *Combat) PossedeObjet(itemID string) bool {
-	// TODO: Implémenter vérification inventaire
-	return true // Placeholder
+// Chaque équipe possède son propre sac, initialisé depuis le DTO de setup
+
+// CatalogueObjets retourne le catalogue des définitions d'objets
+func (c *Combat) CatalogueObjets() *ItemCatalogue {
+	return c.catalogueObjets
+}
+
+// SetCatalogueObjets remplace le catalogue des définitions d'objets
+func (c *Combat) SetCatalogueObjets(catalogue *ItemCatalogue) {
+	if catalogue == nil {
+		catalogue = NewItemCatalogue()
+	}
+	c.catalogueObjets = catalogue
+}
+
+// ReglesStamina retourne les règles de Stamina du combat
+func (c *Combat) ReglesStamina() *ReglesStamina {
+	return c.reglesStamina
+}
+
+// SetReglesStamina remplace les règles de Stamina et applique le taux de régénération aux unités
+func (c *Combat) SetReglesStamina(regles *ReglesStamina) error {
+	if regles == nil {
+		return errors.New("règles de Stamina nil")
+	}
+	if err := regles.Valider(); err != nil {
+		return err
+	}
+
+	c.reglesStamina = regles
+	for _, equipe := range c.equipes {
+		for _, unite := range equipe.Membres() {
+			unite.SetTauxRegenStamina(regles.TauxRegeneration)
+		}
+	}
+	return nil
+}
+
+// ReglesDelaiTour retourne les règles de délai de tour du combat
+func (c *Combat) ReglesDelaiTour() *ReglesDelaiTour {
+	if c.reglesDelai == nil {
+		return NewReglesDelaiTourDefaut()
+	}
+	return c.reglesDelai
+}
+
+// SetReglesDelaiTour remplace les règles de délai de tour du combat
+func (c *Combat) SetReglesDelaiTour(regles *ReglesDelaiTour) error {
+	if regles == nil {
+		return errors.New("règles de délai de tour nil")
+	}
+	if err := regles.Valider(); err != nil {
+		return err
+	}
+	c.reglesDelai = regles
+	return nil
+}
+
+// EcheanceTour retourne l'échéance du tour en cours (nil si aucune)
+func (c *Combat) EcheanceTour() *EcheanceTour {
+	return c.echeanceTour
+}
+
+// FixerEcheanceTour ouvre le délai du tour d'une unité à partir de maintenant
+// Sans limite de temps configurée, aucune échéance n'est fixée (retourne nil)
+func (c *Combat) FixerEcheanceTour(uniteID UnitID, maintenant time.Time) *EcheanceTour {
+	regles := c.ReglesDelaiTour()
+	if !regles.EstActif() {
+		return nil
+	}
+
+	evt := NewEcheanceTourFixeeEvent(c.id, c.tourActuel, uniteID, maintenant.Add(regles.Duree))
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+	return c.echeanceTour
+}
+
+// LeverEcheanceTour lève l'échéance du tour: l'unité a choisi son action à temps
+func (c *Combat) LeverEcheanceTour(uniteID UnitID) {
+	if c.echeanceTour == nil || c.echeanceTour.UniteID != uniteID {
+		return
+	}
+	evt := NewEcheanceTourLeveeEvent(c.id, c.tourActuel, uniteID)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// ExpirerTour constate l'expiration du tour d'une unité et lève son échéance
+func (c *Combat) ExpirerTour(uniteID UnitID, action ActionExpiration) error {
+	if c.echeanceTour == nil || c.echeanceTour.UniteID != uniteID {
+		return fmt.Errorf("aucune échéance en cours pour l'unité %s", uniteID)
+	}
+	evt := NewTourExpireEvent(c.id, c.tourActuel, uniteID, c.echeanceTour.Echeance, action)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+	return nil
+}
+
+// Pause retourne la pause en cours (nil si le combat n'est pas en pause)
+func (c *Combat) Pause() *PauseCombat {
+	return c.pause
+}
+
+// MotifAnnulation retourne le motif d'annulation du combat (vide s'il n'est pas annulé)
+func (c *Combat) MotifAnnulation() string {
+	return c.motifAnnulation
+}
+
+// MettreEnPause suspend un combat en cours: le délai du tour est gelé et plus aucune action n'est acceptée
+func (c *Combat) MettreEnPause(motif string, maintenant time.Time) error {
+	if c.etat != EtatEnCours {
+		return fmt.Errorf("seul un combat en cours peut être mis en pause (état: %s)", c.etat)
+	}
+
+	evt := NewCombatMisEnPauseEvent(c.id, c.tourActuel, motif, maintenant)
+	if c.echeanceTour != nil {
+		evt.UniteID = c.echeanceTour.UniteID
+		evt.TempsRestant = c.echeanceTour.Echeance.Sub(maintenant)
+		if evt.TempsRestant < 0 {
+			evt.TempsRestant = 0
+		}
+	}
+
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
+}
+
+// Reprendre relance un combat en pause: le délai gelé repart avec le temps qu'il restait
+func (c *Combat) Reprendre(maintenant time.Time) error {
+	if c.etat != EtatPause || c.pause == nil {
+		return fmt.Errorf("seul un combat en pause peut reprendre (état: %s)", c.etat)
+	}
+
+	evt := NewCombatReprisEvent(c.id, c.tourActuel)
+	if c.pause.DelaiGele() {
+		echeance := maintenant.Add(c.pause.TempsRestant)
+		evt.UniteID = c.pause.UniteID
+		evt.Echeance = &echeance
+	}
+
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
+}
+
+// Annuler met fin au combat sans vainqueur ni récompenses (incident serveur)
+func (c *Combat) Annuler(motif string) error {
+	if c.etat == EtatTermine || c.etat == EtatAnnule {
+		return fmt.Errorf("le combat est déjà fini (état: %s)", c.etat)
+	}
+
+	evt := NewCombatAnnuleEvent(c.id, c.tourActuel, motif)
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
+}
+
+// Terminer clôt un combat gagné, perdu ou fui (voir VerifierConditionsVictoire)
+// Le vainqueur est la seule équipe encore debout qui n'a pas fui (nil si aucune)
+func (c *Combat) Terminer() error {
+	if c.etat != EtatEnCours {
+		return fmt.Errorf("le combat n'est pas en cours (état: %s)", c.etat)
+	}
+
+	var vainqueur *TeamID
+	for teamID, equipe := range c.equipes {
+		if !c.equipesFuites[teamID] && equipe.ADesMembresVivants() {
+			if vainqueur != nil {
+				return errors.New("plusieurs équipes sont encore en lice")
+			}
+			id := teamID
+			vainqueur = &id
+		}
+	}
+
+	evt := NewCombatTermineEvent(c.id, c.tourActuel, vainqueur)
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
+}
+
+// InventaireEquipe retourne l'inventaire d'une équipe (nil si équipe inconnue)
+func (c *Combat) InventaireEquipe(teamID TeamID) *TeamInventory {
+	return c.inventaires[teamID]
+}
+
+// PossedeObjet vérifie si une équipe possède au moins un exemplaire d'un objet
+func (c *Combat) PossedeObjet(teamID TeamID, itemID string) bool {
+	inventaire := c.inventaires[teamID]
+	return inventaire != nil && inventaire.Has(shared.ObjetID(itemID))
+}
+
+// ObtenirQuantiteObjet retourne la quantité restante d'un objet pour une équipe
+func (c *Combat) ObtenirQuantiteObjet(teamID TeamID, itemID string) int {
+	inventaire := c.inventaires[teamID]
+	if inventaire == nil {
+		return 0
+	}
+	return inventaire.Count(shared.ObjetID(itemID))
 }
 
-func (c *Combat) ObtenirQuantiteObjet(itemID string) int {
-	// TODO: Implémenter récupération quantité
-	return 1 // Placeholder
+// ConsommerObjet consomme un objet de l'inventaire d'une équipe
+// Event Sourcing - lève ObjetConsommeEvent, appliqué immédiatement à l'agrégat
+func (c *Combat) ConsommerObjet(teamID TeamID, itemID string, quantite int) error {
+	inventaire := c.inventaires[teamID]
+	if inventaire == nil {
+		return fmt.Errorf("équipe %s inconnue", teamID)
+	}
+	if inventaire.Count(shared.ObjetID(itemID)) < quantite {
+		return shared.NewDomainError("quantité insuffisante pour "+itemID, "INSUFFICIENT_ITEMS")
+	}
+
+	evt := NewObjetConsommeEvent(c.tourActuel, teamID, shared.ObjetID(itemID), quantite)
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
 }
 
-func (c *Combat) ConsommerObjet(itemID string, quantite int) {
-	// TODO: Implémenter consommation objet
+// RestituerObjet rend un objet consommé à une équipe (rollback d'une commande)
+// Event Sourcing - lève ObjetRestitueEvent pour compenser ObjetConsommeEvent
+func (c *Combat) RestituerObjet(teamID TeamID, itemID string, quantite int) error {
+	if c.inventaires[teamID] == nil {
+		return fmt.Errorf("équipe %s inconnue", teamID)
+	}
+
+	evt := NewObjetRestitueEvent(c.tourActuel, teamID, shared.ObjetID(itemID), quantite)
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
 }
 
-func (c *Combat) AjouterObjet(itemID string, quantite int) {
-	// TODO: Implémenter ajout objet
+// AjouterObjet ajoute des objets à l'inventaire d'une équipe (setup du combat)
+func (c *Combat) AjouterObjet(teamID TeamID, itemID string, quantite int) {
+	inventaire := c.inventaires[teamID]
+	if inventaire == nil {
+		inventaire = NewTeamInventory(teamID)
+		c.inventaires[teamID] = inventaire
+	}
+	inventaire.Add(shared.ObjetID(itemID), quantite)
 }
 
-func (c *Combat) ObtenirObjet(itemID string) interface{} {
-	// TODO: Implémenter récupération objet (retournera *shared.Item quand implémenté)
-	return nil // Placeholder
+// ObtenirObjet retourne la définition d'un objet depuis le catalogue (nil si inconnu)
+func (c *Combat) ObtenirObjet(itemID string) *shared.Item {
+	if c.catalogueObjets == nil {
+		return nil
+	}
+	return c.catalogueObjets.Get(shared.ObjetID(itemID))
 }
 
 // Demarrer démarre le combat via la State Machine
@@ -228,6 +1186,16 @@ func (c *Combat) Demarrer() error {
 	c.etat = EtatEnCours
 	c.tourActuel = 1
 
+	// Enregistrer le démarrage et le mode de tour choisi
+	evt := NewCombatDemarreEvent(c.id, c.tourActuel, c.ordreInitiative())
+	evt.ModeTour = c.ModeTour()
+	evt.Entrainement = c.entrainement
+	evt.DureeTour = c.ReglesDelaiTour().Duree
+	evt.ActionExpiration = c.ReglesDelaiTour().ActionParDefaut
+	evt.ReglesReinitialisationATB = c.ReglesReinitialisationATB()
+	evt.Configuration = c.capturerConfiguration()
+	c.RaiseEvent(evt)
+
 	// La State Machine gère maintenant le démarrage
 	// via la transition Idle → Initializing → Ready
 	return nil
@@ -240,7 +1208,9 @@ func (c *Combat) RaiseEvent(evt Evenement) {
 	evt.SetAggregateID(c.id)
 	evt.SetAggregateVersion(c.version + len(c.evenements) + 1)
 	evt.SetTimestamp(time.Now())
+	c.correler(evt)
 	c.evenements = append(c.evenements, evt)
+	c.memoriserEvenement(evt)
 }
 
 // GetUncommittedEvents retourne les événements non committés
@@ -249,7 +1219,9 @@ func (c *Combat) GetUncommittedEvents() []Evenement {
 }
 
 // ClearUncommittedEvents vide la liste des événements non committés
+// La version avance d'autant: les événements committés ne peuvent plus être annulés (PointDeRestauration)
 func (c *Combat) ClearUncommittedEvents() {
+	c.version += len(c.evenements)
 	c.evenements = make([]Evenement, 0)
 }
 
@@ -266,17 +1238,15 @@ func ReconstruireDepuisEvenements(events []Evenement) (*Combat, error) {
 		return nil, errors.New("le premier événement doit être CombatDemarre")
 	}
 
-	combat := &Combat{
-		id:         firstEvent.AggregateID(),
-		equipes:    make(map[TeamID]*Equipe),
-		evenements: make([]Evenement, 0),
-	}
+	// Équipes, unités et grille sont recréées par la configuration de CombatDemarre
+	combat := nouveauCombat(firstEvent.AggregateID())
 
-	// Appliquer tous les événements
+	// Appliquer tous les événements (les actions annulées d'un entraînement sont rembobinées)
 	for _, evt := range events {
 		if err := combat.Apply(evt); err != nil {
 			return nil, err
 		}
+		combat.memoriserEvenement(evt)
 		combat.version = evt.AggregateVersion()
 	}
 
@@ -289,23 +1259,232 @@ func (c *Combat) Apply(evt Evenement) error {
 	case *CombatDemarreEvent:
 		c.etat = EtatEnCours
 		c.tourActuel = e.Tour
+		c.modeTour = e.ModeTour
+		c.entrainement = e.Entrainement
+		if e.DureeTour > 0 {
+			c.reglesDelai = &ReglesDelaiTour{Duree: e.DureeTour, ActionParDefaut: e.ActionExpiration}
+		}
+		if e.ReglesReinitialisationATB != nil {
+			c.reglesATB = e.ReglesReinitialisationATB
+		}
+		if e.Configuration != nil {
+			return c.appliquerConfiguration(e.Configuration)
+		}
 		return nil
 	case *TourDemarreEvent:
 		c.tourActuel = e.Tour
 		return nil
 	case *CombatTermineEvent:
 		c.etat = EtatTermine
+		c.vainqueur = e.Vainqueur
+		return nil
+	case *RecompensesDistribueesEvent:
+		c.recompensesDistribuees = true
+		for _, butinID := range e.Butins {
+			delete(c.butins, butinID)
+		}
+		for objetID, quantite := range e.Objets {
+			c.AjouterObjet(e.Vainqueur, string(objetID), quantite)
+		}
+		return nil
+	case *CombatMisEnPauseEvent:
+		c.etat = EtatPause
+		c.pause = &PauseCombat{Motif: e.Motif, Debut: e.Debut, UniteID: e.UniteID, TempsRestant: e.TempsRestant}
+		c.echeanceTour = nil
+		return nil
+	case *CombatReprisEvent:
+		c.etat = EtatEnCours
+		c.pause = nil
+		if e.Echeance != nil {
+			c.echeanceTour = &EcheanceTour{Tour: e.Tour, UniteID: e.UniteID, Echeance: *e.Echeance}
+		}
+		return nil
+	case *CombatAnnuleEvent:
+		c.etat = EtatAnnule
+		c.motifAnnulation = e.Motif
+		c.pause = nil
+		c.echeanceTour = nil
+		return nil
+	case *ObjetConsommeEvent:
+		inventaire := c.inventaires[e.TeamID]
+		if inventaire == nil {
+			return fmt.Errorf("inventaire de l'équipe %s introuvable", e.TeamID)
+		}
+		return inventaire.Remove(e.ObjetID, e.Quantite)
+	case *ObjetRestitueEvent:
+		c.AjouterObjet(e.TeamID, string(e.ObjetID), e.Quantite)
+		return nil
+	case *ButinDeposeEvent:
+		c.butins[e.ButinID] = NewButin(e.ButinID, e.TypeButin, e.Position, e.SourceID, e.ObjetID)
+		return nil
+	case *EcheanceTourFixeeEvent:
+		c.echeanceTour = &EcheanceTour{Tour: e.Tour, UniteID: e.UniteID, Echeance: e.Echeance}
+		return nil
+	case *EcheanceTourLeveeEvent, *TourExpireEvent:
+		c.echeanceTour = nil
+		return nil
+	case *ActiviteTourEnregistreeEvent:
+		activite := e.Activite
+		c.activiteTour = &activite
+		return nil
+	case *ActiviteTourConclueEvent:
+		c.activiteTour = nil
+		return nil
+	case *JaugesATBEnregistreesEvent:
+		c.jaugesATB = append([]JaugeATB(nil), e.Jauges...)
+		c.chargesATB = copierChargesATB(e.Charges)
 		return nil
-	case *ActionExecuteeEvent, *DegatsInfligesEvent, *SoinApliqueEvent,
-		*StatutAppliqueEvent, *UniteElimineeEvent, *CompetenceUtiliseeEvent,
-		*DeplacementExecuteEvent:
-		// Événements gérés par la State Machine
+	case *CurseurRoundEnregistreEvent:
+		curseur := e.Curseur
+		curseur.Joue = append([]UnitID(nil), e.Curseur.Joue...)
+		c.curseurRound = &curseur
+		return nil
+	case *MachineEtatsPositionneeEvent:
+		c.positionMachine = &PositionMachineEtats{Etat: e.Etat, UniteID: e.UniteID}
+		return nil
+	case *ButinRamasseEvent:
+		delete(c.butins, e.ButinID)
+		switch e.TypeButin {
+		case ButinCristal:
+			c.modifierUnite(e.UniteID, func(u *Unite) {
+				u.Soigner(u.Stats().HP)
+				u.RestaurerMP(u.Stats().MP)
+			})
+		case ButinCoffre:
+			c.AjouterObjet(e.TeamID, string(e.ObjetID), 1)
+		}
+		return nil
+	case *MenaceGenereeEvent:
+		for id, menace := range e.Menaces {
+			if table := c.TableMenace(id); table != nil {
+				table.Add(e.SourceID, menace)
+			}
+		}
+		return nil
+	case *UniteProvoqueeEvent:
+		cible := c.trouverUnite(e.CibleID)
+		if cible == nil {
+			return nil
+		}
+		if err := c.appliquerStatut(cible, e.Statut); err != nil {
+			return err
+		}
+		c.provocations[e.CibleID] = e.SourceID
+		if table := c.TableMenace(e.CibleID); table != nil {
+			table.Add(e.SourceID, MenaceProvocation)
+		}
+		return nil
+	case *UniteCharmeeEvent:
+		cible := c.trouverUnite(e.CibleID)
+		if cible == nil {
+			return nil
+		}
+		if err := c.appliquerStatut(cible, e.Statut); err != nil {
+			return err
+		}
+		c.charmes[e.CibleID] = e.TeamID
+		return nil
+	case *PointDeRestaurationPoseEvent:
+		c.poserPointDeRestauration(e.UniteID)
+		return nil
+	case *ActionAnnuleeEvent:
+		return c.annulerAction()
+	case *ActionRetablieEvent:
+		return c.retablirAction()
+	case *ActionImposeeEvent, *SortRenvoyeEvent, *ObjetUtiliseEvent, *IncantationDemarreeEvent, *IncantationInterrompueEvent:
+		// Notifications: leurs effets sont portés par les événements qui les suivent
 		return nil
 	default:
+		if c.appliquerEffetUnite(evt) {
+			return nil
+		}
 		return errors.New("type d'événement inconnu")
 	}
 }
 
+// appliquerEffetUnite applique un événement qui modifie une unité (dégâts, soins, statuts, KO, déplacements...)
+// Retourne false si l'événement n'est pas de ce type. Une unité inconnue (combat antérieur aux
+// configurations de démarrage) est ignorée
+func (c *Combat) appliquerEffetUnite(evt Evenement) bool {
+	switch e := evt.(type) {
+	case *DegatsInfligesEvent:
+		c.modifierUnite(e.CibleID, func(u *Unite) { u.RecevoirDegats(e.Degats) })
+	case *SoinApliqueEvent:
+		c.modifierUnite(e.CibleID, func(u *Unite) { u.Soigner(e.Soin) })
+	case *MPRestaureEvent:
+		c.modifierUnite(e.CibleID, func(u *Unite) { u.RestaurerMP(e.MP) })
+	case *StatutAppliqueEvent:
+		c.modifierUnite(e.CibleID, func(u *Unite) { _ = c.appliquerStatut(u, e.Statut) })
+	case *StatutRetireEvent:
+		c.modifierUnite(e.CibleID, func(u *Unite) { u.RetirerStatut(e.TypeStatut) })
+	case *SortAnnuleEvent:
+		c.modifierUnite(e.CibleID, func(u *Unite) { u.RetirerStatut(shared.TypeStatutContreSort) })
+	case *UniteKOEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) {
+			u.combat.currentStats.HP = 0
+			u.combat.isEliminated = true
+			u.combat.koCountdown = e.CompteARebours
+		})
+	case *CompteAReboursKOEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) { u.combat.koCountdown = e.Restants })
+	case *UniteMorteEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) {
+			u.combat.isEliminated = true
+			u.combat.isDead = true
+			u.combat.koCountdown = 0
+		})
+	case *UniteRessusciteeEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) { u.Ressusciter(e.HP) })
+	case *DeplacementExecuteEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) { u.DeplacerVers(e.PositionArrivee) })
+	case *UniteDeplaceeEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) { u.DeplacerVers(e.PositionArrivee) })
+	case *StaminaConsommeeEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) { _ = u.ConsommerStamina(e.Stamina) })
+	case *TourUniteEntameEvent:
+		c.modifierUnite(e.UniteID, func(u *Unite) { e.effets = entamerTour(u, e.TauxRegenStamina) })
+	case *DureeStopDecompteeEvent:
+		for _, id := range e.UniteIDs {
+			c.modifierUnite(id, func(u *Unite) {
+				if stop := u.ObtenirStatut(shared.TypeStatutStop); stop != nil {
+					stop.DecrémenterDuree()
+				}
+			})
+		}
+	case *CompetenceUtiliseeEvent:
+		c.modifierUnite(e.ActeurID, func(u *Unite) {
+			if competence := u.ObtenirCompetence(e.CompetenceID); competence != nil {
+				_ = u.ConsommerMP(competence.CoutMP())
+				u.ActiverCooldown(competence.ID(), competence.Cooldown())
+			}
+		})
+	case *ActionExecuteeEvent:
+		if e.Action != nil && e.Resultat != nil && e.Action.Type == TypeActionFuite && e.Resultat.Succes {
+			c.modifierUnite(e.Action.ActeurID, func(u *Unite) { c.equipesFuites[u.TeamID()] = true })
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+// modifierUnite applique une modification à une unité du combat (ignorée si l'unité est inconnue)
+func (c *Combat) modifierUnite(id UnitID, modification func(u *Unite)) {
+	if unite := c.trouverUnite(id); unite != nil {
+		modification(unite)
+	}
+}
+
+// appliquerStatut ajoute une copie du statut d'un événement: l'événement n'est pas modifié
+// quand la durée du statut de l'unité s'écoule
+func (c *Combat) appliquerStatut(cible *Unite, statut *shared.Statut) error {
+	if statut == nil {
+		return errors.New("statut manquant")
+	}
+	copie := *statut
+	return cible.AjouterStatut(&copie)
+}
+
 func (c *Combat) trouverUnite(id UnitID) *Unite {
 	for _, equipe := range c.equipes {
 		for _, unite := range equipe.Membres() {
@@ -321,9 +1500,10 @@ func (c *Combat) obtenirPositionsOccupees(exclusionID UnitID) map[string]bool {
 	// Crée une map des positions occupées par toutes les unités sauf celle exclue
 	positions := make(map[string]bool)
 
+	// Les corps des unités KO occupent leur case, les unités mortes la libèrent
 	for _, equipe := range c.equipes {
 		for _, unite := range equipe.Membres() {
-			if unite.ID() != exclusionID && !unite.EstEliminee() {
+			if unite.ID() != exclusionID && !unite.EstMorte() {
 				pos := unite.Position()
 				cle := positionKey(pos)
 				positions[cle] = true
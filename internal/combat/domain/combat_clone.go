@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"maps"
+	"math/rand"
+	"time"
+
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// Cloner crée une copie indépendante du combat pour simuler une action (prévisualisation)
+// Unités, équipes, inventaires, butins, menace et activité du tour sont copiés: rien de ce que
+// la copie subit ne touche le combat d'origine, et ses événements restent dans la copie.
+// La copie reçoit sa propre chaîne de validation mais ni State Machine, ni invoker, ni
+// observateurs, ni actions d'entraînement à annuler: aucune notification ne part d'une simulation.
+// Son générateur aléatoire est neuf pour ne pas consommer les tirages du combat réel
+func (c *Combat) Cloner() *Combat {
+	clone := *c
+	clone.evenements = make([]Evenement, 0)
+	clone.stateMachine = nil
+	clone.commandInvoker = nil
+	clone.commandFactory = nil
+	clone.observerSubject = nil
+	clone.actionsAnnulables = nil
+	clone.actionsRefaisables = nil
+	clone.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	if c.validationChain != nil {
+		clone.validationChain = c.validationChain.Cloner()
+	}
+
+	clone.equipes = make(map[TeamID]*Equipe, len(c.equipes))
+	for id, equipe := range c.equipes {
+		clone.equipes[id] = equipe.cloner()
+	}
+
+	clone.inventaires = make(map[TeamID]*TeamInventory, len(c.inventaires))
+	for id, inventaire := range c.inventaires {
+		clone.inventaires[id] = inventaire.cloner()
+	}
+
+	clone.equipesFuites = maps.Clone(c.equipesFuites)
+	clone.butins = maps.Clone(c.butins)
+	clone.provocations = maps.Clone(c.provocations)
+	clone.charmes = maps.Clone(c.charmes)
+
+	clone.tablesMenace = make(map[UnitID]*ThreatTable, len(c.tablesMenace))
+	for id, table := range c.tablesMenace {
+		clone.tablesMenace[id] = &ThreatTable{ownerID: table.ownerID, threat: maps.Clone(table.threat)}
+	}
+
+	clone.jaugesATB = append([]JaugeATB(nil), c.jaugesATB...)
+	clone.chargesATB = copierChargesATB(c.chargesATB)
+	if c.activiteTour != nil {
+		activite := *c.activiteTour
+		clone.activiteTour = &activite
+	}
+
+	return &clone
+}
+
+// cloner copie l'équipe et chacun de ses membres
+func (e *Equipe) cloner() *Equipe {
+	clone := *e
+	clone.membres = make([]*Unite, 0, len(e.membres))
+	for _, membre := range e.membres {
+		clone.membres = append(clone.membres, membre.cloner())
+	}
+	return &clone
+}
+
+// cloner copie l'unité: stats, statuts, compétences (cooldowns) et ressources du tour
+func (u *Unite) cloner() *Unite {
+	clone := *u
+
+	combat := *u.combat
+	combat.baseStats = u.combat.baseStats.Clone()
+	combat.currentStats = u.combat.currentStats.Clone()
+	clone.combat = &combat
+
+	clone.statuses = NewUnitStatusManager()
+	for _, statut := range u.statuses.Statuses() {
+		copie := *statut
+		clone.statuses.statuses = append(clone.statuses.statuses, &copie)
+	}
+
+	clone.inventory = &UnitInventory{
+		skills: make([]*Competence, 0, len(u.inventory.skills)),
+		items:  append([]shared.ObjetID(nil), u.inventory.items...),
+	}
+	for _, competence := range u.inventory.skills {
+		clone.inventory.skills = append(clone.inventory.skills, competence.Clone())
+	}
+
+	return &clone
+}
+
+// cloner copie les quantités d'objets de l'équipe
+func (inv *TeamInventory) cloner() *TeamInventory {
+	return &TeamInventory{teamID: inv.teamID, quantities: maps.Clone(inv.quantities)}
+}
@@ -0,0 +1,232 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// ConfigurationCombat est la photographie du combat au démarrage (portée par CombatDemarreEvent)
+// Équipes, unités (stats, compétences, positions), grille, inventaires et règles: tout ce qui
+// n'est pas issu d'un événement et sans quoi le combat ne peut pas être reconstruit
+type ConfigurationCombat struct {
+	Grille          *shared.GrilleCombat
+	Equipes         []ConfigurationEquipe
+	CatalogueObjets []*shared.Item
+	Inventaires     map[TeamID]map[shared.ObjetID]int
+	ReglesStamina   *ReglesStamina
+	TypeButinMort   TypeButin
+	FuiteAutorisee  bool
+}
+
+// ConfigurationEquipe décrit une équipe et ses membres, dans l'ordre
+type ConfigurationEquipe struct {
+	ID       TeamID
+	Nom      string
+	Couleur  string
+	EstIA    bool
+	JoueurID *string
+	Membres  []ConfigurationUnite
+}
+
+// ConfigurationUnite décrit une unité: identité, stats de base, compétences et état au démarrage
+type ConfigurationUnite struct {
+	ID               UnitID
+	Nom              string
+	StatsBase        *shared.Stats
+	Etat             *EtatUnite
+	Competences      []ConfigurationCompetence
+	Objets           []shared.ObjetID
+	TauxRegenStamina int
+	EstIA            bool
+}
+
+// ConfigurationCompetence décrit une compétence (le cooldown en cours fait partie de EtatUnite)
+type ConfigurationCompetence struct {
+	ID           CompetenceID
+	Nom          string
+	Description  string
+	Type         TypeCompetence
+	Portee       int
+	FormeZone    FormeZone
+	TailleZone   int
+	CoutMP       int
+	CoutStamina  int
+	Cooldown     int
+	Incantation  int // Temps d'incantation en ticks ATB
+	DegatsBase   int
+	Modificateur float64
+	Effets       []ConfigurationEffet
+	Cibles       TypeCible
+}
+
+// ConfigurationEffet décrit un effet de compétence
+type ConfigurationEffet struct {
+	Type   TypeEffetCompetence
+	Valeur int
+	Duree  int
+	Statut *shared.TypeStatut
+}
+
+// capturerConfiguration photographie la configuration du combat (équipes triées par ID)
+func (c *Combat) capturerConfiguration() *ConfigurationCombat {
+	config := &ConfigurationCombat{
+		Grille:         c.grille,
+		Equipes:        make([]ConfigurationEquipe, 0, len(c.equipes)),
+		Inventaires:    make(map[TeamID]map[shared.ObjetID]int, len(c.inventaires)),
+		TypeButinMort:  c.typeButinMort,
+		FuiteAutorisee: c.fuiteAutorisee,
+	}
+	if c.reglesStamina != nil {
+		regles := *c.reglesStamina
+		config.ReglesStamina = &regles
+	}
+
+	for _, equipe := range c.equipes {
+		configEquipe := ConfigurationEquipe{
+			ID:       equipe.ID(),
+			Nom:      equipe.Nom(),
+			Couleur:  equipe.Couleur(),
+			EstIA:    equipe.IsIA(),
+			JoueurID: equipe.JoueurID(),
+			Membres:  make([]ConfigurationUnite, 0, len(equipe.Membres())),
+		}
+		for _, membre := range equipe.Membres() {
+			configEquipe.Membres = append(configEquipe.Membres, membre.capturerConfiguration())
+		}
+		config.Equipes = append(config.Equipes, configEquipe)
+	}
+	sort.Slice(config.Equipes, func(i, j int) bool { return config.Equipes[i].ID < config.Equipes[j].ID })
+
+	if c.catalogueObjets != nil {
+		config.CatalogueObjets = c.catalogueObjets.Items()
+		sort.Slice(config.CatalogueObjets, func(i, j int) bool {
+			return config.CatalogueObjets[i].ID < config.CatalogueObjets[j].ID
+		})
+	}
+	for teamID, inventaire := range c.inventaires {
+		config.Inventaires[teamID] = inventaire.Quantities()
+	}
+
+	return config
+}
+
+// capturerConfiguration photographie une unité et ses compétences
+func (u *Unite) capturerConfiguration() ConfigurationUnite {
+	config := ConfigurationUnite{
+		ID:               u.id,
+		Nom:              u.nom,
+		StatsBase:        u.combat.baseStats.Clone(),
+		Etat:             u.CapturerEtat(),
+		Competences:      make([]ConfigurationCompetence, 0, len(u.Competences())),
+		Objets:           append([]shared.ObjetID(nil), u.inventory.items...),
+		TauxRegenStamina: u.tauxRegenStamina,
+		EstIA:            u.estIA,
+	}
+	for _, competence := range u.Competences() {
+		configCompetence := ConfigurationCompetence{
+			ID:           competence.id,
+			Nom:          competence.nom,
+			Description:  competence.description,
+			Type:         competence.typeCompetence,
+			Portee:       competence.portee,
+			FormeZone:    competence.zone.forme,
+			TailleZone:   competence.zone.taille,
+			CoutMP:       competence.coutMP,
+			CoutStamina:  competence.coutStamina,
+			Cooldown:     competence.cooldown,
+			Incantation:  competence.incantation,
+			DegatsBase:   competence.degatsBase,
+			Modificateur: competence.modificateur,
+			Effets:       make([]ConfigurationEffet, 0, len(competence.effets)),
+			Cibles:       competence.cibles,
+		}
+		for _, effet := range competence.effets {
+			configCompetence.Effets = append(configCompetence.Effets, ConfigurationEffet{
+				Type:   effet.typeEffet,
+				Valeur: effet.valeur,
+				Duree:  effet.duree,
+				Statut: effet.statut,
+			})
+		}
+		config.Competences = append(config.Competences, configCompetence)
+	}
+	return config
+}
+
+// appliquerConfiguration recrée équipes, unités, grille, inventaires et règles depuis une configuration
+func (c *Combat) appliquerConfiguration(config *ConfigurationCombat) error {
+	c.grille = config.Grille
+	c.typeButinMort = config.TypeButinMort
+	c.fuiteAutorisee = config.FuiteAutorisee
+	if config.ReglesStamina != nil {
+		regles := *config.ReglesStamina
+		c.reglesStamina = &regles
+	}
+	if len(config.CatalogueObjets) > 0 {
+		c.catalogueObjets = NewItemCatalogue(config.CatalogueObjets...)
+	}
+
+	c.equipes = make(map[TeamID]*Equipe, len(config.Equipes))
+	c.inventaires = make(map[TeamID]*TeamInventory, len(config.Equipes))
+	for _, configEquipe := range config.Equipes {
+		equipe, err := NewEquipe(configEquipe.ID, configEquipe.Nom, configEquipe.Couleur, configEquipe.EstIA, configEquipe.JoueurID)
+		if err != nil {
+			return fmt.Errorf("équipe %s: %w", configEquipe.ID, err)
+		}
+		for _, configUnite := range configEquipe.Membres {
+			unite, err := configUnite.creerUnite(configEquipe.ID)
+			if err != nil {
+				return err
+			}
+			if err := equipe.AjouterMembre(unite); err != nil {
+				return fmt.Errorf("unité %s: %w", configUnite.ID, err)
+			}
+			// AjouterMembre impose le contrôle de l'équipe: rétablir celui de l'unité
+			unite.SetIA(configUnite.EstIA)
+		}
+		c.equipes[equipe.ID()] = equipe
+		c.inventaires[equipe.ID()] = NewTeamInventory(equipe.ID())
+	}
+
+	for teamID, quantites := range config.Inventaires {
+		for objetID, quantite := range quantites {
+			c.AjouterObjet(teamID, string(objetID), quantite)
+		}
+	}
+	return nil
+}
+
+// creerUnite recrée une unité, ses compétences et son état depuis sa configuration
+func (config ConfigurationUnite) creerUnite(teamID TeamID) (*Unite, error) {
+	if config.StatsBase == nil || config.Etat == nil || config.Etat.Stats == nil {
+		return nil, fmt.Errorf("configuration de l'unité %s incomplète", config.ID)
+	}
+
+	unite := NewUnite(config.ID, config.Nom, teamID, config.StatsBase.Clone(), config.Etat.Position)
+	for _, configCompetence := range config.Competences {
+		competence := NewCompetence(
+			configCompetence.ID,
+			configCompetence.Nom, configCompetence.Description,
+			configCompetence.Type,
+			configCompetence.Portee,
+			ZoneEffet{forme: configCompetence.FormeZone, taille: configCompetence.TailleZone},
+			configCompetence.CoutMP, configCompetence.CoutStamina, configCompetence.Cooldown,
+			configCompetence.DegatsBase,
+			configCompetence.Modificateur,
+			configCompetence.Cibles,
+		)
+		competence.SetTempsIncantation(configCompetence.Incantation)
+		for _, effet := range configCompetence.Effets {
+			competence.AjouterEffet(NewEffetCompetence(effet.Type, effet.Valeur, effet.Duree, effet.Statut))
+		}
+		if err := unite.AjouterCompetence(competence); err != nil {
+			return nil, fmt.Errorf("unité %s: %w", config.ID, err)
+		}
+	}
+	unite.inventory.items = append(make([]shared.ObjetID, 0, len(config.Objets)), config.Objets...)
+	unite.tauxRegenStamina = config.TauxRegenStamina
+	unite.RestaurerEtat(config.Etat)
+	return unite, nil
+}
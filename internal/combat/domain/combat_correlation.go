@@ -0,0 +1,35 @@
+package domain
+
+import "fmt"
+
+// DebuterAction ouvre l'action dont découlent les prochains événements et retourne son identifiant de corrélation
+// Tous les événements levés jusqu'à TerminerAction (ou la prochaine action) portent cet identifiant:
+// ex. CompetenceUtilisee, DegatsInfliges, UniteKO et ActionExecutee d'un même sort.
+// L'identifiant est celui du combat suivi de la version du premier événement de l'action,
+// unique dans le flux et identique quand le combat est rejoué
+func (c *Combat) DebuterAction() string {
+	c.correlationAction = fmt.Sprintf("%s:%d", c.id, c.version+len(c.evenements)+1)
+	return c.correlationAction
+}
+
+// TerminerAction ferme l'action en cours: un événement levé hors action est sa propre action
+func (c *Combat) TerminerAction() {
+	c.correlationAction = ""
+}
+
+// ActionEnCours retourne l'identifiant de corrélation de l'action en cours (vide hors action)
+func (c *Combat) ActionEnCours() string {
+	return c.correlationAction
+}
+
+// correler renseigne l'identifiant de corrélation d'un événement qui n'en a pas encore
+func (c *Combat) correler(evt Evenement) {
+	if evt.CorrelationID() != "" {
+		return
+	}
+	if c.correlationAction != "" {
+		evt.SetCorrelationID(c.correlationAction)
+		return
+	}
+	evt.SetCorrelationID(fmt.Sprintf("%s:%d", c.id, evt.AggregateVersion()))
+}
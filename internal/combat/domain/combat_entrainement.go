@@ -0,0 +1,143 @@
+package domain
+
+import "errors"
+
+// actionEntrainement est une action d'un joueur dans un combat d'entraînement
+// Elle garde l'état du combat d'avant l'action et les événements levés depuis (tours de l'IA
+// compris) pour être annulée puis refaite. Le flux d'événements n'est jamais tronqué: les actions
+// annulées et refaites sont rejouées au rechargement (PointDeRestaurationPose, ActionAnnulee, ActionRetablie)
+type actionEntrainement struct {
+	uniteID    UnitID
+	point      *PointDeRestauration
+	evenements []Evenement
+
+	// Actions refaisables avant celle-ci: une nouvelle action les invalide, sauf si elle est abandonnée
+	refaisables []*actionEntrainement
+}
+
+// PoserPointDeRestauration enregistre l'état du combat avant l'action d'un joueur (entraînement uniquement)
+// L'action pourra être annulée; les actions annulées jusqu'ici ne peuvent plus être refaites
+func (c *Combat) PoserPointDeRestauration(uniteID UnitID) {
+	if !c.entrainement {
+		return
+	}
+	evt := NewPointDeRestaurationPoseEvent(c.id, c.tourActuel, uniteID)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
+
+// AbandonnerPointDeRestauration retire le dernier point de restauration posé, quand son action
+// n'a pas pu être exécutée: le combat et son flux d'événements reviennent à l'état d'avant le point
+// et les actions annulées redeviennent refaisables
+func (c *Combat) AbandonnerPointDeRestauration() error {
+	if len(c.actionsAnnulables) == 0 {
+		return errors.New("aucun point de restauration")
+	}
+	action := c.actionsAnnulables[len(c.actionsAnnulables)-1]
+	if err := c.RestaurerPointDeRestauration(action.point); err != nil {
+		return err
+	}
+	c.actionsAnnulables = c.actionsAnnulables[:len(c.actionsAnnulables)-1]
+	c.actionsRefaisables = action.refaisables
+	return nil
+}
+
+// PeutAnnulerAction vérifie qu'une action d'entraînement peut être annulée
+func (c *Combat) PeutAnnulerAction() bool {
+	return c.entrainement && len(c.actionsAnnulables) > 0
+}
+
+// PeutRetablirAction vérifie qu'une action d'entraînement annulée peut être refaite
+func (c *Combat) PeutRetablirAction() bool {
+	return c.entrainement && len(c.actionsRefaisables) > 0
+}
+
+// AnnulerAction remet le combat dans l'état d'avant la dernière action d'un joueur (entraînement uniquement)
+// Les tours joués depuis sont annulés avec elle. Lève ActionAnnuleeEvent
+func (c *Combat) AnnulerAction() error {
+	if !c.entrainement {
+		return errors.New("annuler une action est réservé aux combats d'entraînement")
+	}
+	if len(c.actionsAnnulables) == 0 {
+		return errors.New("aucune action à annuler")
+	}
+
+	evt := NewActionAnnuleeEvent(c.id, c.tourActuel, c.actionsAnnulables[len(c.actionsAnnulables)-1].uniteID)
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
+}
+
+// RetablirAction refait la dernière action annulée (entraînement uniquement)
+// Ses événements sont réappliqués: l'action et les tours qui l'avaient suivie se rejouent à l'identique.
+// Lève ActionRetablieEvent
+func (c *Combat) RetablirAction() error {
+	if !c.entrainement {
+		return errors.New("refaire une action est réservé aux combats d'entraînement")
+	}
+	if len(c.actionsRefaisables) == 0 {
+		return errors.New("aucune action à refaire")
+	}
+
+	evt := NewActionRetablieEvent(c.id, c.tourActuel, c.actionsRefaisables[len(c.actionsRefaisables)-1].uniteID)
+	if err := c.Apply(evt); err != nil {
+		return err
+	}
+	c.RaiseEvent(evt)
+	return nil
+}
+
+// poserPointDeRestauration empile une nouvelle action annulable (Apply de PointDeRestaurationPoseEvent)
+func (c *Combat) poserPointDeRestauration(uniteID UnitID) {
+	c.actionsAnnulables = append(c.actionsAnnulables, &actionEntrainement{
+		uniteID:     uniteID,
+		point:       c.CapturerPointDeRestauration(),
+		evenements:  make([]Evenement, 0),
+		refaisables: c.actionsRefaisables,
+	})
+	c.actionsRefaisables = nil
+}
+
+// annulerAction restaure l'état d'avant la dernière action (Apply de ActionAnnuleeEvent)
+func (c *Combat) annulerAction() error {
+	if len(c.actionsAnnulables) == 0 {
+		return errors.New("aucune action à annuler")
+	}
+	action := c.actionsAnnulables[len(c.actionsAnnulables)-1]
+	c.restaurerEtat(action.point)
+	c.actionsAnnulables = c.actionsAnnulables[:len(c.actionsAnnulables)-1]
+	c.actionsRefaisables = append(c.actionsRefaisables, action)
+	return nil
+}
+
+// retablirAction réapplique les événements de la dernière action annulée (Apply de ActionRetablieEvent)
+func (c *Combat) retablirAction() error {
+	if len(c.actionsRefaisables) == 0 {
+		return errors.New("aucune action à refaire")
+	}
+	action := c.actionsRefaisables[len(c.actionsRefaisables)-1]
+	for _, evt := range action.evenements {
+		if err := c.Apply(evt); err != nil {
+			return err
+		}
+	}
+	c.actionsRefaisables = c.actionsRefaisables[:len(c.actionsRefaisables)-1]
+	c.actionsAnnulables = append(c.actionsAnnulables, action)
+	return nil
+}
+
+// memoriserEvenement rattache un événement levé ou rejoué à la dernière action annulable
+// (les événements d'annuler/refaire n'appartiennent à aucune action)
+func (c *Combat) memoriserEvenement(evt Evenement) {
+	if len(c.actionsAnnulables) == 0 {
+		return
+	}
+	switch evt.(type) {
+	case *PointDeRestaurationPoseEvent, *ActionAnnuleeEvent, *ActionRetablieEvent:
+		return
+	}
+	action := c.actionsAnnulables[len(c.actionsAnnulables)-1]
+	action.evenements = append(action.evenements, evt)
+}
@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// PauseCombat décrit la pause en cours d'un combat (litige arbitré par le MJ)
+// Le délai du tour est gelé: il repart à la reprise avec le temps qu'il restait
+type PauseCombat struct {
+	Motif        string
+	Debut        time.Time
+	UniteID      UnitID        // Unité dont le délai est gelé (vide sans délai en cours)
+	TempsRestant time.Duration // Temps restant du délai au moment de la pause
+}
+
+// DelaiGele indique si un délai de tour a été gelé par la pause
+func (p *PauseCombat) DelaiGele() bool {
+	return p.UniteID != ""
+}
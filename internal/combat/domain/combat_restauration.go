@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"errors"
+	"maps"
+)
+
+// PointDeRestauration est l'état complet d'un combat avant une commande (rollback, annuler en entraînement)
+// Unités, menaces, provocations, charmes, fuites, butins, inventaires, fin de combat, activité,
+// délai et ordonnancement du tour sont capturés. Les événements levés après la capture sont
+// retirés du flux non committé à la restauration: ils ne sont jamais enregistrés
+type PointDeRestauration struct {
+	evenements int // Nombre d'événements levés depuis la création du combat (committés compris)
+
+	unites       map[UnitID]*EtatUnite
+	menaces      map[UnitID]map[UnitID]int
+	provocations map[UnitID]UnitID
+	charmes      map[UnitID]TeamID
+	fuites       map[TeamID]bool
+	butins       map[string]*Butin
+	inventaires  map[TeamID]*TeamInventory
+
+	etat                   EtatCombat
+	tourActuel             int
+	vainqueur              *TeamID
+	recompensesDistribuees bool
+
+	activiteTour    *ActiviteTour
+	echeanceTour    *EcheanceTour
+	jaugesATB       []JaugeATB
+	chargesATB      []ChargeATB
+	curseurRound    *CurseurRound
+	positionMachine *PositionMachineEtats
+}
+
+// CapturerPointDeRestauration photographie le combat et la position de son flux d'événements
+func (c *Combat) CapturerPointDeRestauration() *PointDeRestauration {
+	point := &PointDeRestauration{
+		evenements:             c.version + len(c.evenements),
+		unites:                 make(map[UnitID]*EtatUnite),
+		menaces:                make(map[UnitID]map[UnitID]int, len(c.tablesMenace)),
+		provocations:           maps.Clone(c.provocations),
+		charmes:                maps.Clone(c.charmes),
+		fuites:                 maps.Clone(c.equipesFuites),
+		butins:                 maps.Clone(c.butins),
+		inventaires:            make(map[TeamID]*TeamInventory, len(c.inventaires)),
+		etat:                   c.etat,
+		tourActuel:             c.tourActuel,
+		vainqueur:              c.vainqueur,
+		recompensesDistribuees: c.recompensesDistribuees,
+		activiteTour:           c.activiteTour,
+		echeanceTour:           c.echeanceTour,
+		jaugesATB:              append([]JaugeATB(nil), c.jaugesATB...),
+		chargesATB:             copierChargesATB(c.chargesATB),
+		curseurRound:           c.curseurRound,
+		positionMachine:        c.positionMachine,
+	}
+	for _, equipe := range c.equipes {
+		for _, unite := range equipe.Membres() {
+			point.unites[unite.ID()] = unite.CapturerEtat()
+		}
+	}
+	for id, table := range c.tablesMenace {
+		point.menaces[id] = maps.Clone(table.threat)
+	}
+	for id, inventaire := range c.inventaires {
+		point.inventaires[id] = inventaire.cloner()
+	}
+	if c.activiteTour != nil {
+		activite := *c.activiteTour
+		point.activiteTour = &activite
+	}
+	return point
+}
+
+// RestaurerPointDeRestauration remet le combat dans l'état capturé et retire les événements levés depuis
+// Impossible si ces événements ont déjà été committés (enregistrés dans l'Event Store)
+func (c *Combat) RestaurerPointDeRestauration(point *PointDeRestauration) error {
+	if point == nil {
+		return errors.New("aucun point de restauration")
+	}
+	if point.evenements < c.version {
+		return errors.New("les événements à annuler ont déjà été enregistrés")
+	}
+	if garder := point.evenements - c.version; garder < len(c.evenements) {
+		c.evenements = c.evenements[:garder]
+	}
+	c.restaurerEtat(point)
+	return nil
+}
+
+// restaurerEtat remet le combat dans l'état capturé sans toucher au flux d'événements
+func (c *Combat) restaurerEtat(point *PointDeRestauration) {
+	for _, equipe := range c.equipes {
+		for _, unite := range equipe.Membres() {
+			if etat, exists := point.unites[unite.ID()]; exists {
+				unite.RestaurerEtat(etat)
+			}
+		}
+	}
+
+	c.tablesMenace = make(map[UnitID]*ThreatTable, len(point.menaces))
+	for id, menace := range point.menaces {
+		c.tablesMenace[id] = &ThreatTable{ownerID: id, threat: maps.Clone(menace)}
+	}
+	c.provocations = maps.Clone(point.provocations)
+	c.charmes = maps.Clone(point.charmes)
+	c.equipesFuites = maps.Clone(point.fuites)
+	c.butins = maps.Clone(point.butins)
+	c.inventaires = make(map[TeamID]*TeamInventory, len(point.inventaires))
+	for id, inventaire := range point.inventaires {
+		c.inventaires[id] = inventaire.cloner()
+	}
+
+	c.etat = point.etat
+	c.tourActuel = point.tourActuel
+	c.vainqueur = point.vainqueur
+	c.recompensesDistribuees = point.recompensesDistribuees
+	c.activiteTour = nil
+	if point.activiteTour != nil {
+		activite := *point.activiteTour
+		c.activiteTour = &activite
+	}
+	c.echeanceTour = point.echeanceTour
+	c.jaugesATB = append([]JaugeATB(nil), point.jaugesATB...)
+	c.chargesATB = copierChargesATB(point.chargesATB)
+	c.curseurRound = point.curseurRound
+	c.positionMachine = point.positionMachine
+}
@@ -0,0 +1,99 @@
+package commands
+
+import (
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// NewActionExecutedEvent résume une commande jouée et son résultat dans un ActionExecuteeEvent
+// Les effets détaillés ont déjà leurs propres événements (DegatsInfliges, SoinApplique...):
+// celui-ci clôt l'action dans le flux, y compris pour une commande sans effet (Attendre, Fuite ratée)
+func NewActionExecutedEvent(combat *domain.Combat, cmd Command, result *CommandResult) *domain.ActionExecuteeEvent {
+	action := &domain.ActionCombat{
+		Type:     actionType(cmd),
+		ActeurID: cmd.GetActor().ID(),
+	}
+	resultat := &domain.ResultatAction{
+		ActeurID:   action.ActeurID,
+		TypeAction: action.Type,
+		Effets:     make([]domain.EffetAction, 0),
+	}
+
+	switch c := cmd.(type) {
+	case *MoveCommand:
+		action.PositionCible = c.GetTargetPosition()
+		resultat.CheminParcouru = c.GetPath()
+	case *SkillCommand:
+		competenceID := c.GetSkill().ID()
+		action.CompetenceID = &competenceID
+	case *ItemCommand:
+		objetID := shared.ObjetID(c.GetItem().GetID())
+		action.ObjetID = &objetID
+		action.PositionCible = c.GetTargetPosition()
+	}
+	if targeting, ok := cmd.(TargetingCommand); ok {
+		if cibles := targeting.GetTargets(); len(cibles) > 0 && cibles[0] != nil {
+			cibleID := cibles[0].ID()
+			action.CibleID = &cibleID
+		}
+	}
+
+	if result != nil {
+		resultat.Succes = result.Success
+		resultat.Message = result.Message
+		resultat.CoutDeplacement = result.CostMovement
+		resultat.Effets = actionEffects(result.Effects, resultat.Effets)
+	}
+
+	evt := domain.NewActionExecuteeEvent(combat.ID(), combat.TourActuel(), action, resultat)
+	evt.TypeCommande = string(cmd.GetType())
+	return evt
+}
+
+// actionType retourne le type d'action du domaine correspondant à une commande
+func actionType(cmd Command) domain.TypeAction {
+	switch cmd.GetType() {
+	case CommandTypeAttack:
+		return domain.TypeActionAttaque
+	case CommandTypeSkill:
+		return domain.TypeActionCompetence
+	case CommandTypeMove:
+		return domain.TypeActionDeplacement
+	case CommandTypeItem:
+		return domain.TypeActionObjet
+	case CommandTypeWait:
+		return domain.TypeActionPasser
+	case CommandTypeFlee:
+		return domain.TypeActionFuite
+	case CommandTypeComposite:
+		return domain.TypeActionComposee
+	default:
+		return domain.TypeActionPersonnalisee
+	}
+}
+
+// actionEffects convertit les effets d'une commande (effets liés compris) en effets d'action du domaine
+// Les effets sans équivalent (KO, butin, renvoi...) sont tracés par leurs propres événements
+func actionEffects(effects []CommandEffect, effets []domain.EffetAction) []domain.EffetAction {
+	for _, effect := range effects {
+		effet := domain.EffetAction{CibleID: effect.TargetID, Valeur: effect.Value, Statut: effect.Status, Position: effect.Position}
+		switch effect.Type {
+		case EffectTypeDamage:
+			effet.Type = domain.TypeEffetActionDegats
+		case EffectTypeHealing, EffectTypeDrain, EffectTypeRevive:
+			effet.Type = domain.TypeEffetActionSoin
+		case EffectTypeStatus, EffectTypeStatusRemoved:
+			effet.Type = domain.TypeEffetActionStatut
+		case EffectTypeMovement:
+			effet.Type = domain.TypeEffetActionDeplacement
+		case EffectTypeStatChange, EffectTypeManaRestore, EffectTypeManaDrain:
+			effet.Type = domain.TypeEffetActionModificationStat
+		default:
+			effets = actionEffects(effect.Linked, effets)
+			continue
+		}
+		effets = append(effets, effet)
+		effets = actionEffects(effect.Linked, effets)
+	}
+	return effets
+}
@@ -43,18 +43,41 @@ func (c *AttackCommand) Validate() error {
 		return fmt.Errorf("cible hors de portée (distance: %d, portée max: %d)", distance, domain.PorteeAttaqueMelee)
 	}
 
-	// 4. Vérifier que la cible est dans une équipe ennemie
-	if c.actor.TeamID() == c.target.TeamID() {
+	// 4. Vérifier que la cible est ennemie (camp effectif en cas de Charme)
+	// Une unité confuse peut frapper n'importe qui sauf elle-même
+	if c.actor.ID() == c.target.ID() {
+		return fmt.Errorf("une unité ne peut pas s'attaquer elle-même")
+	}
+	if !c.actor.EstConfuse() && !c.combat.SontEnnemis(c.actor, c.target) {
 		return fmt.Errorf("impossible d'attaquer un allié")
 	}
 
+	// 5. Vérifier l'épuisement et la Stamina
+	if c.actor.EstEpuise() {
+		return fmt.Errorf("l'unité %s est épuisée", c.actor.Nom())
+	}
+
+	if err := c.checkStamina(c.GetStaminaCost()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// GetTargets retourne la cible de l'attaque
+func (c *AttackCommand) GetTargets() []*domain.Unite {
+	return []*domain.Unite{c.target}
+}
+
+// GetStaminaCost retourne le coût en Stamina de l'attaque basique
+func (c *AttackCommand) GetStaminaCost() int {
+	return c.combat.ReglesStamina().CoutAttaque
+}
+
 // Execute exécute l'attaque
 func (c *AttackCommand) Execute() (*CommandResult, error) {
 	// Créer un snapshot avant modification
-	c.CreateSnapshot()
+	c.CreateSnapshot(c.target)
 
 	// Obtenir la compétence par défaut (attaque basique)
 	competence := c.actor.ObtenirCompetenceParDefaut()
@@ -63,9 +86,6 @@ func (c *AttackCommand) Execute() (*CommandResult, error) {
 	calculator := c.combat.GetDamageCalculator()
 	degatsFinaux := calculator.Calculate(c.actor, c.target, competence)
 
-	// Appliquer les dégâts
-	c.target.RecevoirDegats(degatsFinaux)
-
 	// Créer le résultat
 	result := &CommandResult{
 		Success:     true,
@@ -80,21 +100,20 @@ func (c *AttackCommand) Execute() (*CommandResult, error) {
 		},
 	}
 
+	// Consommer la Stamina avant d'appliquer les dégâts
+	if err := c.ConsumeStamina(c.GetStaminaCost(), result); err != nil {
+		return nil, err
+	}
+
+	// Appliquer les dégâts
+	c.DealDamage(c.target, degatsFinaux, result)
+	c.SignalKO(c.target, result)
+
+	c.RecordTurnActivity()
 	return result, nil
 }
 
-// Rollback annule l'attaque (restaure les HP de la cible)
+// Rollback annule l'attaque (Stamina et épuisement de l'acteur, HP, sommeil et KO de la cible)
 func (c *AttackCommand) Rollback() error {
-	if c.snapshot == nil {
-		return fmt.Errorf("aucun snapshot disponible pour rollback")
-	}
-
-	// Restaurer les HP de la cible si elle est dans le snapshot
-	if _, exists := c.snapshot.TargetStates[c.target.ID()]; exists {
-		// Avec SetHP implémentée maintenant, on pourrait faire le rollback complet
-		// c.target.SetHP(targetSnapshot.HP)
-		fmt.Printf("[Rollback] Rollback de l'attaque sur %s\n", c.target.Nom())
-	}
-
-	return nil
+	return c.RestoreSnapshot()
 }
@@ -27,6 +27,22 @@ type Command interface {
 	GetActor() *domain.Unite
 }
 
+// StaminaConsumer est implémenté par les commandes physiques (déplacement, attaque, compétence)
+// Utilisé par le CostValidator pour vérifier la Stamina avant exécution
+type StaminaConsumer interface {
+	// GetStaminaCost retourne le coût en Stamina de la commande
+	GetStaminaCost() int
+}
+
+// TargetingCommand est implémenté par les commandes visant des unités (attaque, compétence)
+// Utilisé par le TargetValidator pour faire respecter les provocations
+type TargetingCommand interface {
+	// GetTargets retourne les unités visées
+	GetTargets() []*domain.Unite
+	// GetCombat retourne le combat dans lequel la commande s'exécute
+	GetCombat() *domain.Combat
+}
+
 // CommandType énumère les types de commandes
 type CommandType string
 
@@ -37,6 +53,9 @@ const (
 	CommandTypeItem   CommandType = "ITEM"
 	CommandTypeFlee   CommandType = "FLEE"
 	CommandTypeWait   CommandType = "WAIT"
+
+	// CommandTypeComposite enchaîne plusieurs commandes en un seul tour (déplacement puis action)
+	CommandTypeComposite CommandType = "COMPOSITE"
 )
 
 // CommandResult représente le résultat de l'exécution d'une commande
@@ -59,17 +78,29 @@ type CommandEffect struct {
 	Value    int
 	Position *shared.Position
 	Status   *shared.Statut
+
+	// Effets liés résolus avec celui-ci (ex: drain de vie rattaché aux dégâts)
+	Linked []CommandEffect
 }
 
 // EffectType énumère les types d'effets
 type EffectType string
 
 const (
-	EffectTypeDamage     EffectType = "DAMAGE"
-	EffectTypeHealing    EffectType = "HEALING"
-	EffectTypeStatus     EffectType = "STATUS"
-	EffectTypeMovement   EffectType = "MOVEMENT"
-	EffectTypeStatChange EffectType = "STAT_CHANGE"
+	EffectTypeDamage         EffectType = "DAMAGE"
+	EffectTypeHealing        EffectType = "HEALING"
+	EffectTypeStatus         EffectType = "STATUS"
+	EffectTypeMovement       EffectType = "MOVEMENT"
+	EffectTypeStatChange     EffectType = "STAT_CHANGE"
+	EffectTypeManaRestore    EffectType = "MANA_RESTORE"
+	EffectTypeStatusRemoved  EffectType = "STATUS_REMOVED"
+	EffectTypeRevive         EffectType = "REVIVE"
+	EffectTypeKO             EffectType = "KO"
+	EffectTypeLootPickup     EffectType = "LOOT_PICKUP"
+	EffectTypeDrain          EffectType = "DRAIN"
+	EffectTypeManaDrain      EffectType = "MANA_DRAIN"
+	EffectTypeReflect        EffectType = "REFLECT"
+	EffectTypeSpellCountered EffectType = "SPELL_COUNTERED"
 )
 
 // BaseCommand fournit une implémentation de base pour les commandes
@@ -84,21 +115,30 @@ type BaseCommand struct {
 }
 
 // CommandSnapshot sauvegarde l'état avant exécution pour rollback
+// L'acteur et chaque unité touchée (cibles, cibles de zone, cible d'un renvoi) sont capturés,
+// ainsi que l'état du combat (menaces, provocations, charmes, butins, inventaires, activité du tour)
+// et la position de son flux d'événements
 type CommandSnapshot struct {
-	ActorHP       int
-	ActorMP       int
-	ActorStamina  int
-	ActorPosition *shared.Position
-	TargetStates  map[domain.UnitID]*UnitSnapshot
+	ActorState   *UnitSnapshot
+	TargetStates map[domain.UnitID]*UnitSnapshot
+	CombatState  *domain.PointDeRestauration
 }
 
-// UnitSnapshot sauvegarde l'état d'une unité
+// UnitSnapshot sauvegarde l'état complet d'une unité
+// HP, MP, Stamina, position, statuts, cooldowns et KO (voir domain.EtatUnite)
 type UnitSnapshot struct {
-	HP       int
-	MP       int
-	Stamina  int
-	Position *shared.Position
-	Statuses []*shared.Statut
+	Unit  *domain.Unite
+	State *domain.EtatUnite
+}
+
+// newUnitSnapshot capture l'état actuel d'une unité
+func newUnitSnapshot(unit *domain.Unite) *UnitSnapshot {
+	return &UnitSnapshot{Unit: unit, State: unit.CapturerEtat()}
+}
+
+// restore remet l'unité dans l'état capturé
+func (s *UnitSnapshot) restore() {
+	s.Unit.RestaurerEtat(s.State)
 }
 
 // NewBaseCommand crée une nouvelle commande de base
@@ -120,17 +160,137 @@ func (c *BaseCommand) GetActor() *domain.Unite {
 	return c.actor
 }
 
-// CreateSnapshot crée un snapshot de l'état actuel
-func (c *BaseCommand) CreateSnapshot() {
+// GetCombat retourne le combat
+func (c *BaseCommand) GetCombat() *domain.Combat {
+	return c.combat
+}
+
+// CreateSnapshot crée un snapshot de l'état actuel de l'acteur et des cibles connues avant exécution
+// Les unités touchées plus tard (renvoi d'un sort) sont ajoutées par snapshotTarget
+func (c *BaseCommand) CreateSnapshot(targets ...*domain.Unite) {
 	c.snapshot = &CommandSnapshot{
-		ActorHP:       c.actor.HPActuels(),
-		ActorMP:       c.actor.StatsActuelles().MP,
-		ActorStamina:  c.actor.StatsActuelles().Stamina,
-		ActorPosition: c.actor.Position(),
-		TargetStates:  make(map[domain.UnitID]*UnitSnapshot),
+		ActorState:   newUnitSnapshot(c.actor),
+		TargetStates: make(map[domain.UnitID]*UnitSnapshot),
+		CombatState:  c.combat.CapturerPointDeRestauration(),
+	}
+	for _, target := range targets {
+		c.snapshotTarget(target)
+	}
+}
+
+// RecordTurnActivity enregistre l'activité du tour de l'acteur (part de jauge ATB conservée)
+// Un déplacement et une action se cumulent, attendre n'enregistre rien
+func (c *BaseCommand) RecordTurnActivity() {
+	switch c.commandType {
+	case CommandTypeWait:
+		return
+	case CommandTypeMove:
+		c.combat.EnregistrerDeplacementTour(c.actor.ID())
+	default:
+		c.combat.EnregistrerActionTour(c.actor.ID())
 	}
 }
 
+// checkStamina vérifie que l'acteur dispose de la Stamina nécessaire
+func (c *BaseCommand) checkStamina(cout int) error {
+	if c.actor.StatsActuelles().Stamina < cout {
+		return fmt.Errorf("Stamina insuffisante (coût: %d, disponible: %d)", cout, c.actor.StatsActuelles().Stamina)
+	}
+	return nil
+}
+
+// ConsumeStamina consomme la Stamina de l'acteur et trace l'épuisement éventuel
+func (c *BaseCommand) ConsumeStamina(cout int, result *CommandResult) error {
+	if cout <= 0 {
+		return nil
+	}
+
+	etaitEpuise := c.actor.EstEpuise()
+	if err := c.actor.ConsommerStamina(cout); err != nil {
+		return err
+	}
+	result.CostStamina += cout
+	c.combat.RaiseEvent(domain.NewStaminaConsommeeEvent(c.combat.ID(), c.combat.TourActuel(), c.actor.ID(), cout))
+
+	if !etaitEpuise && c.actor.EstEpuise() {
+		statut := c.actor.ObtenirStatut(shared.TypeStatutEpuisement)
+		result.StatusApplied = append(result.StatusApplied, statut)
+		result.Effects = append(result.Effects, CommandEffect{
+			Type:     EffectTypeStatus,
+			TargetID: c.actor.ID(),
+			Status:   statut,
+		})
+		// L'événement porte une copie: la durée du statut de l'unité s'écoule sans le modifier
+		copie := *statut
+		c.combat.RaiseEvent(domain.NewStatutAppliqueEvent(c.combat.ID(), c.combat.TourActuel(), c.actor.ID(), c.actor.ID(), &copie))
+	}
+	return nil
+}
+
+// DealDamage inflige des dégâts à une cible et trace leurs conséquences (menace, réveil d'une unité endormie)
+// Retourne les dégâts réellement subis. La mise KO est tracée ensuite par SignalKO
+func (c *BaseCommand) DealDamage(target *domain.Unite, degats int, result *CommandResult) int {
+	endormie := target.EstEndormie()
+	avant := target.HPActuels()
+
+	target.RecevoirDegats(degats)
+	reels := avant - target.HPActuels()
+	c.combat.EnregistrerDegats(c.actor, target, reels)
+	c.combat.RaiseEvent(domain.NewDegatsInfligesEvent(c.combat.ID(), c.combat.TourActuel(), c.actor.ID(), target.ID(), reels))
+
+	if endormie && !target.EstEndormie() {
+		result.Effects = append(result.Effects, CommandEffect{
+			Type:     EffectTypeStatusRemoved,
+			TargetID: target.ID(),
+			Status:   shared.NewStatut(shared.TypeStatutSommeil, 0, 0),
+		})
+		c.combat.RaiseEvent(domain.NewStatutRetireEvent(c.combat.ID(), c.combat.TourActuel(), c.actor.ID(), target.ID(), shared.TypeStatutSommeil))
+	}
+
+	return reels
+}
+
+// SignalKO trace la mise KO d'une cible qui était debout avant les dégâts
+func (c *BaseCommand) SignalKO(target *domain.Unite, result *CommandResult) {
+	if !target.EstKO() {
+		return
+	}
+	result.Effects = append(result.Effects, CommandEffect{
+		Type:     EffectTypeKO,
+		TargetID: target.ID(),
+		Value:    target.CompteAReboursKO(),
+	})
+	c.combat.RaiseEvent(domain.NewUniteKOEvent(c.combat.ID(), c.combat.TourActuel(), target.ID(), target.CompteAReboursKO()))
+}
+
+// snapshotTarget sauvegarde l'état d'une cible avant modification
+// Sans effet pour une unité déjà capturée (l'acteur est capturé à part, avant ses coûts)
+func (c *BaseCommand) snapshotTarget(target *domain.Unite) {
+	if target == nil || target.ID() == c.actor.ID() {
+		return
+	}
+	if _, exists := c.snapshot.TargetStates[target.ID()]; exists {
+		return
+	}
+	c.snapshot.TargetStates[target.ID()] = newUnitSnapshot(target)
+}
+
+// RestoreSnapshot remet le combat, l'acteur et toutes les unités touchées dans leur état d'avant l'exécution
+// Les événements levés par la commande sont retirés: ils ne seront jamais enregistrés
+func (c *BaseCommand) RestoreSnapshot() error {
+	if c.snapshot == nil {
+		return fmt.Errorf("aucun snapshot disponible pour rollback")
+	}
+	if err := c.combat.RestaurerPointDeRestauration(c.snapshot.CombatState); err != nil {
+		return fmt.Errorf("rollback impossible: %w", err)
+	}
+	for _, target := range c.snapshot.TargetStates {
+		target.restore()
+	}
+	c.snapshot.ActorState.restore()
+	return nil
+}
+
 // Rollback implémentation par défaut (ne fait rien)
 func (c *BaseCommand) Rollback() error {
 	// Les commandes concrètes peuvent override
@@ -139,15 +299,18 @@ func (c *BaseCommand) Rollback() error {
 
 // CommandInvoker gère l'historique et l'exécution des commandes
 // Invoker du Command Pattern - stocke et exécute les commandes
+// Deux piles: les commandes exécutées (annulables) et les commandes annulées (refaisables)
 type CommandInvoker struct {
 	history    []Command
-	maxHistory int
+	redoStack  []Command
+	maxHistory int // <= 0: historique illimité (combat d'entraînement)
 }
 
 // NewCommandInvoker crée un nouvel invoker
 func NewCommandInvoker(maxHistory int) *CommandInvoker {
 	return &CommandInvoker{
 		history:    make([]Command, 0),
+		redoStack:  make([]Command, 0),
 		maxHistory: maxHistory,
 	}
 }
@@ -170,29 +333,47 @@ func (inv *CommandInvoker) Execute(cmd Command) (*CommandResult, error) {
 	}
 
 	// 3. Ajouter à l'historique
-	inv.addToHistory(cmd)
+	inv.Record(cmd)
 
 	return result, nil
 }
 
+// Record ajoute à l'historique une commande exécutée hors de l'invoker (par la state machine)
+// Une nouvelle commande invalide les commandes annulées: elles ne peuvent plus être refaites
+func (inv *CommandInvoker) Record(cmd Command) {
+	inv.addToHistory(cmd)
+	inv.redoStack = inv.redoStack[:0]
+}
+
 // addToHistory ajoute une commande à l'historique
 func (inv *CommandInvoker) addToHistory(cmd Command) {
 	inv.history = append(inv.history, cmd)
 
 	// Limiter la taille de l'historique
-	if len(inv.history) > inv.maxHistory {
+	if inv.maxHistory > 0 && len(inv.history) > inv.maxHistory {
 		inv.history = inv.history[1:]
 	}
 }
 
+// SetMaxHistory change la taille maximale de l'historique (<= 0: illimité)
+func (inv *CommandInvoker) SetMaxHistory(maxHistory int) {
+	inv.maxHistory = maxHistory
+}
+
 // GetHistory retourne l'historique des commandes
 func (inv *CommandInvoker) GetHistory() []Command {
 	return inv.history
 }
 
-// Clear vide l'historique
+// GetRedoStack retourne les commandes annulées, la prochaine à refaire en dernier
+func (inv *CommandInvoker) GetRedoStack() []Command {
+	return inv.redoStack
+}
+
+// Clear vide l'historique et les commandes annulées
 func (inv *CommandInvoker) Clear() {
 	inv.history = make([]Command, 0)
+	inv.redoStack = make([]Command, 0)
 }
 
 // Implémentation de CommandSystemProvider interface
@@ -203,6 +384,7 @@ func (inv *CommandInvoker) CanUndo() bool {
 }
 
 // Undo implémente CommandSystemProvider
+// La commande annulée passe sur la pile de redo
 func (inv *CommandInvoker) Undo() error {
 	if !inv.CanUndo() {
 		return fmt.Errorf("aucune commande à annuler")
@@ -214,6 +396,35 @@ func (inv *CommandInvoker) Undo() error {
 	}
 
 	inv.history = inv.history[:len(inv.history)-1]
+	inv.redoStack = append(inv.redoStack, lastCmd)
+	return nil
+}
+
+// CanRedo implémente CommandSystemProvider
+func (inv *CommandInvoker) CanRedo() bool {
+	return len(inv.redoStack) > 0
+}
+
+// Redo implémente CommandSystemProvider
+// La dernière commande annulée est revalidée puis réexécutée, et revient dans l'historique
+func (inv *CommandInvoker) Redo() error {
+	if !inv.CanRedo() {
+		return fmt.Errorf("aucune commande à refaire")
+	}
+
+	cmd := inv.redoStack[len(inv.redoStack)-1]
+	if err := cmd.Validate(); err != nil {
+		return fmt.Errorf("validation échouée: %w", err)
+	}
+	if _, err := cmd.Execute(); err != nil {
+		if rollbackErr := cmd.Rollback(); rollbackErr != nil {
+			return fmt.Errorf("erreur lors de l'exécution ET du rollback: %w, %v", err, rollbackErr)
+		}
+		return fmt.Errorf("erreur lors de l'exécution: %w", err)
+	}
+
+	inv.redoStack = inv.redoStack[:len(inv.redoStack)-1]
+	inv.addToHistory(cmd)
 	return nil
 }
 
@@ -4,22 +4,33 @@ import (
 	"fmt"
 
 	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
-	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
 
 // CommandFactory crée des commandes selon les paramètres
 // Factory Pattern pour la création de commandes
 type CommandFactory struct {
-	combat *domain.Combat
+	combat   *domain.Combat
+	registry *CommandRegistry
 }
 
-// NewCommandFactory crée une nouvelle factory
+// NewCommandFactory crée une nouvelle factory, sans type de commande personnalisé
 func NewCommandFactory(combat *domain.Combat) *CommandFactory {
+	return NewCommandFactoryWithRegistry(combat, NewCommandRegistry())
+}
+
+// NewCommandFactoryWithRegistry crée une factory résolvant les types personnalisés dans le registre donné
+func NewCommandFactoryWithRegistry(combat *domain.Combat, registry *CommandRegistry) *CommandFactory {
 	return &CommandFactory{
-		combat: combat,
+		combat:   combat,
+		registry: registry,
 	}
 }
 
+// Registry retourne le registre des types de commandes personnalisés de la factory
+func (f *CommandFactory) Registry() *CommandRegistry {
+	return f.registry
+}
+
 // CreateMoveCommand crée une commande de déplacement
 func (f *CommandFactory) CreateMoveCommand(actor *domain.Unite, targetX, targetY int) (Command, error) {
 	position := f.combat.Grille().Position(targetX, targetY)
@@ -47,9 +58,8 @@ func (f *CommandFactory) CreateSkillCommand(actor *domain.Unite, skillID string,
 
 // CreateItemCommand crée une commande d'objet
 func (f *CommandFactory) CreateItemCommand(actor *domain.Unite, itemID string, targetID domain.UnitID) (Command, error) {
-	itemInterface := f.combat.ObtenirObjet(itemID)
-	item, ok := itemInterface.(*shared.Item)
-	if !ok || item == nil {
+	item := f.combat.ObtenirObjet(itemID)
+	if item == nil {
 		return nil, fmt.Errorf("objet %s non trouvé", itemID)
 	}
 	target := f.combat.TrouverUnite(targetID)
@@ -57,6 +67,17 @@ func (f *CommandFactory) CreateItemCommand(actor *domain.Unite, itemID string, t
 	return NewItemCommand(actor, f.combat, item, target), nil
 }
 
+// CreateItemCommandAtPosition crée une commande d'objet lancé sur une case (objets à zone)
+func (f *CommandFactory) CreateItemCommandAtPosition(actor *domain.Unite, itemID string, targetX, targetY int) (Command, error) {
+	item := f.combat.ObtenirObjet(itemID)
+	if item == nil {
+		return nil, fmt.Errorf("objet %s non trouvé", itemID)
+	}
+	position := f.combat.Grille().Position(targetX, targetY)
+
+	return NewItemCommandAtPosition(actor, f.combat, item, position), nil
+}
+
 // CreateFleeCommand crée une commande de fuite
 func (f *CommandFactory) CreateFleeCommand(actor *domain.Unite) (Command, error) {
 	return NewFleeCommand(actor, f.combat), nil
@@ -67,5 +88,18 @@ func (f *CommandFactory) CreateWaitCommand(actor *domain.Unite) (Command, error)
 	return NewWaitCommand(actor, f.combat), nil
 }
 
+// CreateCompositeCommand crée une commande composite jouant les étapes dans l'ordre, en un seul tour
+func (f *CommandFactory) CreateCompositeCommand(actor *domain.Unite, steps ...Command) (Command, error) {
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("commande composite sans étape")
+	}
+	return NewCompositeCommand(actor, f.combat, steps...), nil
+}
+
+// CreateRegisteredCommand crée une commande d'un type personnalisé enregistré dans le registre de la factory
+func (f *CommandFactory) CreateRegisteredCommand(actor *domain.Unite, commandType CommandType, params CommandParams) (Command, error) {
+	return f.registry.Create(commandType, actor, f.combat, params)
+}
+
 // Implémentation marker de CommandFactoryProvider
 // Les méthodes concrètes (CreateMoveCommand, CreateAttackCommand, etc.) sont déjà définies
@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+)
+
+// CommandParams sont les paramètres bruts d'une action personnalisée
+// Ex: corps JSON d'une requête REST, ou map passée à la facade
+// Les nombres décodés depuis du JSON sont des float64: utiliser Int pour les lire
+type CommandParams map[string]interface{}
+
+// Clés des paramètres communs, renseignées par l'API REST depuis la cible et la position de l'action
+const (
+	ParamTargetID = "targetID"
+	ParamTargetX  = "targetX"
+	ParamTargetY  = "targetY"
+)
+
+// ParamDecoder convertit les paramètres bruts d'une action en paramètres typés de la commande
+type ParamDecoder func(params CommandParams) (interface{}, error)
+
+// CommandConstructor construit une commande à partir des paramètres décodés
+type CommandConstructor func(actor *domain.Unite, combat *domain.Combat, params interface{}) (Command, error)
+
+// CommandDefinition décrit un type de commande ajouté hors du package (Vol, Saut, Lancer, Recruter...)
+// La commande est validée par la chaîne de validation comme les commandes standard:
+// ciblage et provocation via TargetingCommand, portée via RangedCommand, Stamina via StaminaConsumer
+type CommandDefinition struct {
+	Type CommandType
+
+	// Decode convertit les paramètres bruts (optionnel: sans décodeur, Create reçoit les CommandParams)
+	Decode ParamDecoder
+	// Create construit la commande
+	Create CommandConstructor
+
+	// Physical bloque la commande comme une attaque (Stun, épuisement)
+	Physical bool
+	// Magical bloque la commande comme une compétence (Silence)
+	Magical bool
+	// Hostile réserve la commande aux ennemis de l'acteur (sauf confusion), comme une attaque
+	Hostile bool
+
+	// Validate est une règle propre au type, appliquée en fin de chaîne de validation (optionnelle)
+	Validate func(cmd Command) error
+}
+
+// RangedCommand est implémenté par les commandes personnalisées visant des unités à distance
+// Utilisé par le RangeValidator (distance de Manhattan entre l'acteur et chaque cible)
+type RangedCommand interface {
+	// GetRange retourne la portée maximale de la commande
+	GetRange() int
+}
+
+// CommandRegistry référence les types de commandes personnalisés
+// Responsabilités: Résolution CommandType → définition (décodeur, constructeur, règles)
+// Le registre est injecté dans la CommandFactory et la chaîne de validation d'un combat
+// (voir combatinitializer.NewCombatInitializerWithRegistry): chaque moteur a ses propres types
+type CommandRegistry struct {
+	mu          sync.RWMutex
+	definitions map[CommandType]*CommandDefinition
+}
+
+// NewCommandRegistry crée un registre vide
+func NewCommandRegistry() *CommandRegistry {
+	return &CommandRegistry{
+		definitions: make(map[CommandType]*CommandDefinition),
+	}
+}
+
+// builtinCommandTypes sont les types standard, qui ne peuvent pas être redéfinis
+var builtinCommandTypes = map[CommandType]bool{
+	CommandTypeMove:      true,
+	CommandTypeAttack:    true,
+	CommandTypeSkill:     true,
+	CommandTypeItem:      true,
+	CommandTypeFlee:      true,
+	CommandTypeWait:      true,
+	CommandTypeComposite: true,
+}
+
+// NormalizeCommandType met un nom d'action au format des types de commandes ("steal" → "STEAL")
+func NormalizeCommandType(name string) CommandType {
+	return CommandType(strings.ToUpper(strings.TrimSpace(name)))
+}
+
+// Register ajoute un type de commande personnalisé
+func (r *CommandRegistry) Register(definition CommandDefinition) error {
+	definition.Type = NormalizeCommandType(string(definition.Type))
+	if definition.Type == "" {
+		return fmt.Errorf("type de commande requis")
+	}
+	if definition.Create == nil {
+		return fmt.Errorf("constructeur requis pour la commande %s", definition.Type)
+	}
+	if builtinCommandTypes[definition.Type] {
+		return fmt.Errorf("le type de commande %s est réservé", definition.Type)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.definitions[definition.Type]; exists {
+		return fmt.Errorf("le type de commande %s est déjà enregistré", definition.Type)
+	}
+	r.definitions[definition.Type] = &definition
+	return nil
+}
+
+// Unregister retire un type de commande personnalisé
+func (r *CommandRegistry) Unregister(commandType CommandType) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.definitions, NormalizeCommandType(string(commandType)))
+}
+
+// Lookup retourne la définition d'un type de commande personnalisé (insensible à la casse)
+// Un registre nil ne connaît aucun type personnalisé
+func (r *CommandRegistry) Lookup(commandType CommandType) (*CommandDefinition, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	definition, exists := r.definitions[NormalizeCommandType(string(commandType))]
+	return definition, exists
+}
+
+// Types retourne les types de commandes personnalisés enregistrés, triés
+func (r *CommandRegistry) Types() []CommandType {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	types := make([]CommandType, 0, len(r.definitions))
+	for commandType := range r.definitions {
+		types = append(types, commandType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// Create décode les paramètres puis construit une commande d'un type enregistré
+func (r *CommandRegistry) Create(commandType CommandType, actor *domain.Unite, combat *domain.Combat, params CommandParams) (Command, error) {
+	definition, exists := r.Lookup(commandType)
+	if !exists {
+		return nil, fmt.Errorf("type de commande inconnu: %s", commandType)
+	}
+	if actor == nil {
+		return nil, fmt.Errorf("acteur requis pour la commande %s", definition.Type)
+	}
+
+	var decoded interface{} = params
+	if definition.Decode != nil {
+		var err error
+		if decoded, err = definition.Decode(params); err != nil {
+			return nil, fmt.Errorf("paramètres invalides pour %s: %w", definition.Type, err)
+		}
+	}
+
+	cmd, err := definition.Create(actor, combat, decoded)
+	if err != nil {
+		return nil, err
+	}
+	if cmd.GetType() != definition.Type {
+		return nil, fmt.Errorf("la commande créée est de type %s au lieu de %s", cmd.GetType(), definition.Type)
+	}
+	return cmd, nil
+}
+
+// Int lit un entier (accepte les nombres JSON décodés en float64)
+func (p CommandParams) Int(key string) (int, bool) {
+	switch v := p[key].(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), v == float64(int(v))
+	default:
+		return 0, false
+	}
+}
+
+// String lit une chaîne
+func (p CommandParams) String(key string) (string, bool) {
+	v, ok := p[key].(string)
+	return v, ok
+}
+
+// UnitID lit un identifiant d'unité
+func (p CommandParams) UnitID(key string) (domain.UnitID, bool) {
+	switch v := p[key].(type) {
+	case domain.UnitID:
+		return v, true
+	case string:
+		return domain.UnitID(v), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,168 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+)
+
+// CompositeCommand enchaîne plusieurs commandes d'une même unité en un seul tour
+// (ex: déplacement, compétence puis fin de tour) de façon atomique:
+// toutes les étapes sont validées d'avance sur l'état projeté, exécutées dans l'ordre,
+// et tout est annulé si l'une d'elles échoue (un seul snapshot pour toute la séquence)
+type CompositeCommand struct {
+	*BaseCommand
+	steps []Command
+}
+
+// NewCompositeCommand crée une nouvelle commande composite
+func NewCompositeCommand(actor *domain.Unite, combat *domain.Combat, steps ...Command) *CompositeCommand {
+	return &CompositeCommand{
+		BaseCommand: NewBaseCommand(actor, combat, CommandTypeComposite),
+		steps:       steps,
+	}
+}
+
+// GetSteps retourne les étapes de la commande, dans leur ordre d'exécution
+func (c *CompositeCommand) GetSteps() []Command {
+	return c.steps
+}
+
+// Validate vérifie chaque étape sur l'état projeté de l'acteur
+// Une étape est validée (chaîne de validation du combat puis commande) comme si les étapes
+// précédentes avaient été jouées: position, MP, Stamina, cooldowns et objets consommés.
+// L'acteur est remis dans son état réel une fois la validation terminée
+func (c *CompositeCommand) Validate() error {
+	if len(c.steps) == 0 {
+		return fmt.Errorf("commande composite sans étape")
+	}
+
+	etat := c.actor.CapturerEtat()
+	defer c.actor.RestaurerEtat(etat)
+
+	objetsUtilises := make(map[string]int)
+	for i, step := range c.steps {
+		if err := c.checkStep(i, step); err != nil {
+			return err
+		}
+
+		if chain := c.combat.GetValidationChain(); chain != nil {
+			if err := chain.Validate(step); err != nil {
+				return c.stepError(i, step, err)
+			}
+		}
+		if err := step.Validate(); err != nil {
+			return c.stepError(i, step, err)
+		}
+
+		// Le même objet utilisé à plusieurs étapes doit être disponible en autant d'exemplaires
+		if item, ok := step.(*ItemCommand); ok {
+			objetsUtilises[item.GetItem().GetID()]++
+			if c.combat.ObtenirQuantiteObjet(c.actor.TeamID(), item.GetItem().GetID()) < objetsUtilises[item.GetItem().GetID()] {
+				return c.stepError(i, step, fmt.Errorf("objet %s non trouvé dans l'inventaire", item.GetItem().GetName()))
+			}
+		}
+
+		c.project(step)
+	}
+
+	return nil
+}
+
+// checkStep vérifie la place d'une étape dans la séquence
+func (c *CompositeCommand) checkStep(i int, step Command) error {
+	if step == nil {
+		return fmt.Errorf("étape %d non spécifiée", i+1)
+	}
+	if step.GetActor() == nil || step.GetActor().ID() != c.actor.ID() {
+		return fmt.Errorf("étape %d (%s): toutes les étapes doivent être jouées par %s", i+1, step.GetType(), c.actor.Nom())
+	}
+
+	switch step.GetType() {
+	case CommandTypeComposite:
+		return fmt.Errorf("étape %d: une commande composite ne peut pas en contenir une autre", i+1)
+	case CommandTypeWait, CommandTypeFlee:
+		// Attendre et fuir terminent le tour
+		if i != len(c.steps)-1 {
+			return fmt.Errorf("étape %d (%s): seule la dernière étape peut terminer le tour", i+1, step.GetType())
+		}
+	}
+	return nil
+}
+
+// stepError rattache une erreur à l'étape qui l'a produite
+func (c *CompositeCommand) stepError(i int, step Command, err error) error {
+	return fmt.Errorf("étape %d (%s): %w", i+1, step.GetType(), err)
+}
+
+// project applique à l'acteur l'effet d'une étape validée (position, MP, Stamina, cooldown)
+// pour valider les étapes suivantes sur l'état projeté
+func (c *CompositeCommand) project(step Command) {
+	switch s := step.(type) {
+	case *MoveCommand:
+		_ = c.actor.ConsommerStamina(s.GetStaminaCost())
+		c.actor.DeplacerVers(s.GetTargetPosition())
+	case *SkillCommand:
+		_ = c.actor.UtiliserCompetence(s.GetSkill().ID())
+	case StaminaConsumer:
+		_ = c.actor.ConsommerStamina(s.GetStaminaCost())
+	}
+}
+
+// Execute exécute les étapes dans l'ordre
+// Si une étape échoue, le combat est remis dans son état d'avant la première étape,
+// y compris ce que l'étape en échec a modifié avant d'échouer (avec ou sans snapshot de sa part)
+func (c *CompositeCommand) Execute() (*CommandResult, error) {
+	// Créer un snapshot avant la première étape (le point de restauration couvre tout le combat)
+	c.CreateSnapshot()
+
+	result := &CommandResult{
+		Success: true,
+		Effects: []CommandEffect{},
+	}
+	messages := make([]string, 0, len(c.steps))
+
+	for i, step := range c.steps {
+		stepResult, err := step.Execute()
+		if err != nil {
+			if rollbackErr := c.Rollback(); rollbackErr != nil {
+				return nil, fmt.Errorf("%w (rollback: %v)", c.stepError(i, step, err), rollbackErr)
+			}
+			return nil, c.stepError(i, step, err)
+		}
+
+		mergeResult(result, stepResult)
+		if stepResult.Message != "" {
+			messages = append(messages, stepResult.Message)
+		}
+	}
+
+	result.Message = strings.Join(messages, ", puis ")
+	return result, nil
+}
+
+// mergeResult cumule le résultat d'une étape dans celui de la commande composite
+func mergeResult(result, step *CommandResult) {
+	result.Success = result.Success && step.Success
+	result.Effects = append(result.Effects, step.Effects...)
+	result.CostMP += step.CostMP
+	result.CostStamina += step.CostStamina
+	result.CostMovement += step.CostMovement
+	result.DamageDealt += step.DamageDealt
+	result.HealingDone += step.HealingDone
+	result.StatusApplied = append(result.StatusApplied, step.StatusApplied...)
+}
+
+// Rollback annule toutes les étapes exécutées en restaurant le snapshot pris avant la première
+// Sans effet si la séquence n'a pas été exécutée ou a déjà été annulée
+func (c *CompositeCommand) Rollback() error {
+	if c.snapshot == nil {
+		return nil
+	}
+	if err := c.RestoreSnapshot(); err != nil {
+		return err
+	}
+	c.snapshot = nil
+	return nil
+}
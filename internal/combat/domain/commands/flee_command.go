@@ -43,6 +43,8 @@ func (c *FleeCommand) Validate() error {
 
 // Execute tente de fuir
 func (c *FleeCommand) Execute() (*CommandResult, error) {
+	c.CreateSnapshot()
+
 	// Calculer la probabilité de fuite
 	// Base: 50% + (SPD acteur - SPD moyenne ennemis) / 10
 	probability := c.calculateFleeProbability()
@@ -65,6 +67,7 @@ func (c *FleeCommand) Execute() (*CommandResult, error) {
 		result.Message = fmt.Sprintf("%s n'a pas réussi à fuir (probabilité: %.1f%%)", c.actor.Nom(), probability)
 	}
 
+	c.RecordTurnActivity()
 	return result, nil
 }
 
@@ -105,8 +108,6 @@ func (c *FleeCommand) calculateFleeProbability() float64 {
 
 // Rollback annule la fuite (remet l'équipe en jeu)
 func (c *FleeCommand) Rollback() error {
-	if c.fleeSuccess {
-		c.combat.AnnulerFuite(c.actor.TeamID())
-	}
-	return nil
+	c.fleeSuccess = false
+	return c.RestoreSnapshot()
 }
@@ -7,14 +7,17 @@ import (
 	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
 
-// ItemCommand représente l'utilisation d'un objet (Potion, Éther, Antidote)
+// ItemCommand représente l'utilisation d'un objet (Potion, Éther, Antidote, Queue de Phénix, Bombe)
+// Les objets à zone (AreaRadius > 0) touchent toutes les unités éligibles autour du point d'impact
 type ItemCommand struct {
 	*BaseCommand
-	item   *shared.Item
-	target *domain.Unite
+	item           *shared.Item
+	target         *domain.Unite    // Cible principale (nil si lancé sur une case)
+	targetPosition *shared.Position // Point d'impact d'un objet lancé sur une case
+	consumed       bool             // Objet retiré de l'inventaire (à restituer au rollback)
 }
 
-// NewItemCommand crée une nouvelle commande d'utilisation d'objet
+// NewItemCommand crée une nouvelle commande d'utilisation d'objet sur une unité
 func NewItemCommand(actor *domain.Unite, combat *domain.Combat, item *shared.Item, target *domain.Unite) *ItemCommand {
 	return &ItemCommand{
 		BaseCommand: NewBaseCommand(actor, combat, CommandTypeItem),
@@ -23,6 +26,29 @@ func NewItemCommand(actor *domain.Unite, combat *domain.Combat, item *shared.Ite
 	}
 }
 
+// NewItemCommandAtPosition crée une commande d'objet lancé sur une case (objets à zone)
+func NewItemCommandAtPosition(actor *domain.Unite, combat *domain.Combat, item *shared.Item, position *shared.Position) *ItemCommand {
+	return &ItemCommand{
+		BaseCommand:    NewBaseCommand(actor, combat, CommandTypeItem),
+		item:           item,
+		targetPosition: position,
+	}
+}
+
+// GetItem retourne l'objet utilisé
+func (c *ItemCommand) GetItem() *shared.Item {
+	return c.item
+}
+
+// GetTargetPosition retourne le point d'impact
+// Un objet utilisé sur une unité suit sa position actuelle (elle a pu se déplacer depuis la création)
+func (c *ItemCommand) GetTargetPosition() *shared.Position {
+	if c.target != nil {
+		return c.target.Position()
+	}
+	return c.targetPosition
+}
+
 // Validate vérifie si l'objet peut être utilisé
 func (c *ItemCommand) Validate() error {
 	// 1. Vérifier que l'acteur peut agir
@@ -35,160 +61,242 @@ func (c *ItemCommand) Validate() error {
 		return fmt.Errorf("aucun objet spécifié")
 	}
 
-	// 3. Vérifier que l'objet est dans l'inventaire
-	if !c.combat.PossedeObjet(c.item.GetID()) {
+	// 3. Vérifier que l'objet est dans l'inventaire de l'équipe
+	if !c.combat.PossedeObjet(c.actor.TeamID(), c.item.GetID()) {
 		return fmt.Errorf("objet %s non trouvé dans l'inventaire", c.item.GetName())
 	}
 
 	// 4. Vérifier la quantité disponible
-	quantite := c.combat.ObtenirQuantiteObjet(c.item.GetID())
+	quantite := c.combat.ObtenirQuantiteObjet(c.actor.TeamID(), c.item.GetID())
 	if quantite <= 0 {
 		return fmt.Errorf("quantité insuffisante pour %s", c.item.GetName())
 	}
 
-	// 5. Vérifier la cible
-	if c.target == nil {
+	// 5. Vérifier la cible / le point d'impact
+	if c.GetTargetPosition() == nil {
 		return fmt.Errorf("aucune cible spécifiée")
 	}
 
-	if c.target.EstEliminee() {
-		return fmt.Errorf("la cible %s est déjà éliminée", c.target.Nom())
+	if !c.combat.Grille().EstDansLimites(c.GetTargetPosition()) {
+		return fmt.Errorf("point d'impact hors limites")
 	}
 
-	// 6. Vérifier la portée (objets utilisables à distance)
-	distance := c.actor.Position().Distance(c.target.Position())
+	// 6. Vérifier la portée de lancer
+	distance := c.actor.Position().Distance(c.GetTargetPosition())
 
 	if distance > c.item.GetRange() {
 		return fmt.Errorf("cible hors de portée (distance: %d, portée: %d)", distance, c.item.GetRange())
 	}
 
 	// 7. Vérifier les restrictions d'usage
-	if !c.canUseItemOnTarget() {
+	if !c.item.IsAreaOfEffect() {
+		if c.target == nil {
+			return fmt.Errorf("aucune cible spécifiée")
+		}
+		if !c.canUseItemOnTarget() {
+			return fmt.Errorf("impossible d'utiliser %s sur %s", c.item.GetName(), c.target.Nom())
+		}
+		return nil
+	}
+
+	if c.target != nil && !c.isEligible(c.target) {
 		return fmt.Errorf("impossible d'utiliser %s sur %s", c.item.GetName(), c.target.Nom())
 	}
 
+	if len(c.resolveTargets()) == 0 {
+		return fmt.Errorf("aucune unité affectée par %s", c.item.GetName())
+	}
+
 	return nil
 }
 
-// canUseItemOnTarget vérifie si l'objet peut être utilisé sur la cible
+// isEligible vérifie si une unité peut être affectée par l'objet (cible principale ou zone)
+// Les camps sont les camps effectifs: une unité charmée soigne ses nouveaux alliés et peut bombarder son équipe d'origine
+func (c *ItemCommand) isEligible(unite *domain.Unite) bool {
+	allie := !c.combat.SontEnnemis(c.actor, unite)
+
+	switch c.item.GetItemType() {
+	case shared.ItemTypePotion, shared.ItemTypeEther, shared.ItemTypeAntidote:
+		return allie && !unite.EstEliminee()
+
+	case shared.ItemTypeRevive:
+		// Seules les unités KO sont ranimables, les morts sont définitifs
+		return allie && unite.EstKO()
+
+	case shared.ItemTypeBomb:
+		return !unite.EstEliminee() && (!allie || c.item.HasFriendlyFire())
+
+	default:
+		return false
+	}
+}
+
+// canUseItemOnTarget vérifie si l'objet peut être utilisé sur la cible unique
 func (c *ItemCommand) canUseItemOnTarget() bool {
+	if !c.isEligible(c.target) {
+		return false
+	}
+
 	switch c.item.GetItemType() {
 	case shared.ItemTypePotion:
-		// Potion: seulement sur alliés vivants avec HP < Max
-		return c.actor.TeamID() == c.target.TeamID() &&
-			!c.target.EstEliminee() &&
-			c.target.HPActuels() < c.target.Stats().HP
+		// Potion: seulement sur alliés avec HP < Max
+		return c.target.HPActuels() < c.target.Stats().HP
 
 	case shared.ItemTypeEther:
-		// Éther: seulement sur alliés vivants avec MP < Max
-		return c.actor.TeamID() == c.target.TeamID() &&
-			!c.target.EstEliminee() &&
-			c.target.StatsActuelles().MP < c.target.Stats().MP
+		// Éther: seulement sur alliés avec MP < Max
+		return c.target.StatsActuelles().MP < c.target.Stats().MP
 
 	case shared.ItemTypeAntidote:
 		// Antidote: seulement sur alliés empoisonnés
-		return c.actor.TeamID() == c.target.TeamID() &&
-			!c.target.EstEliminee() &&
-			c.target.EstEmpoisonne()
-
-	case shared.ItemTypeRevive:
-		// Revive: seulement sur alliés KO
-		return c.actor.TeamID() == c.target.TeamID() &&
-			c.target.EstEliminee()
-
-	case shared.ItemTypeBomb:
-		// Bombe: seulement sur ennemis vivants
-		return c.actor.TeamID() != c.target.TeamID() &&
-			!c.target.EstEliminee()
+		return c.target.EstEmpoisonne()
 
 	default:
 		return true
 	}
 }
 
+// resolveTargets retourne les unités affectées par l'objet
+func (c *ItemCommand) resolveTargets() []*domain.Unite {
+	if !c.item.IsAreaOfEffect() {
+		if c.target == nil {
+			return []*domain.Unite{}
+		}
+		return []*domain.Unite{c.target}
+	}
+
+	cibles := make([]*domain.Unite, 0)
+	for _, unite := range c.combat.ObtenirUnitesDansZone(c.GetTargetPosition(), c.item.GetAreaRadius()) {
+		if c.isEligible(unite) {
+			cibles = append(cibles, unite)
+		}
+	}
+	return cibles
+}
+
 // Execute utilise l'objet
 func (c *ItemCommand) Execute() (*CommandResult, error) {
+	// Résoudre les cibles avant application (les effets peuvent changer l'éligibilité)
+	cibles := c.resolveTargets()
+
 	// Créer un snapshot avant modification
-	c.CreateSnapshot()
+	c.CreateSnapshot(cibles...)
+
+	// Consommer l'objet (ObjetConsommeEvent)
+	if err := c.combat.ConsommerObjet(c.actor.TeamID(), c.item.GetID(), 1); err != nil {
+		return nil, err
+	}
+	c.consumed = true
 
-	// Consommer l'objet
-	c.combat.ConsommerObjet(c.item.GetID(), 1)
+	ciblesIDs := make([]domain.UnitID, 0, len(cibles))
+	for _, cible := range cibles {
+		ciblesIDs = append(ciblesIDs, cible.ID())
+	}
+	c.combat.RaiseEvent(domain.NewObjetUtiliseEvent(
+		c.combat.ID(), c.combat.TourActuel(), c.actor.ID(),
+		shared.ObjetID(c.item.GetID()), c.GetTargetPosition(), ciblesIDs,
+	))
 
 	// Créer le résultat
 	result := &CommandResult{
 		Success: true,
-		Message: fmt.Sprintf("%s utilise %s sur %s", c.actor.Nom(), c.item.GetName(), c.target.Nom()),
+		Message: c.describe(),
 		Effects: make([]CommandEffect, 0),
 	}
 
-	// Appliquer l'effet selon le type d'objet
+	// Appliquer l'effet selon le type d'objet sur chaque cible
+	for _, cible := range cibles {
+		c.applyEffect(cible, result)
+	}
+
+	c.RecordTurnActivity()
+	return result, nil
+}
+
+// describe construit le message du résultat
+func (c *ItemCommand) describe() string {
+	if c.target != nil {
+		return fmt.Sprintf("%s utilise %s sur %s", c.actor.Nom(), c.item.GetName(), c.target.Nom())
+	}
+	return fmt.Sprintf("%s utilise %s en (%d,%d)", c.actor.Nom(), c.item.GetName(), c.targetPosition.X(), c.targetPosition.Y())
+}
+
+// applyEffect applique l'effet de l'objet sur une cible et trace l'effet réel
+func (c *ItemCommand) applyEffect(cible *domain.Unite, result *CommandResult) {
+	tour := c.combat.TourActuel()
+
 	switch c.item.GetItemType() {
 	case shared.ItemTypePotion:
-		// Soigner les HP
-		soins := c.item.EffectValue()
-		c.target.Soigner(soins)
-		result.HealingDone = soins
+		// Soigner les HP (valeur réelle, plafonnée aux HP max)
+		avant := cible.HPActuels()
+		cible.Soigner(c.item.EffectValue())
+		soins := cible.HPActuels() - avant
+		c.combat.EnregistrerSoin(c.actor, soins)
+		result.HealingDone += soins
 		result.Effects = append(result.Effects, CommandEffect{
 			Type:     EffectTypeHealing,
-			TargetID: c.target.ID(),
+			TargetID: cible.ID(),
 			Value:    soins,
 		})
+		c.combat.RaiseEvent(domain.NewSoinApliqueEvent(c.combat.ID(), tour, c.actor.ID(), cible.ID(), soins))
 
 	case shared.ItemTypeEther:
-		// Restaurer les MP
-		mp := c.item.EffectValue()
-		c.target.RestaurerMP(mp)
+		// Restaurer les MP (valeur réelle, plafonnée aux MP max)
+		avant := cible.StatsActuelles().MP
+		cible.RestaurerMP(c.item.EffectValue())
+		mp := cible.StatsActuelles().MP - avant
 		result.Effects = append(result.Effects, CommandEffect{
-			Type:     EffectTypeHealing,
-			TargetID: c.target.ID(),
+			Type:     EffectTypeManaRestore,
+			TargetID: cible.ID(),
 			Value:    mp,
 		})
+		c.combat.RaiseEvent(domain.NewMPRestaureEvent(c.combat.ID(), tour, c.actor.ID(), cible.ID(), mp))
 
 	case shared.ItemTypeAntidote:
 		// Retirer le poison
-		c.target.RetirerStatut(shared.TypeStatutPoison)
+		poison := cible.ObtenirStatut(shared.TypeStatutPoison)
+		if poison == nil {
+			return
+		}
+		cible.RetirerStatut(shared.TypeStatutPoison)
 		result.Effects = append(result.Effects, CommandEffect{
-			Type:     EffectTypeStatus,
-			TargetID: c.target.ID(),
+			Type:     EffectTypeStatusRemoved,
+			TargetID: cible.ID(),
+			Status:   poison,
 		})
+		c.combat.RaiseEvent(domain.NewStatutRetireEvent(c.combat.ID(), tour, c.actor.ID(), cible.ID(), shared.TypeStatutPoison))
 
 	case shared.ItemTypeRevive:
 		// Ressusciter l'unité
-		c.target.Ressusciter(c.item.EffectValue())
-		result.HealingDone = c.item.EffectValue()
+		cible.Ressusciter(c.item.EffectValue())
+		hp := cible.HPActuels()
+		c.combat.EnregistrerSoin(c.actor, hp)
+		result.HealingDone += hp
 		result.Effects = append(result.Effects, CommandEffect{
-			Type:     EffectTypeHealing,
-			TargetID: c.target.ID(),
-			Value:    c.item.EffectValue(),
+			Type:     EffectTypeRevive,
+			TargetID: cible.ID(),
+			Value:    hp,
 		})
+		c.combat.RaiseEvent(domain.NewUniteRessusciteeEvent(c.combat.ID(), tour, c.actor.ID(), cible.ID(), hp))
 
 	case shared.ItemTypeBomb:
-		// Infliger des dégâts
-		degats := c.item.EffectValue()
-		c.target.RecevoirDegats(degats)
-		result.DamageDealt = degats
+		// Infliger des dégâts (valeur réelle, plafonnée aux HP restants)
+		degats := c.DealDamage(cible, c.item.EffectValue(), result)
+		result.DamageDealt += degats
 		result.Effects = append(result.Effects, CommandEffect{
 			Type:     EffectTypeDamage,
-			TargetID: c.target.ID(),
+			TargetID: cible.ID(),
 			Value:    degats,
 		})
+		c.SignalKO(cible, result)
 	}
-
-	return result, nil
 }
 
 // Rollback annule l'utilisation de l'objet
 func (c *ItemCommand) Rollback() error {
-	if c.snapshot == nil {
-		return fmt.Errorf("aucun snapshot disponible pour rollback")
+	// Restaurer l'inventaire de l'équipe et l'état de chaque cible (soins, MP, poison, résurrection, dégâts de zone)
+	if err := c.RestoreSnapshot(); err != nil {
+		return err
 	}
-
-	// Rendre l'objet à l'inventaire
-	c.combat.AjouterObjet(c.item.GetID(), 1)
-
-	// Restaurer l'état de la cible
-	// Note: nécessite des méthodes SetHP/SetMP sur Unite
-	fmt.Printf("[Rollback] Objet %s rendu à l'inventaire\n", c.item.GetName())
-
+	c.consumed = false
 	return nil
 }
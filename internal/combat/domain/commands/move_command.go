@@ -14,6 +14,7 @@ type MoveCommand struct {
 	targetPosition *shared.Position
 	path           []*shared.Position
 	cost           int
+	loot           *domain.Butin // Butin ramassé à l'arrivée (reposé au rollback)
 }
 
 // NewMoveCommand crée une nouvelle commande de déplacement
@@ -24,6 +25,16 @@ func NewMoveCommand(actor *domain.Unite, combat *domain.Combat, targetPosition *
 	}
 }
 
+// GetTargetPosition retourne la case de destination
+func (c *MoveCommand) GetTargetPosition() *shared.Position {
+	return c.targetPosition
+}
+
+// GetPath retourne le chemin calculé à la validation (nil avant validation)
+func (c *MoveCommand) GetPath() []*shared.Position {
+	return c.path
+}
+
 // Validate vérifie si le déplacement est possible
 func (c *MoveCommand) Validate() error {
 	// 1. Vérifier que l'acteur peut se déplacer (pas Root/Stun)
@@ -48,8 +59,8 @@ func (c *MoveCommand) Validate() error {
 	// Créer la map des positions occupées (excluant l'acteur)
 	unitesOccupees := c.combat.ObtenirPositionsOccupees(c.actor.ID())
 
-	// Calculer le chemin avec portée
-	porteeMax := c.actor.Stats().MOV
+	// Calculer le chemin avec portée (réduite si l'unité est épuisée)
+	porteeMax := c.actor.PorteeDeplacement()
 	path, cost, err := pathfindingService.TrouverCheminAvecPortee(
 		grille,
 		c.actor.Position(),
@@ -65,17 +76,29 @@ func (c *MoveCommand) Validate() error {
 	c.path = path
 	c.cost = cost
 
+	// 4. Vérifier la Stamina (coût par case parcourue)
+	if err := c.checkStamina(c.GetStaminaCost()); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// GetStaminaCost retourne le coût en Stamina du déplacement
+// Avant validation, le coût est estimé sur la distance Manhattan
+func (c *MoveCommand) GetStaminaCost() int {
+	cases := c.cost
+	if c.path == nil && c.targetPosition != nil {
+		cases = c.actor.Position().Distance(c.targetPosition)
+	}
+	return c.combat.ReglesStamina().CoutDeplacement(cases)
+}
+
 // Execute déplace l'unité
 func (c *MoveCommand) Execute() (*CommandResult, error) {
 	// Créer un snapshot avant modification
 	c.CreateSnapshot()
 
-	// Déplacer l'unité
-	c.actor.DeplacerVers(c.targetPosition)
-
 	// Créer le résultat
 	result := &CommandResult{
 		Success:      true,
@@ -90,16 +113,35 @@ func (c *MoveCommand) Execute() (*CommandResult, error) {
 		},
 	}
 
+	// Consommer la Stamina puis déplacer l'unité
+	if err := c.ConsumeStamina(c.GetStaminaCost(), result); err != nil {
+		return nil, err
+	}
+	depart := c.actor.Position()
+	c.actor.DeplacerVers(c.targetPosition)
+	c.combat.RaiseEvent(domain.NewDeplacementExecuteEvent(c.combat.ID(), c.combat.TourActuel(), c.actor.ID(), depart, c.targetPosition, c.path, c.cost))
+
+	// Ramasser le cristal ou le coffre présent sur la case d'arrivée
+	if butin := c.combat.RamasserButin(c.actor); butin != nil {
+		c.loot = butin
+		result.Effects = append(result.Effects, CommandEffect{
+			Type:     EffectTypeLootPickup,
+			TargetID: c.actor.ID(),
+			Position: butin.Position(),
+		})
+	}
+
+	c.RecordTurnActivity()
 	return result, nil
 }
 
 // Rollback annule le déplacement
 func (c *MoveCommand) Rollback() error {
-	if c.snapshot == nil {
-		return fmt.Errorf("aucun snapshot disponible pour rollback")
+	// Restaurer la position précédente, la Stamina, les HP/MP rendus par un cristal
+	// et le butin ramassé (reposé sur la grille, retiré de l'inventaire de l'équipe)
+	if err := c.RestoreSnapshot(); err != nil {
+		return err
 	}
-
-	// Restaurer la position précédente
-	c.actor.DeplacerVers(c.snapshot.ActorPosition)
+	c.loot = nil
 	return nil
 }
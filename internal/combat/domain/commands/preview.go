@@ -0,0 +1,257 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// ChanceToucheCertaine est la chance de toucher d'une action validée (%)
+// Le moteur n'a ni esquive ni précision: une action validée touche toujours ses cibles,
+// seuls un Contre-sort ou un Reflet détournent un effet (la prévision l'indique alors à 0)
+const ChanceToucheCertaine = 100
+
+// CommandPreview est la prévision d'une commande simulée sur une copie du combat (dry-run)
+// Ex: "Feu: 42 dégâts, 100% de toucher, met la cible KO"
+type CommandPreview struct {
+	Valid   bool
+	Reason  string // Raison du refus (commande invalide ou échec de la simulation)
+	Message string
+
+	CostMP       int
+	CostStamina  int
+	CostMovement int
+
+	Tiles   []*shared.Position // Cases touchées (chemin, zone d'effet, cibles)
+	Units   []UnitPreview      // Unités dont l'état change, triées par ID
+	Effects []CommandEffect
+}
+
+// UnitPreview est la prévision pour une unité touchée par la commande
+type UnitPreview struct {
+	UnitID domain.UnitID
+
+	// Fourchette de dégâts: égale aux dégâts simulés, le calcul des dégâts est déterministe
+	// (aucun critique n'est tiré, voir CriticalDamageCalculator)
+	DamageMin int
+	DamageMax int
+	Healing   int
+	// Chance de toucher (%): fixe, ChanceToucheCertaine pour une unité touchée, 0 pour un effet contré ou renvoyé
+	HitChance int
+
+	HPBefore      int
+	HPAfter       int
+	MPBefore      int
+	MPAfter       int
+	StaminaBefore int
+	StaminaAfter  int
+	Position      *shared.Position // Position après la commande
+
+	KO              bool // La commande met l'unité KO
+	Revived         bool // La commande relève l'unité
+	StatusesApplied []shared.TypeStatut
+	StatusesRemoved []shared.TypeStatut
+}
+
+// PreviewCommand simule une commande sur une copie du combat et en retourne les effets attendus
+// L'agrégat réel n'est jamais modifié et aucun de ses événements n'est émis (voir domain.Combat.Cloner).
+// build crée la commande avec la factory de la copie (types personnalisés du registre donné),
+// sur l'acteur et les cibles de la copie.
+// Une commande invalide donne une prévision Valid=false avec sa raison, pas une erreur
+func PreviewCommand(combat *domain.Combat, registry *CommandRegistry, build func(clone *domain.Combat, factory *CommandFactory) (Command, error)) (*CommandPreview, error) {
+	clone := combat.Cloner()
+	cmd, err := build(clone, NewCommandFactoryWithRegistry(clone, registry))
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &CommandPreview{Tiles: []*shared.Position{}, Units: []UnitPreview{}, Effects: []CommandEffect{}}
+
+	// Chaîne de validation du combat puis validation propre à la commande
+	if chain := clone.GetValidationChain(); chain != nil {
+		if err := chain.Validate(cmd); err != nil {
+			preview.Reason = err.Error()
+			return preview, nil
+		}
+	}
+	if err := cmd.Validate(); err != nil {
+		preview.Reason = err.Error()
+		return preview, nil
+	}
+
+	// Les cases sont relevées avant l'exécution (position des cibles, chemin calculé)
+	preview.Tiles = previewTiles(clone, cmd)
+	avant := captureUnits(clone)
+
+	result, err := cmd.Execute()
+	if err != nil {
+		preview.Reason = err.Error()
+		return preview, nil
+	}
+
+	preview.Valid = true
+	preview.Message = result.Message
+	preview.CostMP = result.CostMP
+	preview.CostStamina = result.CostStamina
+	preview.CostMovement = result.CostMovement
+	preview.Effects = result.Effects
+	preview.Units = previewUnits(clone, cmd, result, avant)
+	return preview, nil
+}
+
+// captureUnits capture l'état de toutes les unités du combat
+func captureUnits(combat *domain.Combat) map[domain.UnitID]*domain.EtatUnite {
+	etats := make(map[domain.UnitID]*domain.EtatUnite)
+	for _, equipe := range combat.Equipes() {
+		for _, unite := range equipe.Membres() {
+			etats[unite.ID()] = unite.CapturerEtat()
+		}
+	}
+	return etats
+}
+
+// previewUnits compare l'état des unités avant et après la simulation
+func previewUnits(combat *domain.Combat, cmd Command, result *CommandResult, avant map[domain.UnitID]*domain.EtatUnite) []UnitPreview {
+	degats := make(map[domain.UnitID]int)
+	soins := make(map[domain.UnitID]int)
+	collectEffects(result.Effects, degats, soins)
+
+	cibles := make(map[domain.UnitID]bool)
+	if targeting, ok := cmd.(TargetingCommand); ok {
+		for _, cible := range targeting.GetTargets() {
+			if cible != nil {
+				cibles[cible.ID()] = true
+			}
+		}
+	}
+
+	units := make([]UnitPreview, 0)
+	for id, etatAvant := range avant {
+		unite := combat.TrouverUnite(id)
+		etatApres := unite.CapturerEtat()
+		if !cibles[id] && degats[id] == 0 && soins[id] == 0 && etatsIdentiques(etatAvant, etatApres) {
+			continue
+		}
+
+		preview := UnitPreview{
+			UnitID:          id,
+			DamageMin:       degats[id],
+			DamageMax:       degats[id],
+			Healing:         soins[id],
+			HPBefore:        etatAvant.Stats.HP,
+			HPAfter:         etatApres.Stats.HP,
+			MPBefore:        etatAvant.Stats.MP,
+			MPAfter:         etatApres.Stats.MP,
+			StaminaBefore:   etatAvant.Stats.Stamina,
+			StaminaAfter:    etatApres.Stats.Stamina,
+			Position:        etatApres.Position,
+			KO:              !etatAvant.Eliminee && etatApres.Eliminee,
+			Revived:         etatAvant.Eliminee && !etatApres.Eliminee,
+			StatusesApplied: diffStatuses(etatApres.Statuts, etatAvant.Statuts),
+			StatusesRemoved: diffStatuses(etatAvant.Statuts, etatApres.Statuts),
+		}
+
+		// Une cible visée qui ne subit rien a vu l'effet contré ou renvoyé
+		if degats[id] > 0 || soins[id] > 0 || !cibles[id] || !etatsIdentiques(etatAvant, etatApres) {
+			preview.HitChance = ChanceToucheCertaine
+		}
+
+		units = append(units, preview)
+	}
+
+	sort.Slice(units, func(i, j int) bool { return units[i].UnitID < units[j].UnitID })
+	return units
+}
+
+// collectEffects cumule les dégâts et les soins par unité, effets liés compris (drain)
+func collectEffects(effects []CommandEffect, degats, soins map[domain.UnitID]int) {
+	for _, effect := range effects {
+		switch effect.Type {
+		case EffectTypeDamage:
+			degats[effect.TargetID] += effect.Value
+		case EffectTypeHealing, EffectTypeDrain:
+			soins[effect.TargetID] += effect.Value
+		}
+		collectEffects(effect.Linked, degats, soins)
+	}
+}
+
+// etatsIdentiques compare les ressources, la position et les statuts de deux états d'une unité
+func etatsIdentiques(a, b *domain.EtatUnite) bool {
+	return *a.Stats == *b.Stats && a.Position.Equals(b.Position) && a.Eliminee == b.Eliminee &&
+		len(diffStatuses(a.Statuts, b.Statuts)) == 0 && len(diffStatuses(b.Statuts, a.Statuts)) == 0
+}
+
+// diffStatuses retourne les types de statuts présents dans a et absents de b
+func diffStatuses(a, b []shared.Statut) []shared.TypeStatut {
+	presents := make(map[shared.TypeStatut]bool, len(b))
+	for _, statut := range b {
+		presents[statut.Type()] = true
+	}
+	diff := make([]shared.TypeStatut, 0)
+	for _, statut := range a {
+		if !presents[statut.Type()] {
+			diff = append(diff, statut.Type())
+		}
+	}
+	return diff
+}
+
+// previewTiles retourne les cases touchées par une commande validée
+func previewTiles(combat *domain.Combat, cmd Command) []*shared.Position {
+	tiles := make([]*shared.Position, 0)
+	switch c := cmd.(type) {
+	case *MoveCommand:
+		tiles = append(tiles, c.GetPath()...)
+
+	case *AttackCommand:
+		for _, cible := range c.GetTargets() {
+			tiles = append(tiles, cible.Position())
+		}
+
+	case *SkillCommand:
+		for _, cible := range c.GetTargets() {
+			if cible != nil {
+				tiles = append(tiles, c.GetSkill().ObtenirPositionsDansZone(cible.Position(), combat.Grille())...)
+			}
+		}
+
+	case *ItemCommand:
+		if c.GetItem().IsAreaOfEffect() {
+			tiles = append(tiles, combat.Grille().PositionsADansPortee(c.GetTargetPosition(), c.GetItem().GetAreaRadius())...)
+		} else if c.GetTargetPosition() != nil {
+			tiles = append(tiles, c.GetTargetPosition())
+		}
+
+	case *CompositeCommand:
+		// Les étapes suivantes partent de la position projetée: seul le premier chemin est connu ici
+		for _, step := range c.GetSteps() {
+			tiles = append(tiles, previewTiles(combat, step)...)
+		}
+
+	case TargetingCommand:
+		// Commande personnalisée: cases des unités visées
+		for _, cible := range c.GetTargets() {
+			if cible != nil {
+				tiles = append(tiles, cible.Position())
+			}
+		}
+	}
+	return uniqueTiles(tiles)
+}
+
+// uniqueTiles retire les cases en double en gardant l'ordre
+func uniqueTiles(tiles []*shared.Position) []*shared.Position {
+	vues := make(map[string]bool, len(tiles))
+	uniques := make([]*shared.Position, 0, len(tiles))
+	for _, tile := range tiles {
+		cle := fmt.Sprintf("%d,%d", tile.X(), tile.Y())
+		if !vues[cle] {
+			vues[cle] = true
+			uniques = append(uniques, tile)
+		}
+	}
+	return uniques
+}
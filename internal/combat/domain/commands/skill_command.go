@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
 
 // SkillCommand représente l'utilisation d'une compétence
@@ -11,6 +12,12 @@ type SkillCommand struct {
 	*BaseCommand
 	skill   *domain.Competence
 	targets []*domain.Unite
+
+	// Cibles finales après Contre-sort et Reflet
+	resolved []*domain.Unite
+
+	// Résolution d'une incantation: l'action a été enregistrée au tour où elle a démarré
+	chargeResolution bool
 }
 
 // NewSkillCommand crée une nouvelle commande de skill
@@ -44,12 +51,21 @@ func (c *SkillCommand) Validate() error {
 		return fmt.Errorf("MP insuffisant (coût: %d, disponible: %d)", c.skill.CoutMP(), c.actor.StatsActuelles().MP)
 	}
 
-	// 5. Vérifier le cooldown
+	// 5. Vérifier l'épuisement et la Stamina (compétences physiques)
+	if c.skill.EstPhysique() && c.actor.EstEpuise() {
+		return fmt.Errorf("l'unité %s est épuisée, impossible d'utiliser %s", c.actor.Nom(), c.skill.Nom())
+	}
+
+	if err := c.checkStamina(c.GetStaminaCost()); err != nil {
+		return err
+	}
+
+	// 6. Vérifier le cooldown
 	if !c.actor.SkillEstPret(c.skill.ID()) {
 		return fmt.Errorf("compétence en cooldown")
 	}
 
-	// 6. Vérifier les cibles
+	// 7. Vérifier les cibles
 	if len(c.targets) == 0 {
 		return fmt.Errorf("aucune cible spécifiée")
 	}
@@ -67,7 +83,7 @@ func (c *SkillCommand) Validate() error {
 		}
 	}
 
-	// 7. Vérifier le statut Silence (interdit les skills)
+	// 8. Vérifier le statut Silence (interdit les skills)
 	if c.actor.EstSilence() {
 		return fmt.Errorf("l'unité est Silencée, impossible d'utiliser des compétences")
 	}
@@ -75,16 +91,31 @@ func (c *SkillCommand) Validate() error {
 	return nil
 }
 
-// Execute utilise la compétence
-func (c *SkillCommand) Execute() (*CommandResult, error) {
-	// Créer un snapshot avant modification
-	c.CreateSnapshot()
+// MarkChargeResolution indique que la commande résout une incantation arrivée à terme
+// L'activité du tour n'est pas enregistrée: elle appartient au tour où l'incantation a démarré
+func (c *SkillCommand) MarkChargeResolution() {
+	c.chargeResolution = true
+}
 
-	// Consommer les MP
-	c.actor.ConsommerMP(c.skill.CoutMP())
+// GetSkill retourne la compétence utilisée
+func (c *SkillCommand) GetSkill() *domain.Competence {
+	return c.skill
+}
 
-	// Activer le cooldown
-	c.actor.ActiverCooldown(c.skill.ID(), c.skill.Cooldown())
+// GetTargets retourne les cibles de la compétence
+func (c *SkillCommand) GetTargets() []*domain.Unite {
+	return c.targets
+}
+
+// GetStaminaCost retourne le coût en Stamina de la compétence
+func (c *SkillCommand) GetStaminaCost() int {
+	return c.combat.ReglesStamina().CoutCompetence(c.skill)
+}
+
+// Execute utilise la compétence
+func (c *SkillCommand) Execute() (*CommandResult, error) {
+	// Créer un snapshot avant modification (Contre-sort consommé pendant la résolution compris)
+	c.CreateSnapshot(c.targets...)
 
 	// Créer le résultat
 	result := &CommandResult{
@@ -94,27 +125,57 @@ func (c *SkillCommand) Execute() (*CommandResult, error) {
 		Effects: make([]CommandEffect, 0),
 	}
 
+	// Consommer la Stamina puis les MP
+	if err := c.ConsumeStamina(c.GetStaminaCost(), result); err != nil {
+		return nil, err
+	}
+	c.actor.ConsommerMP(c.skill.CoutMP())
+
+	// Activer le cooldown
+	c.actor.ActiverCooldown(c.skill.ID(), c.skill.Cooldown())
+
+	cibles := make([]domain.UnitID, 0, len(c.targets))
+	for _, target := range c.targets {
+		cibles = append(cibles, target.ID())
+	}
+	c.combat.RaiseEvent(domain.NewCompetenceUtiliseeEvent(c.combat.ID(), c.combat.TourActuel(), c.actor.ID(), c.skill.ID(), cibles))
+
 	// Appliquer les effets selon le type de skill
 	calculator := c.combat.GetDamageCalculator()
 
-	for _, target := range c.targets {
+	// Résoudre les cibles finales avant les dégâts (Contre-sort, Reflet)
+	c.resolved = c.resolveTargets(result)
+
+	for _, target := range c.resolved {
+		c.snapshotTarget(target)
+
 		// Utiliser le type de compétence pour déterminer l'effet
 		switch c.skill.Type() {
 		case domain.CompetenceAttaque, domain.CompetenceMagie:
-			// Compétence de dégâts
-			degats := calculator.Calculate(c.actor, target, c.skill)
-			target.RecevoirDegats(degats)
-			result.DamageDealt += degats
+			// Compétence de dégâts (HP manquants du lanceur si l'effet est présent)
+			degats, horsCalcul := c.skill.DegatsHPManquants(c.actor)
+			if !horsCalcul {
+				degats = calculator.Calculate(c.actor, target, c.skill)
+			}
+			// Dégâts réellement infligés (plafonnés aux HP restants de la cible)
+			reels := c.DealDamage(target, degats, result)
+			result.DamageDealt += reels
 			result.Effects = append(result.Effects, CommandEffect{
 				Type:     EffectTypeDamage,
 				TargetID: target.ID(),
-				Value:    degats,
+				Value:    reels,
+				Linked:   c.applyDrains(reels, result),
 			})
+			c.SignalKO(target, result)
 
 		case domain.CompetenceSoin:
 			// Compétence de soin - utiliser les dégâts de base comme valeur de soin
-			soins := c.skill.DegatsBase()
-			target.Soigner(soins)
+			// (valeur réelle, plafonnée aux HP max: le surplus ne génère pas de menace)
+			avant := target.HPActuels()
+			target.Soigner(c.skill.DegatsBase())
+			soins := target.HPActuels() - avant
+			c.combat.EnregistrerSoin(c.actor, soins)
+			c.combat.RaiseEvent(domain.NewSoinApliqueEvent(c.combat.ID(), c.combat.TourActuel(), c.actor.ID(), target.ID(), soins))
 			result.HealingDone += soins
 			result.Effects = append(result.Effects, CommandEffect{
 				Type:     EffectTypeHealing,
@@ -135,22 +196,139 @@ func (c *SkillCommand) Execute() (*CommandResult, error) {
 			// Autres types de compétences (Buff, Support, etc.)
 			fmt.Printf("[Skill] Type de compétence %v non géré\n", c.skill.Type())
 		}
+
+		c.applyStatusEffects(target, result)
 	}
 
+	if !c.chargeResolution {
+		c.RecordTurnActivity()
+	}
 	return result, nil
 }
 
-// Rollback annule l'utilisation du skill
-func (c *SkillCommand) Rollback() error {
-	if c.snapshot == nil {
-		return fmt.Errorf("aucun snapshot disponible pour rollback")
+// resolveTargets résout les cibles finales d'un sort hostile avant les dégâts
+// Contre-sort: le sort est annulé pour la cible (statut consommé)
+// Reflet: un sort à cible unique est renvoyé (voir Combat.CibleRenvoi)
+// Un sort renvoyé n'est jamais renvoyé une seconde fois, ce qui évite les boucles entre réflecteurs
+func (c *SkillCommand) resolveTargets(result *CommandResult) []*domain.Unite {
+	if c.skill.Type() != domain.CompetenceMagie {
+		return c.targets
 	}
 
-	// Restaurer les MP de l'acteur
-	// Note: nécessite une méthode SetMP sur Unite
-	fmt.Printf("[Rollback] Impossible de restaurer les MP de %s (méthode SetMP non implémentée)\n", c.actor.Nom())
+	tour := c.combat.TourActuel()
+	cibles := make([]*domain.Unite, 0, len(c.targets))
+	for _, target := range c.targets {
+		if !c.combat.SontEnnemis(c.actor, target) {
+			cibles = append(cibles, target)
+			continue
+		}
 
-	return nil
+		if target.AContreSort() {
+			statut := target.ObtenirStatut(shared.TypeStatutContreSort)
+			target.RetirerStatut(shared.TypeStatutContreSort)
+			result.Effects = append(result.Effects, CommandEffect{
+				Type:     EffectTypeSpellCountered,
+				TargetID: target.ID(),
+				Status:   statut,
+			})
+			c.combat.RaiseEvent(domain.NewSortAnnuleEvent(c.combat.ID(), tour, c.actor.ID(), c.skill.ID(), target.ID()))
+			continue
+		}
+
+		if len(c.targets) == 1 && target.EstReflet() {
+			if nouvelleCible := c.combat.CibleRenvoi(c.actor, target); nouvelleCible != nil {
+				result.Effects = append(result.Effects, CommandEffect{
+					Type:     EffectTypeReflect,
+					TargetID: nouvelleCible.ID(),
+					Position: nouvelleCible.Position(),
+				})
+				c.combat.RaiseEvent(domain.NewSortRenvoyeEvent(c.combat.ID(), tour, c.actor.ID(), c.skill.ID(), target.ID(), nouvelleCible.ID()))
+				cibles = append(cibles, nouvelleCible)
+				continue
+			}
+		}
+
+		cibles = append(cibles, target)
+	}
+	return cibles
+}
+
+// applyDrains convertit une part des dégâts réellement infligés en HP et MP du lanceur
+// Résolu avec les dégâts, retourne les effets à rattacher à l'effet de dégâts
+func (c *SkillCommand) applyDrains(degats int, result *CommandResult) []CommandEffect {
+	linked := make([]CommandEffect, 0)
+	tour := c.combat.TourActuel()
+
+	if pourcentage := c.skill.PourcentageDrainVie(); pourcentage > 0 && degats > 0 {
+		avant := c.actor.HPActuels()
+		c.actor.Soigner(degats * pourcentage / 100)
+		gain := c.actor.HPActuels() - avant
+		if gain > 0 {
+			result.HealingDone += gain
+			linked = append(linked, CommandEffect{
+				Type:     EffectTypeDrain,
+				TargetID: c.actor.ID(),
+				Value:    gain,
+			})
+			c.combat.RaiseEvent(domain.NewSoinApliqueEvent(c.combat.ID(), tour, c.actor.ID(), c.actor.ID(), gain))
+		}
+	}
+
+	if pourcentage := c.skill.PourcentageDrainMana(); pourcentage > 0 && degats > 0 {
+		avant := c.actor.StatsActuelles().MP
+		c.actor.RestaurerMP(degats * pourcentage / 100)
+		gain := c.actor.StatsActuelles().MP - avant
+		if gain > 0 {
+			linked = append(linked, CommandEffect{
+				Type:     EffectTypeManaDrain,
+				TargetID: c.actor.ID(),
+				Value:    gain,
+			})
+			c.combat.RaiseEvent(domain.NewMPRestaureEvent(c.combat.ID(), tour, c.actor.ID(), c.actor.ID(), gain))
+		}
+	}
+
+	return linked
+}
+
+// applyStatusEffects applique les statuts de la compétence à une cible encore debout
+// Provocation et Charme mémorisent leur source dans le combat
+func (c *SkillCommand) applyStatusEffects(target *domain.Unite, result *CommandResult) {
+	if target.EstEliminee() {
+		return
+	}
+	for _, effet := range c.skill.Effets() {
+		statut := effet.CreerStatut()
+		if statut == nil {
+			continue
+		}
+
+		var err error
+		switch statut.Type() {
+		case shared.TypeStatutProvocation:
+			statut, err = c.combat.AppliquerProvocation(c.actor, target, statut.Duree())
+		case shared.TypeStatutCharme:
+			statut, err = c.combat.AppliquerCharme(c.actor, target, statut.Duree())
+		default:
+			statut, err = c.combat.AppliquerStatut(c.actor, target, statut)
+		}
+		if err != nil {
+			continue
+		}
+
+		result.StatusApplied = append(result.StatusApplied, statut)
+		result.Effects = append(result.Effects, CommandEffect{
+			Type:     EffectTypeStatus,
+			TargetID: target.ID(),
+			Status:   statut,
+		})
+	}
+}
+
+// Rollback annule l'utilisation du skill
+// Acteur: coûts, cooldown, drains; cibles (renvoi compris): dégâts, soins, statuts, Contre-sort, KO
+func (c *SkillCommand) Rollback() error {
+	return c.RestoreSnapshot()
 }
 
 // abs est défini dans attack_command.go
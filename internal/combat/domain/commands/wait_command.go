@@ -7,7 +7,8 @@ import (
 )
 
 // WaitCommand représente l'action d'attendre (passer son tour)
-// Réinitialise la jauge ATB à 0 et passe le tour
+// Sans déplacement ni action, l'unité conserve une plus grande part de sa jauge ATB
+// (ReglesReinitialisationATB) et rejoue plus tôt
 type WaitCommand struct {
 	*BaseCommand
 }
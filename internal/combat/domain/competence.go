@@ -19,6 +19,7 @@ type Competence struct {
 	coutStamina    int
 	cooldown       int
 	cooldownActuel int
+	incantation    int // Temps d'incantation en ticks ATB (0: résolution immédiate)
 	degatsBase     int
 	modificateur   float64 // Scaling (ATK, MATK, etc.)
 	effets         []EffetCompetence
@@ -73,6 +74,33 @@ type EffetCompetence struct {
 	statut    *shared.TypeStatut
 }
 
+// NewEffetCompetence crée un effet de compétence (statut nil si l'effet n'applique pas de statut)
+func NewEffetCompetence(typeEffet TypeEffetCompetence, valeur, duree int, statut *shared.TypeStatut) EffetCompetence {
+	return EffetCompetence{
+		typeEffet: typeEffet,
+		valeur:    valeur,
+		duree:     duree,
+		statut:    statut,
+	}
+}
+
+// CreerStatut crée le statut appliqué par l'effet (nil si l'effet n'applique pas de statut)
+// Durée et puissance par défaut si non renseignées
+func (e *EffetCompetence) CreerStatut() *shared.Statut {
+	if e.typeEffet != EffetStatut || e.statut == nil {
+		return nil
+	}
+	duree := e.duree
+	if duree <= 0 {
+		duree = DureeStatutDefaut
+	}
+	puissance := e.valeur
+	if *e.statut == shared.TypeStatutBerserk && puissance <= 0 {
+		puissance = BonusATKBerserkDefaut
+	}
+	return shared.NewStatut(*e.statut, duree, puissance)
+}
+
 // Getters pour EffetCompetence
 func (e *EffetCompetence) TypeEffet() TypeEffetCompetence { return e.typeEffet }
 func (e *EffetCompetence) Valeur() int                    { return e.valeur }
@@ -89,6 +117,9 @@ const (
 	EffetDeplacement
 	EffetInvocation
 	EffetModificateurStat
+	EffetDrainVie          // Convertit valeur% des dégâts infligés en HP du lanceur
+	EffetDrainMana         // Convertit valeur% des dégâts infligés en MP du lanceur
+	EffetDegatsHPManquants // Inflige valeur% des HP manquants du lanceur (100% si non renseigné)
 )
 
 // NewCompetence crée une nouvelle compétence
@@ -132,16 +163,69 @@ func (c *Competence) CoutMP() int               { return c.coutMP }
 func (c *Competence) CoutStamina() int          { return c.coutStamina }
 func (c *Competence) Cooldown() int             { return c.cooldown }
 func (c *Competence) CooldownActuel() int       { return c.cooldownActuel }
+func (c *Competence) TempsIncantation() int     { return c.incantation }
 func (c *Competence) DegatsBase() int           { return c.degatsBase }
 func (c *Competence) Modificateur() float64     { return c.modificateur }
 func (c *Competence) Effets() []EffetCompetence { return c.effets }
 func (c *Competence) Cibles() TypeCible         { return c.cibles }
 
+// SetTempsIncantation définit le temps d'incantation en ticks ATB
+// En mode ATB, la compétence est chargée pendant ce temps puis résolue (hors ATB elle est immédiate)
+func (c *Competence) SetTempsIncantation(ticks int) {
+	if ticks < 0 {
+		ticks = 0
+	}
+	c.incantation = ticks
+}
+
 // AjouterEffet ajoute un effet à la compétence
 func (c *Competence) AjouterEffet(effet EffetCompetence) {
 	c.effets = append(c.effets, effet)
 }
 
+// EstPhysique vérifie si la compétence est physique (consomme de la Stamina)
+func (c *Competence) EstPhysique() bool {
+	return c.typeCompetence == CompetenceAttaque
+}
+
+// PourcentageDrainVie retourne la part (en %) des dégâts convertie en HP du lanceur
+func (c *Competence) PourcentageDrainVie() int {
+	return c.sommeEffets(EffetDrainVie)
+}
+
+// PourcentageDrainMana retourne la part (en %) des dégâts convertie en MP du lanceur
+func (c *Competence) PourcentageDrainMana() int {
+	return c.sommeEffets(EffetDrainMana)
+}
+
+// DegatsHPManquants calcule les dégâts basés sur les HP manquants du lanceur
+// Retourne false si la compétence n'a pas d'effet EffetDegatsHPManquants
+func (c *Competence) DegatsHPManquants(lanceur *Unite) (int, bool) {
+	for _, effet := range c.effets {
+		if effet.typeEffet != EffetDegatsHPManquants {
+			continue
+		}
+		pourcentage := effet.valeur
+		if pourcentage <= 0 {
+			pourcentage = 100
+		}
+		manquants := lanceur.Stats().HP - lanceur.HPActuels()
+		return manquants * pourcentage / 100, true
+	}
+	return 0, false
+}
+
+// sommeEffets additionne les valeurs des effets d'un type
+func (c *Competence) sommeEffets(typeEffet TypeEffetCompetence) int {
+	total := 0
+	for _, effet := range c.effets {
+		if effet.typeEffet == typeEffet {
+			total += effet.valeur
+		}
+	}
+	return total
+}
+
 // EstEnCooldown vérifie si la compétence est en cooldown
 func (c *Competence) EstEnCooldown() bool {
 	return c.cooldownActuel > 0
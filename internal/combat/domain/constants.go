@@ -49,6 +49,15 @@ const (
 
 	// CoutStaminaNul représente une action sans coût en Stamina
 	CoutStaminaNul = 0
+
+	// CoutStaminaParCase est le coût en Stamina par case parcourue
+	CoutStaminaParCase = 1
+
+	// CoutStaminaAttaque est le coût en Stamina d'une attaque basique
+	CoutStaminaAttaque = 5
+
+	// CoutStaminaCompetencePhysique est le coût minimum d'une compétence physique (CompetenceAttaque)
+	CoutStaminaCompetencePhysique = 5
 )
 
 // =============================================================================
@@ -60,8 +69,107 @@ const (
 	// RegenMPDiviseur est le diviseur pour calculer la régénération de MP (10% = MP/10)
 	RegenMPDiviseur = 10
 
-	// RegenStaminaDiviseur est le diviseur pour calculer la régénération de Stamina (20% = Stamina/5)
-	RegenStaminaDiviseur = 5
+	// TauxRegenStaminaDefaut est le pourcentage de Stamina max régénéré par tour (20%)
+	TauxRegenStaminaDefaut = 20
+)
+
+// Épuisement (Stamina tombée à 0)
+const (
+	// DureeEpuisement est la durée du statut Épuisement en tours
+	DureeEpuisement = 2
+
+	// DiviseurDeplacementEpuise divise la portée de déplacement d'une unité épuisée
+	DiviseurDeplacementEpuise = 2
+)
+
+// =============================================================================
+// CONSTANTES DE KO ET DE MORT
+// =============================================================================
+
+// KO et mort définitive
+const (
+	// CompteAReboursKO est le nombre de tours (de l'unité KO) avant la mort définitive
+	CompteAReboursKO = 3
+
+	// ObjetCoffreDefaut est l'objet contenu dans un coffre laissé par une unité morte
+	ObjetCoffreDefaut = ObjetPotion
+)
+
+// =============================================================================
+// CONSTANTES DE RÉCOMPENSES
+// =============================================================================
+
+// Récompenses de fin de combat
+const (
+	// ExperienceParEnnemiVaincu est l'expérience gagnée par chaque survivant victorieux, par ennemi vaincu
+	ExperienceParEnnemiVaincu = 50
+)
+
+// =============================================================================
+// CONSTANTES DE MENACE (AGGRO)
+// =============================================================================
+
+// Génération de menace dans les tables des unités IA
+const (
+	// MenaceParDegat est la menace générée par point de dégât infligé
+	MenaceParDegat = 1
+
+	// MenaceSoinDiviseur divise les soins prodigués pour obtenir la menace générée
+	// (la menace est ressentie par toutes les unités IA ennemies du soigneur)
+	MenaceSoinDiviseur = 2
+
+	// MenaceProvocation est la menace ajoutée par une provocation
+	// Garde le provocateur en tête de table après l'expiration du statut
+	MenaceProvocation = 100
+
+	// DureeProvocationDefaut est la durée (en tours) d'une provocation sans durée explicite
+	DureeProvocationDefaut = 2
+)
+
+// =============================================================================
+// CONSTANTES DE STATUTS DE COMPORTEMENT
+// =============================================================================
+
+// Statuts imposant l'action de l'unité (Sommeil, Confusion, Charme, Berserk)
+const (
+	// DureeStatutDefaut est la durée (en tours) d'un statut de compétence sans durée explicite
+	DureeStatutDefaut = 2
+
+	// BonusATKBerserkDefaut est le bonus d'ATK d'un Berserk sans puissance explicite
+	BonusATKBerserkDefaut = 10
+)
+
+// Modes de renvoi du statut Reflet (puissance du statut)
+const (
+	// RenvoiVersLanceur renvoie le sort vers son lanceur
+	RenvoiVersLanceur = 0
+
+	// RenvoiAleatoire renvoie le sort vers un ennemi du réflecteur tiré au hasard
+	RenvoiAleatoire = 1
+)
+
+// Modificateurs de vitesse ATB (pourcentage de la vitesse de remplissage)
+const (
+	// PourcentageVitesseHate est la vitesse de remplissage sous Hâte (150%)
+	PourcentageVitesseHate = 150
+
+	// PourcentageVitesseLenteur est la vitesse de remplissage sous Lenteur (50%)
+	PourcentageVitesseLenteur = 50
+)
+
+// Part de jauge ATB conservée en fin de tour selon l'activité de l'unité (règles FFT)
+const (
+	// ConservationJaugeAttente est la part conservée sans déplacement ni action (40%)
+	ConservationJaugeAttente = 40
+
+	// ConservationJaugeDeplacement est la part conservée après un déplacement seul (20%)
+	ConservationJaugeDeplacement = 20
+
+	// ConservationJaugeAction est la part conservée après une action sans déplacement (20%)
+	ConservationJaugeAction = 20
+
+	// ConservationJaugeComplete est la part conservée après un déplacement et une action (0%)
+	ConservationJaugeComplete = 0
 )
 
 // =============================================================================
@@ -86,3 +194,40 @@ const (
 	// SeuilRessourceMinimum est le seuil minimum pour les ressources (HP, MP, Stamina)
 	SeuilRessourceMinimum = 0
 )
+
+// =============================================================================
+// CONSTANTES D'OBJETS
+// =============================================================================
+
+// Identifiants du catalogue d'objets par défaut
+const (
+	ObjetPotion      = "potion"
+	ObjetEther       = "ether"
+	ObjetAntidote    = "antidote"
+	ObjetQueuePhenix = "queue-de-phenix"
+	ObjetBombe       = "bombe"
+)
+
+// Valeurs des objets du catalogue par défaut
+const (
+	// PorteeObjetDefaut est la portée de lancer d'un objet de soutien (soi ou adjacent)
+	PorteeObjetDefaut = 1
+
+	// PorteeBombe est la portée de lancer d'une bombe
+	PorteeBombe = 4
+
+	// RayonBombe est le rayon de l'explosion autour du point d'impact
+	RayonBombe = 1
+
+	// PotionSoin sont les HP restaurés par une Potion
+	PotionSoin = 50
+
+	// EtherMP sont les MP restaurés par un Éther
+	EtherMP = 30
+
+	// QueuePhenixHP sont les HP rendus par une Queue de Phénix
+	QueuePhenixHP = 25
+
+	// BombeDegats sont les dégâts infligés par une Bombe
+	BombeDegats = 40
+)
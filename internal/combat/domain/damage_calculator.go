@@ -27,17 +27,19 @@ func NewPhysicalDamageCalculator() DamageCalculator {
 
 func (c *PhysicalDamageCalculator) Calculate(attacker *Unite, defender *Unite, competence *Competence) int {
 	// Stats de base
-	attackerStats := attacker.Stats()
 	defenderStats := defender.Stats()
 
+	// ATK augmentée des statuts actifs (Berserk)
+	attaque := attacker.ATKEffective()
+
 	// Calcul de base: ATK - DEF
-	baseDamage := attackerStats.ATK - defenderStats.DEF
+	baseDamage := attaque - defenderStats.DEF
 
 	// Bonus de la compétence
 	skillBonus := competence.DegatsBase()
 
 	// Modificateur de la compétence (scaling)
-	scaling := competence.Modificateur() * float64(attackerStats.ATK)
+	scaling := competence.Modificateur() * float64(attaque)
 
 	// Dégâts totaux
 	totalDamage := float64(baseDamage) + float64(skillBonus) + scaling
@@ -164,7 +166,7 @@ func (c *HybridDamageCalculator) Calculate(attacker *Unite, defender *Unite, com
 	defenderStats := defender.Stats()
 
 	// Partie physique
-	physicalDamage := float64(attackerStats.ATK-defenderStats.DEF) * c.physicalRatio
+	physicalDamage := float64(attacker.ATKEffective()-defenderStats.DEF) * c.physicalRatio
 
 	// Partie magique
 	magicalDamage := float64(attackerStats.MATK-defenderStats.MDEF) * c.magicalRatio
@@ -173,7 +175,7 @@ func (c *HybridDamageCalculator) Calculate(attacker *Unite, defender *Unite, com
 	skillBonus := competence.DegatsBase()
 
 	// Scaling hybride
-	scaling := competence.Modificateur() * (float64(attackerStats.ATK)*c.physicalRatio + float64(attackerStats.MATK)*c.magicalRatio)
+	scaling := competence.Modificateur() * (float64(attacker.ATKEffective())*c.physicalRatio + float64(attackerStats.MATK)*c.magicalRatio)
 
 	totalDamage := physicalDamage + magicalDamage + float64(skillBonus) + scaling
 
@@ -262,6 +264,16 @@ func NewCriticalDamageCalculator(base DamageCalculator, critChance, critMultipli
 	}
 }
 
+// CritChance retourne la chance de critique (0.0 à 1.0)
+func (c *CriticalDamageCalculator) CritChance() float64 {
+	return c.critChance
+}
+
+// CritMultiplier retourne le multiplicateur appliqué aux dégâts d'un critique
+func (c *CriticalDamageCalculator) CritMultiplier() float64 {
+	return c.critMultiplier
+}
+
 func (c *CriticalDamageCalculator) Calculate(attacker *Unite, defender *Unite, competence *Competence) int {
 	// Calculer les dégâts de base avec la stratégie wrappée
 	baseDamage := c.baseCalculator.Calculate(attacker, defender, competence)
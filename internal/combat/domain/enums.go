@@ -73,3 +73,22 @@ func (p PhaseTour) String() string {
 
 // TypeCellule représente le type de terrain d'une cellule (défini dans value_objects.go mais réexporté ici)
 // Voir value_objects.go pour la définition complète
+
+// ModeTour représente le système d'ordonnancement des tours choisi à la création du combat
+type ModeTour string
+
+const (
+	ModeTourATB          ModeTour = "ATB"           // Jauges de temps actif remplies selon la SPD
+	ModeTourCTB          ModeTour = "CTB"           // Tourniquet strict: chaque unité joue une fois par round, par SPD
+	ModeTourPhaseEquipes ModeTour = "PHASE_EQUIPES" // Phases alternées: toute une équipe joue, puis la suivante
+)
+
+// EstValide vérifie que le mode de tour est connu
+func (m ModeTour) EstValide() bool {
+	switch m {
+	case ModeTourATB, ModeTourCTB, ModeTourPhaseEquipes:
+		return true
+	default:
+		return false
+	}
+}
@@ -62,6 +62,9 @@ func (e *Equipe) AjouterMembre(unite *Unite) error {
 		return errors.New("l'unité n'appartient pas à cette équipe")
 	}
 
+	// Une unité d'une équipe IA est contrôlée par l'IA
+	unite.SetIA(e.isIA)
+
 	e.membres = append(e.membres, unite)
 	return nil
 }
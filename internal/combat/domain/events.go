@@ -15,6 +15,8 @@ type Evenement interface {
 	SetAggregateID(id string)
 	SetAggregateVersion(version int)
 	SetTimestamp(t time.Time)
+	CorrelationID() string
+	SetCorrelationID(id string)
 }
 
 // BaseEvent implémente les méthodes communes à tous les événements
@@ -23,21 +25,36 @@ type BaseEvent struct {
 	aggregateID      string
 	aggregateVersion int
 	timestamp        time.Time
+	correlationID    string // Action à l'origine de l'événement (voir Combat.DebuterAction)
 }
 
-func (e *BaseEvent) EventType() string         { return e.eventType }
-func (e *BaseEvent) AggregateID() string       { return e.aggregateID }
-func (e *BaseEvent) AggregateVersion() int     { return e.aggregateVersion }
-func (e *BaseEvent) Timestamp() time.Time      { return e.timestamp }
-func (e *BaseEvent) SetAggregateID(id string)  { e.aggregateID = id }
-func (e *BaseEvent) SetAggregateVersion(v int) { e.aggregateVersion = v }
-func (e *BaseEvent) SetTimestamp(t time.Time)  { e.timestamp = t }
+func (e *BaseEvent) EventType() string          { return e.eventType }
+func (e *BaseEvent) AggregateID() string        { return e.aggregateID }
+func (e *BaseEvent) AggregateVersion() int      { return e.aggregateVersion }
+func (e *BaseEvent) Timestamp() time.Time       { return e.timestamp }
+func (e *BaseEvent) SetAggregateID(id string)   { e.aggregateID = id }
+func (e *BaseEvent) SetAggregateVersion(v int)  { e.aggregateVersion = v }
+func (e *BaseEvent) SetTimestamp(t time.Time)   { e.timestamp = t }
+func (e *BaseEvent) CorrelationID() string      { return e.correlationID }
+func (e *BaseEvent) SetCorrelationID(id string) { e.correlationID = id }
 
 // CombatDemarreEvent - Le combat a démarré
 type CombatDemarreEvent struct {
 	BaseEvent
 	Tour            int
 	OrdreInitiative []UnitID
+	ModeTour        ModeTour // Vide pour les combats antérieurs aux modes de tour (ATB)
+	Entrainement    bool     // Combat d'entraînement (annuler/refaire autorisés)
+
+	// Délai de tour (0 = pas de limite)
+	DureeTour        time.Duration
+	ActionExpiration ActionExpiration
+
+	// Part de jauge ATB conservée en fin de tour (nil = règles par défaut)
+	ReglesReinitialisationATB *ReglesReinitialisationATB
+
+	// Équipes, unités, grille et inventaires au démarrage (nil pour les combats antérieurs)
+	Configuration *ConfigurationCombat
 }
 
 func NewCombatDemarreEvent(combatID string, tour int, ordre []UnitID) *CombatDemarreEvent {
@@ -51,7 +68,8 @@ func NewCombatDemarreEvent(combatID string, tour int, ordre []UnitID) *CombatDem
 // TourDemarreEvent - Un nouveau tour démarre
 type TourDemarreEvent struct {
 	BaseEvent
-	Tour int
+	Tour    int
+	UniteID UnitID // Unité dont le tour commence
 }
 
 func NewTourDemarreEvent(combatID string, tour int) *TourDemarreEvent {
@@ -64,9 +82,10 @@ func NewTourDemarreEvent(combatID string, tour int) *TourDemarreEvent {
 // ActionExecuteeEvent - Une action a été exécutée
 type ActionExecuteeEvent struct {
 	BaseEvent
-	Tour     int
-	Action   *ActionCombat
-	Resultat *ResultatAction
+	Tour         int
+	TypeCommande string // Type de la commande jouée (types personnalisés compris)
+	Action       *ActionCombat
+	Resultat     *ResultatAction
 }
 
 func NewActionExecuteeEvent(combatID string, tour int, action *ActionCombat, resultat *ResultatAction) *ActionExecuteeEvent {
@@ -135,21 +154,383 @@ func NewStatutAppliqueEvent(combatID string, tour int, acteurID, cibleID UnitID,
 	}
 }
 
-// UniteElimineeEvent - Une unité a été éliminée
-type UniteElimineeEvent struct {
+// UniteKOEvent - Une unité est tombée KO (ressuscitable pendant CompteARebours tours)
+type UniteKOEvent struct {
+	BaseEvent
+	Tour           int
+	UniteID        UnitID
+	CompteARebours int
+}
+
+func NewUniteKOEvent(combatID string, tour int, uniteID UnitID, compteARebours int) *UniteKOEvent {
+	return &UniteKOEvent{
+		BaseEvent:      BaseEvent{eventType: "UniteKO"},
+		Tour:           tour,
+		UniteID:        uniteID,
+		CompteARebours: compteARebours,
+	}
+}
+
+// CompteAReboursKOEvent - Le compte à rebours d'une unité KO a avancé
+type CompteAReboursKOEvent struct {
+	BaseEvent
+	Tour     int
+	UniteID  UnitID
+	Restants int
+}
+
+func NewCompteAReboursKOEvent(combatID string, tour int, uniteID UnitID, restants int) *CompteAReboursKOEvent {
+	return &CompteAReboursKOEvent{
+		BaseEvent: BaseEvent{eventType: "CompteAReboursKO"},
+		Tour:      tour,
+		UniteID:   uniteID,
+		Restants:  restants,
+	}
+}
+
+// UniteMorteEvent - Une unité KO est définitivement morte
+type UniteMorteEvent struct {
+	BaseEvent
+	Tour     int
+	UniteID  UnitID
+	Position *shared.Position
+}
+
+func NewUniteMorteEvent(combatID string, tour int, uniteID UnitID, position *shared.Position) *UniteMorteEvent {
+	return &UniteMorteEvent{
+		BaseEvent: BaseEvent{eventType: "UniteMorte"},
+		Tour:      tour,
+		UniteID:   uniteID,
+		Position:  position,
+	}
+}
+
+// ButinDeposeEvent - Une unité morte a laissé un cristal ou un coffre
+type ButinDeposeEvent struct {
+	BaseEvent
+	Tour      int
+	ButinID   string
+	TypeButin TypeButin
+	Position  *shared.Position
+	SourceID  UnitID
+	ObjetID   shared.ObjetID
+}
+
+func NewButinDeposeEvent(combatID string, tour int, butin *Butin) *ButinDeposeEvent {
+	return &ButinDeposeEvent{
+		BaseEvent: BaseEvent{eventType: "ButinDepose"},
+		Tour:      tour,
+		ButinID:   butin.ID(),
+		TypeButin: butin.Type(),
+		Position:  butin.Position(),
+		SourceID:  butin.SourceID(),
+		ObjetID:   butin.ObjetID(),
+	}
+}
+
+// ButinRamasseEvent - Une unité a ramassé un cristal ou un coffre
+type ButinRamasseEvent struct {
+	BaseEvent
+	Tour      int
+	ButinID   string
+	TypeButin TypeButin
+	UniteID   UnitID
+	TeamID    TeamID
+	ObjetID   shared.ObjetID
+}
+
+func NewButinRamasseEvent(combatID string, tour int, butin *Butin, unite *Unite) *ButinRamasseEvent {
+	return &ButinRamasseEvent{
+		BaseEvent: BaseEvent{eventType: "ButinRamasse"},
+		Tour:      tour,
+		ButinID:   butin.ID(),
+		TypeButin: butin.Type(),
+		UniteID:   unite.ID(),
+		TeamID:    unite.TeamID(),
+		ObjetID:   butin.ObjetID(),
+	}
+}
+
+// ActionImposeeEvent - Un statut (Sommeil, Charme, Confusion, Berserk) a imposé l'action d'une unité
+type ActionImposeeEvent struct {
+	BaseEvent
+	Tour         int
+	UniteID      UnitID
+	Statut       shared.TypeStatut
+	TypeCommande string
+	CibleID      UnitID
+	Raison       string
+}
+
+func NewActionImposeeEvent(combatID string, tour int, uniteID UnitID, statut shared.TypeStatut, typeCommande string, cibleID UnitID, raison string) *ActionImposeeEvent {
+	return &ActionImposeeEvent{
+		BaseEvent:    BaseEvent{eventType: "ActionImposee"},
+		Tour:         tour,
+		UniteID:      uniteID,
+		Statut:       statut,
+		TypeCommande: typeCommande,
+		CibleID:      cibleID,
+		Raison:       raison,
+	}
+}
+
+// SortRenvoyeEvent - Un sort a été renvoyé par le statut Reflet vers une nouvelle cible
+type SortRenvoyeEvent struct {
+	BaseEvent
+	Tour            int
+	LanceurID       UnitID
+	CompetenceID    CompetenceID
+	ReflecteurID    UnitID
+	NouvelleCibleID UnitID
+}
+
+func NewSortRenvoyeEvent(combatID string, tour int, lanceurID UnitID, competenceID CompetenceID, reflecteurID, nouvelleCibleID UnitID) *SortRenvoyeEvent {
+	return &SortRenvoyeEvent{
+		BaseEvent:       BaseEvent{eventType: "SortRenvoye"},
+		Tour:            tour,
+		LanceurID:       lanceurID,
+		CompetenceID:    competenceID,
+		ReflecteurID:    reflecteurID,
+		NouvelleCibleID: nouvelleCibleID,
+	}
+}
+
+// SortAnnuleEvent - Un sort hostile a été annulé par le statut Contre-sort de sa cible
+type SortAnnuleEvent struct {
+	BaseEvent
+	Tour         int
+	LanceurID    UnitID
+	CompetenceID CompetenceID
+	CibleID      UnitID
+}
+
+func NewSortAnnuleEvent(combatID string, tour int, lanceurID UnitID, competenceID CompetenceID, cibleID UnitID) *SortAnnuleEvent {
+	return &SortAnnuleEvent{
+		BaseEvent:    BaseEvent{eventType: "SortAnnule"},
+		Tour:         tour,
+		LanceurID:    lanceurID,
+		CompetenceID: competenceID,
+		CibleID:      cibleID,
+	}
+}
+
+// IncantationDemarreeEvent - Une compétence à temps d'incantation est chargée au lieu d'être résolue (mode ATB)
+type IncantationDemarreeEvent struct {
+	BaseEvent
+	Tour         int
+	LanceurID    UnitID
+	CompetenceID CompetenceID
+	Cibles       []UnitID
+	Ticks        int
+}
+
+func NewIncantationDemarreeEvent(combatID string, tour int, lanceurID UnitID, competenceID CompetenceID, cibles []UnitID, ticks int) *IncantationDemarreeEvent {
+	return &IncantationDemarreeEvent{
+		BaseEvent:    BaseEvent{eventType: "IncantationDemarree"},
+		Tour:         tour,
+		LanceurID:    lanceurID,
+		CompetenceID: competenceID,
+		Cibles:       cibles,
+		Ticks:        ticks,
+	}
+}
+
+// IncantationInterrompueEvent - Une incantation arrivée à terme n'a pas pu être résolue (lanceur hors d'état, cible hors de portée...)
+type IncantationInterrompueEvent struct {
+	BaseEvent
+	Tour         int
+	LanceurID    UnitID
+	CompetenceID CompetenceID
+	Raison       string
+}
+
+func NewIncantationInterrompueEvent(combatID string, tour int, lanceurID UnitID, competenceID CompetenceID, raison string) *IncantationInterrompueEvent {
+	return &IncantationInterrompueEvent{
+		BaseEvent:    BaseEvent{eventType: "IncantationInterrompue"},
+		Tour:         tour,
+		LanceurID:    lanceurID,
+		CompetenceID: competenceID,
+		Raison:       raison,
+	}
+}
+
+// JaugesATBEnregistreesEvent - L'état des jauges ATB et des incantations en cours a été enregistré (début de tour)
+type JaugesATBEnregistreesEvent struct {
+	BaseEvent
+	Tour    int
+	Jauges  []JaugeATB
+	Charges []ChargeATB
+}
+
+func NewJaugesATBEnregistreesEvent(combatID string, tour int, jauges []JaugeATB, charges []ChargeATB) *JaugesATBEnregistreesEvent {
+	return &JaugesATBEnregistreesEvent{
+		BaseEvent: BaseEvent{eventType: "JaugesATBEnregistrees"},
+		Tour:      tour,
+		Jauges:    jauges,
+		Charges:   charges,
+	}
+}
+
+// CurseurRoundEnregistreEvent - La position du round/de la phase a été enregistrée (début de tour, hors ATB)
+type CurseurRoundEnregistreEvent struct {
+	BaseEvent
+	Tour    int
+	Curseur CurseurRound
+}
+
+func NewCurseurRoundEnregistreEvent(combatID string, tour int, curseur CurseurRound) *CurseurRoundEnregistreEvent {
+	return &CurseurRoundEnregistreEvent{
+		BaseEvent: BaseEvent{eventType: "CurseurRoundEnregistre"},
+		Tour:      tour,
+		Curseur:   curseur,
+	}
+}
+
+// MachineEtatsPositionneeEvent - La machine d'états a atteint une position stable (attente d'une décision, fin)
+type MachineEtatsPositionneeEvent struct {
+	BaseEvent
+	Tour    int
+	Etat    string
+	UniteID UnitID
+}
+
+func NewMachineEtatsPositionneeEvent(combatID string, tour int, position PositionMachineEtats) *MachineEtatsPositionneeEvent {
+	return &MachineEtatsPositionneeEvent{
+		BaseEvent: BaseEvent{eventType: "MachineEtatsPositionnee"},
+		Tour:      tour,
+		Etat:      position.Etat,
+		UniteID:   position.UniteID,
+	}
+}
+
+// PointDeRestaurationPoseEvent - Un joueur d'un combat d'entraînement va jouer une action annulable
+type PointDeRestaurationPoseEvent struct {
 	BaseEvent
 	Tour    int
 	UniteID UnitID
 }
 
-func NewUniteElimineeEvent(combatID string, tour int, uniteID UnitID) *UniteElimineeEvent {
-	return &UniteElimineeEvent{
-		BaseEvent: BaseEvent{eventType: "UniteEliminee"},
+func NewPointDeRestaurationPoseEvent(combatID string, tour int, uniteID UnitID) *PointDeRestaurationPoseEvent {
+	return &PointDeRestaurationPoseEvent{
+		BaseEvent: BaseEvent{eventType: "PointDeRestaurationPose"},
 		Tour:      tour,
 		UniteID:   uniteID,
 	}
 }
 
+// ActionAnnuleeEvent - La dernière action d'un entraînement est annulée (tours joués depuis compris)
+type ActionAnnuleeEvent struct {
+	BaseEvent
+	Tour    int
+	UniteID UnitID
+}
+
+func NewActionAnnuleeEvent(combatID string, tour int, uniteID UnitID) *ActionAnnuleeEvent {
+	return &ActionAnnuleeEvent{
+		BaseEvent: BaseEvent{eventType: "ActionAnnulee"},
+		Tour:      tour,
+		UniteID:   uniteID,
+	}
+}
+
+// ActionRetablieEvent - La dernière action annulée d'un entraînement est refaite
+type ActionRetablieEvent struct {
+	BaseEvent
+	Tour    int
+	UniteID UnitID
+}
+
+func NewActionRetablieEvent(combatID string, tour int, uniteID UnitID) *ActionRetablieEvent {
+	return &ActionRetablieEvent{
+		BaseEvent: BaseEvent{eventType: "ActionRetablie"},
+		Tour:      tour,
+		UniteID:   uniteID,
+	}
+}
+
+// EcheanceTourFixeeEvent - Le délai du tour d'une unité a commencé
+type EcheanceTourFixeeEvent struct {
+	BaseEvent
+	Tour     int
+	UniteID  UnitID
+	Echeance time.Time
+}
+
+func NewEcheanceTourFixeeEvent(combatID string, tour int, uniteID UnitID, echeance time.Time) *EcheanceTourFixeeEvent {
+	return &EcheanceTourFixeeEvent{
+		BaseEvent: BaseEvent{eventType: "EcheanceTourFixee"},
+		Tour:      tour,
+		UniteID:   uniteID,
+		Echeance:  echeance,
+	}
+}
+
+// EcheanceTourLeveeEvent - L'unité a choisi son action avant l'échéance
+type EcheanceTourLeveeEvent struct {
+	BaseEvent
+	Tour    int
+	UniteID UnitID
+}
+
+func NewEcheanceTourLeveeEvent(combatID string, tour int, uniteID UnitID) *EcheanceTourLeveeEvent {
+	return &EcheanceTourLeveeEvent{
+		BaseEvent: BaseEvent{eventType: "EcheanceTourLevee"},
+		Tour:      tour,
+		UniteID:   uniteID,
+	}
+}
+
+// TourExpireEvent - Le délai du tour est écoulé, l'action par défaut a été jouée
+type TourExpireEvent struct {
+	BaseEvent
+	Tour            int
+	UniteID         UnitID
+	Echeance        time.Time
+	ActionAppliquee ActionExpiration
+}
+
+func NewTourExpireEvent(combatID string, tour int, uniteID UnitID, echeance time.Time, action ActionExpiration) *TourExpireEvent {
+	return &TourExpireEvent{
+		BaseEvent:       BaseEvent{eventType: "TourExpire"},
+		Tour:            tour,
+		UniteID:         uniteID,
+		Echeance:        echeance,
+		ActionAppliquee: action,
+	}
+}
+
+// ActiviteTourEnregistreeEvent - L'activité du tour en cours d'une unité a changé (ouverture, déplacement, action)
+type ActiviteTourEnregistreeEvent struct {
+	BaseEvent
+	Tour     int
+	Activite ActiviteTour
+}
+
+func NewActiviteTourEnregistreeEvent(combatID string, tour int, activite ActiviteTour) *ActiviteTourEnregistreeEvent {
+	return &ActiviteTourEnregistreeEvent{
+		BaseEvent: BaseEvent{eventType: "ActiviteTourEnregistree"},
+		Tour:      tour,
+		Activite:  activite,
+	}
+}
+
+// ActiviteTourConclueEvent - Le tour d'une unité est terminé, une part de sa jauge ATB est conservée
+type ActiviteTourConclueEvent struct {
+	BaseEvent
+	Tour         int
+	Activite     ActiviteTour
+	Conservation int // % de jauge conservé
+}
+
+func NewActiviteTourConclueEvent(combatID string, tour int, activite ActiviteTour, conservation int) *ActiviteTourConclueEvent {
+	return &ActiviteTourConclueEvent{
+		BaseEvent:    BaseEvent{eventType: "ActiviteTourConclue"},
+		Tour:         tour,
+		Activite:     activite,
+		Conservation: conservation,
+	}
+}
+
 // UniteDeplaceeEvent - Une unité s'est déplacée
 type UniteDeplaceeEvent struct {
 	BaseEvent
@@ -205,6 +586,76 @@ func NewCombatTermineEvent(combatID string, tour int, vainqueur *TeamID) *Combat
 	}
 }
 
+// RecompensesDistribueesEvent - L'équipe victorieuse reçoit l'expérience et le butin resté sur la grille
+type RecompensesDistribueesEvent struct {
+	BaseEvent
+	Tour       int
+	Vainqueur  TeamID
+	Experience map[UnitID]int         // Expérience gagnée par membre encore debout
+	Butins     []string               // Butins retirés de la grille
+	Objets     map[shared.ObjetID]int // Contenu des coffres ajouté à l'inventaire de l'équipe
+}
+
+func NewRecompensesDistribueesEvent(combatID string, tour int, vainqueur TeamID) *RecompensesDistribueesEvent {
+	return &RecompensesDistribueesEvent{
+		BaseEvent:  BaseEvent{eventType: "RecompensesDistribuees"},
+		Tour:       tour,
+		Vainqueur:  vainqueur,
+		Experience: make(map[UnitID]int),
+		Butins:     make([]string, 0),
+		Objets:     make(map[shared.ObjetID]int),
+	}
+}
+
+// CombatMisEnPauseEvent - Le combat est suspendu (litige): délai du tour gelé, aucune action acceptée
+type CombatMisEnPauseEvent struct {
+	BaseEvent
+	Tour         int
+	Motif        string
+	Debut        time.Time
+	UniteID      UnitID        // Unité dont le délai est gelé (vide sans délai en cours)
+	TempsRestant time.Duration // Temps restant du délai gelé
+}
+
+func NewCombatMisEnPauseEvent(combatID string, tour int, motif string, debut time.Time) *CombatMisEnPauseEvent {
+	return &CombatMisEnPauseEvent{
+		BaseEvent: BaseEvent{eventType: "CombatMisEnPause"},
+		Tour:      tour,
+		Motif:     motif,
+		Debut:     debut,
+	}
+}
+
+// CombatReprisEvent - Le combat reprend là où il s'était arrêté
+type CombatReprisEvent struct {
+	BaseEvent
+	Tour     int
+	UniteID  UnitID     // Unité dont le délai repart (vide sans délai gelé)
+	Echeance *time.Time // Nouvelle échéance: reprise + temps restant au moment de la pause
+}
+
+func NewCombatReprisEvent(combatID string, tour int) *CombatReprisEvent {
+	return &CombatReprisEvent{
+		BaseEvent: BaseEvent{eventType: "CombatRepris"},
+		Tour:      tour,
+	}
+}
+
+// CombatAnnuleEvent - Le combat est annulé (incident serveur): ni vainqueur ni récompenses
+type CombatAnnuleEvent struct {
+	BaseEvent
+	Tour  int
+	Motif string
+}
+
+func NewCombatAnnuleEvent(combatID string, tour int, motif string) *CombatAnnuleEvent {
+	return &CombatAnnuleEvent{
+		BaseEvent: BaseEvent{eventType: "CombatAnnule"},
+		Tour:      tour,
+		Motif:     motif,
+	}
+}
+
 // DeplacementExecuteEvent - Un déplacement a été exécuté avec pathfinding
 type DeplacementExecuteEvent struct {
 	BaseEvent
@@ -236,6 +687,235 @@ func NewDeplacementExecuteEvent(
 	}
 }
 
+// ObjetConsommeEvent - Un objet a été consommé dans l'inventaire d'une équipe
+type ObjetConsommeEvent struct {
+	BaseEvent
+	Tour     int
+	TeamID   TeamID
+	ObjetID  shared.ObjetID
+	Quantite int
+}
+
+func NewObjetConsommeEvent(tour int, teamID TeamID, objetID shared.ObjetID, quantite int) *ObjetConsommeEvent {
+	return &ObjetConsommeEvent{
+		BaseEvent: BaseEvent{eventType: "ObjetConsomme"},
+		Tour:      tour,
+		TeamID:    teamID,
+		ObjetID:   objetID,
+		Quantite:  quantite,
+	}
+}
+
+// ObjetRestitueEvent - Un objet consommé a été rendu (rollback d'une commande)
+type ObjetRestitueEvent struct {
+	BaseEvent
+	Tour     int
+	TeamID   TeamID
+	ObjetID  shared.ObjetID
+	Quantite int
+}
+
+func NewObjetRestitueEvent(tour int, teamID TeamID, objetID shared.ObjetID, quantite int) *ObjetRestitueEvent {
+	return &ObjetRestitueEvent{
+		BaseEvent: BaseEvent{eventType: "ObjetRestitue"},
+		Tour:      tour,
+		TeamID:    teamID,
+		ObjetID:   objetID,
+		Quantite:  quantite,
+	}
+}
+
+// ObjetUtiliseEvent - Un objet a été utilisé (point d'impact et unités touchées)
+type ObjetUtiliseEvent struct {
+	BaseEvent
+	Tour          int
+	ActeurID      UnitID
+	ObjetID       shared.ObjetID
+	PositionCible *shared.Position
+	Cibles        []UnitID
+}
+
+func NewObjetUtiliseEvent(combatID string, tour int, acteurID UnitID, objetID shared.ObjetID, position *shared.Position, cibles []UnitID) *ObjetUtiliseEvent {
+	return &ObjetUtiliseEvent{
+		BaseEvent:     BaseEvent{eventType: "ObjetUtilise"},
+		Tour:          tour,
+		ActeurID:      acteurID,
+		ObjetID:       objetID,
+		PositionCible: position,
+		Cibles:        cibles,
+	}
+}
+
+// MPRestaureEvent - Des MP ont été restaurés
+type MPRestaureEvent struct {
+	BaseEvent
+	Tour     int
+	ActeurID UnitID
+	CibleID  UnitID
+	MP       int
+}
+
+func NewMPRestaureEvent(combatID string, tour int, acteurID, cibleID UnitID, mp int) *MPRestaureEvent {
+	return &MPRestaureEvent{
+		BaseEvent: BaseEvent{eventType: "MPRestaure"},
+		Tour:      tour,
+		ActeurID:  acteurID,
+		CibleID:   cibleID,
+		MP:        mp,
+	}
+}
+
+// StatutRetireEvent - Un statut a été retiré (antidote, purge)
+type StatutRetireEvent struct {
+	BaseEvent
+	Tour       int
+	ActeurID   UnitID
+	CibleID    UnitID
+	TypeStatut shared.TypeStatut
+}
+
+func NewStatutRetireEvent(combatID string, tour int, acteurID, cibleID UnitID, typeStatut shared.TypeStatut) *StatutRetireEvent {
+	return &StatutRetireEvent{
+		BaseEvent:  BaseEvent{eventType: "StatutRetire"},
+		Tour:       tour,
+		ActeurID:   acteurID,
+		CibleID:    cibleID,
+		TypeStatut: typeStatut,
+	}
+}
+
+// UniteRessusciteeEvent - Une unité KO a été ranimée
+type UniteRessusciteeEvent struct {
+	BaseEvent
+	Tour     int
+	ActeurID UnitID
+	UniteID  UnitID
+	HP       int
+}
+
+func NewUniteRessusciteeEvent(combatID string, tour int, acteurID, uniteID UnitID, hp int) *UniteRessusciteeEvent {
+	return &UniteRessusciteeEvent{
+		BaseEvent: BaseEvent{eventType: "UniteRessuscitee"},
+		Tour:      tour,
+		ActeurID:  acteurID,
+		UniteID:   uniteID,
+		HP:        hp,
+	}
+}
+
+// StaminaConsommeeEvent - Une unité a dépensé de la Stamina (déplacement, attaque, compétence physique)
+// L'épuisement éventuel suit sous forme de StatutAppliqueEvent
+type StaminaConsommeeEvent struct {
+	BaseEvent
+	Tour    int
+	UniteID UnitID
+	Stamina int
+}
+
+func NewStaminaConsommeeEvent(combatID string, tour int, uniteID UnitID, stamina int) *StaminaConsommeeEvent {
+	return &StaminaConsommeeEvent{
+		BaseEvent: BaseEvent{eventType: "StaminaConsommee"},
+		Tour:      tour,
+		UniteID:   uniteID,
+		Stamina:   stamina,
+	}
+}
+
+// MenaceGenereeEvent - Des dégâts ou des soins ont généré de la menace envers leur source
+// Menaces: menace ajoutée dans la table de chaque unité IA concernée
+type MenaceGenereeEvent struct {
+	BaseEvent
+	Tour     int
+	SourceID UnitID
+	Menaces  map[UnitID]int
+}
+
+func NewMenaceGenereeEvent(combatID string, tour int, sourceID UnitID, menaces map[UnitID]int) *MenaceGenereeEvent {
+	return &MenaceGenereeEvent{
+		BaseEvent: BaseEvent{eventType: "MenaceGeneree"},
+		Tour:      tour,
+		SourceID:  sourceID,
+		Menaces:   menaces,
+	}
+}
+
+// TourUniteEntameEvent - Le tour d'une unité commence: compteurs du tour, statuts, cooldowns et régénération
+type TourUniteEntameEvent struct {
+	BaseEvent
+	Tour             int
+	UniteID          UnitID
+	TauxRegenStamina int
+
+	effets []shared.EffetStatut // Effets périodiques produits par l'Apply (non persistés)
+}
+
+func NewTourUniteEntameEvent(combatID string, tour int, uniteID UnitID, tauxRegenStamina int) *TourUniteEntameEvent {
+	return &TourUniteEntameEvent{
+		BaseEvent:        BaseEvent{eventType: "TourUniteEntame"},
+		Tour:             tour,
+		UniteID:          uniteID,
+		TauxRegenStamina: tauxRegenStamina,
+	}
+}
+
+// DureeStopDecompteeEvent - Le tour d'une autre unité a fait s'écouler le Stop des unités figées
+// Les unités libérées suivent sous forme de StatutRetireEvent
+type DureeStopDecompteeEvent struct {
+	BaseEvent
+	Tour     int
+	ActeurID UnitID
+	UniteIDs []UnitID
+}
+
+func NewDureeStopDecompteeEvent(combatID string, tour int, acteurID UnitID, uniteIDs []UnitID) *DureeStopDecompteeEvent {
+	return &DureeStopDecompteeEvent{
+		BaseEvent: BaseEvent{eventType: "DureeStopDecomptee"},
+		Tour:      tour,
+		ActeurID:  acteurID,
+		UniteIDs:  uniteIDs,
+	}
+}
+
+// UniteProvoqueeEvent - Une unité est provoquée: elle doit attaquer la source tant que le statut dure
+type UniteProvoqueeEvent struct {
+	BaseEvent
+	Tour     int
+	SourceID UnitID
+	CibleID  UnitID
+	Statut   *shared.Statut
+}
+
+func NewUniteProvoqueeEvent(combatID string, tour int, sourceID, cibleID UnitID, statut *shared.Statut) *UniteProvoqueeEvent {
+	return &UniteProvoqueeEvent{
+		BaseEvent: BaseEvent{eventType: "UniteProvoquee"},
+		Tour:      tour,
+		SourceID:  sourceID,
+		CibleID:   cibleID,
+		Statut:    statut,
+	}
+}
+
+// UniteCharmeeEvent - Une unité est charmée: elle combat pour l'équipe du charmeur tant que le statut dure
+type UniteCharmeeEvent struct {
+	BaseEvent
+	Tour     int
+	SourceID UnitID
+	CibleID  UnitID
+	TeamID   TeamID
+	Statut   *shared.Statut
+}
+
+func NewUniteCharmeeEvent(combatID string, tour int, sourceID, cibleID UnitID, teamID TeamID, statut *shared.Statut) *UniteCharmeeEvent {
+	return &UniteCharmeeEvent{
+		BaseEvent: BaseEvent{eventType: "UniteCharmee"},
+		Tour:      tour,
+		SourceID:  sourceID,
+		CibleID:   cibleID,
+		TeamID:    teamID,
+		Statut:    statut,
+	}
+}
+
 // ActionCombat représente une action à exécuter
 type ActionCombat struct {
 	Type          TypeAction
@@ -255,6 +935,9 @@ const (
 	TypeActionDeplacement
 	TypeActionObjet
 	TypeActionPasser
+	TypeActionFuite
+	TypeActionComposee      // Plusieurs étapes jouées dans le même tour
+	TypeActionPersonnalisee // Type de commande enregistré hors du moteur (voir ActionExecuteeEvent.TypeCommande)
 )
 
 // ResultatAction représente le résultat d'une action
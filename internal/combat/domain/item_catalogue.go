@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"errors"
+
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// ItemCatalogue référence les définitions d'objets disponibles dans un combat
+// Responsabilités: Résolution ObjetID → définition shared.Item
+// Les quantités possédées sont gérées par TeamInventory
+type ItemCatalogue struct {
+	items map[shared.ObjetID]*shared.Item
+}
+
+// NewItemCatalogue crée un catalogue à partir de définitions d'objets
+func NewItemCatalogue(items ...*shared.Item) *ItemCatalogue {
+	catalogue := &ItemCatalogue{
+		items: make(map[shared.ObjetID]*shared.Item),
+	}
+	for _, item := range items {
+		_ = catalogue.Register(item)
+	}
+	return catalogue
+}
+
+// NewDefaultItemCatalogue crée le catalogue standard (Potion, Éther, Antidote, Queue de Phénix, Bombe)
+func NewDefaultItemCatalogue() *ItemCatalogue {
+	return NewItemCatalogue(
+		&shared.Item{
+			ID:          ObjetPotion,
+			Name:        "Potion",
+			Description: "Restaure des HP à un allié",
+			ItemType:    shared.ItemTypePotion,
+			EffectVal:   PotionSoin,
+			Range:       PorteeObjetDefaut,
+		},
+		&shared.Item{
+			ID:          ObjetEther,
+			Name:        "Éther",
+			Description: "Restaure des MP à un allié",
+			ItemType:    shared.ItemTypeEther,
+			EffectVal:   EtherMP,
+			Range:       PorteeObjetDefaut,
+		},
+		&shared.Item{
+			ID:          ObjetAntidote,
+			Name:        "Antidote",
+			Description: "Soigne le poison d'un allié",
+			ItemType:    shared.ItemTypeAntidote,
+			Range:       PorteeObjetDefaut,
+		},
+		&shared.Item{
+			ID:          ObjetQueuePhenix,
+			Name:        "Queue de Phénix",
+			Description: "Ranime un allié KO",
+			ItemType:    shared.ItemTypeRevive,
+			EffectVal:   QueuePhenixHP,
+			Range:       PorteeObjetDefaut,
+		},
+		&shared.Item{
+			ID:           ObjetBombe,
+			Name:         "Bombe",
+			Description:  "Inflige des dégâts dans une zone, alliés compris",
+			ItemType:     shared.ItemTypeBomb,
+			EffectVal:    BombeDegats,
+			Range:        PorteeBombe,
+			AreaRadius:   RayonBombe,
+			FriendlyFire: true,
+		},
+	)
+}
+
+// Register ajoute (ou remplace) une définition d'objet
+func (c *ItemCatalogue) Register(item *shared.Item) error {
+	if item == nil {
+		return errors.New("objet nil")
+	}
+	if item.ID == "" {
+		return errors.New("ID d'objet requis")
+	}
+	c.items[shared.ObjetID(item.ID)] = item
+	return nil
+}
+
+// Get retourne la définition d'un objet (nil si inconnu)
+func (c *ItemCatalogue) Get(itemID shared.ObjetID) *shared.Item {
+	return c.items[itemID]
+}
+
+// Contains vérifie si un objet est défini dans le catalogue
+func (c *ItemCatalogue) Contains(itemID shared.ObjetID) bool {
+	_, exists := c.items[itemID]
+	return exists
+}
+
+// Items retourne toutes les définitions du catalogue
+func (c *ItemCatalogue) Items() []*shared.Item {
+	items := make([]*shared.Item, 0, len(c.items))
+	for _, item := range c.items {
+		items = append(items, item)
+	}
+	return items
+}
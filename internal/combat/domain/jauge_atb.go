@@ -0,0 +1,33 @@
+package domain
+
+// JaugeATB est l'état sérialisable de la jauge ATB d'une unité
+// Enregistré dans le flux d'événements (JaugesATBEnregistreesEvent) pour reconstruire
+// l'ordre des tours après rechargement du combat
+type JaugeATB struct {
+	UniteID  UnitID
+	Valeur   int  // 0-100
+	Excedent int  // Excédent au-delà de 100 lors du dernier remplissage
+	Vitesse  int  // Vitesse de remplissage par tick
+	SPD      int  // SPD effective (départage des égalités)
+	Active   bool // Fausse pour une unité définitivement morte
+	Gelee    bool // Vraie sous Stop
+}
+
+// CurseurRound est la position sérialisable d'un ordonnanceur par rounds (CTB, phases d'équipes)
+// Enregistré comme les jauges ATB au début de chaque tour: un combat rechargé reprend le round
+// ou la phase en cours au lieu de le recommencer
+type CurseurRound struct {
+	Demarre bool     // Faux tant qu'aucun round n'a commencé
+	Groupe  TeamID   // Équipe dont c'est la phase (vide en CTB)
+	Joue    []UnitID // Unités ayant déjà joué pendant le round/la phase en cours, triées
+}
+
+// ChargeATB est l'état sérialisable d'une compétence en cours d'incantation (mode ATB)
+// Enregistrée avec les jauges: un combat rechargé résout l'incantation au même tick
+type ChargeATB struct {
+	UniteID      UnitID
+	CompetenceID CompetenceID // Vide pour une action chargée sans compétence
+	Libelle      string
+	Cibles       []UnitID
+	Restant      int // Ticks restants avant la résolution
+}
@@ -22,6 +22,12 @@ type CommandSystemProvider interface {
 	// Undo annule la dernière commande
 	Undo() error
 
+	// CanRedo vérifie si une commande annulée peut être refaite
+	CanRedo() bool
+
+	// Redo refait la dernière commande annulée
+	Redo() error
+
 	// History retourne l'historique des commandes
 	History() []string
 } // CommandFactoryProvider crée des commandes
@@ -52,4 +58,7 @@ type ValidationProvider interface {
 
 	// AddValidator ajoute un validateur à la chaîne
 	AddValidator(validator interface{})
+
+	// Cloner copie la chaîne: un validateur ajouté à la copie ne touche pas l'original
+	Cloner() ValidationProvider
 }
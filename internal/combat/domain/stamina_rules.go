@@ -0,0 +1,54 @@
+package domain
+
+import "errors"
+
+// ReglesStamina regroupe les coûts et la régénération de Stamina d'un combat
+// Responsabilités: Calcul des coûts physiques (déplacement, attaque, compétences physiques)
+// La Stamina est aux guerriers ce que les MP sont aux lanceurs de sorts
+type ReglesStamina struct {
+	CoutParCase            int // Stamina par case parcourue
+	CoutAttaque            int // Stamina par attaque basique
+	CoutCompetencePhysique int // Coût minimum d'une compétence physique (CompetenceAttaque)
+	TauxRegeneration       int // % de la Stamina max régénéré par tour
+}
+
+// NewReglesStaminaDefaut crée les règles de Stamina par défaut
+func NewReglesStaminaDefaut() *ReglesStamina {
+	return &ReglesStamina{
+		CoutParCase:            CoutStaminaParCase,
+		CoutAttaque:            CoutStaminaAttaque,
+		CoutCompetencePhysique: CoutStaminaCompetencePhysique,
+		TauxRegeneration:       TauxRegenStaminaDefaut,
+	}
+}
+
+// Valider vérifie la cohérence des règles
+func (r *ReglesStamina) Valider() error {
+	if r.CoutParCase < 0 || r.CoutAttaque < 0 || r.CoutCompetencePhysique < 0 {
+		return errors.New("les coûts en Stamina doivent être >= 0")
+	}
+	if r.TauxRegeneration < 0 || r.TauxRegeneration > 100 {
+		return errors.New("le taux de régénération de Stamina doit être entre 0 et 100")
+	}
+	return nil
+}
+
+// CoutDeplacement retourne le coût en Stamina d'un déplacement de n cases
+func (r *ReglesStamina) CoutDeplacement(cases int) int {
+	if cases <= 0 {
+		return CoutStaminaNul
+	}
+	return cases * r.CoutParCase
+}
+
+// CoutCompetence retourne le coût en Stamina d'une compétence
+// Les compétences physiques coûtent au moins CoutCompetencePhysique
+func (r *ReglesStamina) CoutCompetence(comp *Competence) int {
+	if comp == nil {
+		return CoutStaminaNul
+	}
+	if comp.EstPhysique() && comp.CoutStamina() < r.CoutCompetencePhysique {
+		return r.CoutCompetencePhysique
+	}
+	return comp.CoutStamina()
+}
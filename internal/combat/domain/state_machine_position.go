@@ -0,0 +1,26 @@
+package domain
+
+// PositionMachineEtats est la dernière position stable de la machine d'états du combat
+// Enregistrée quand la machine attend une décision extérieure (action d'un joueur, reprise
+// du MJ) ou s'est arrêtée, pour reprendre un combat rechargé là où il s'était arrêté
+// Aucune commande n'est en cours dans une position stable: le tour reprend à la sélection d'action
+type PositionMachineEtats struct {
+	Etat    string // Nom de l'état (ActionSelection, Paused, BattleEnded...)
+	UniteID UnitID // Unité dont l'action est attendue (vide hors tour d'un joueur)
+}
+
+// PositionMachine retourne la dernière position enregistrée de la machine d'états (nil si jamais enregistrée)
+func (c *Combat) PositionMachine() *PositionMachineEtats {
+	return c.positionMachine
+}
+
+// EnregistrerPositionMachine enregistre la position de la machine d'états dans le flux d'événements
+// Sans effet si la position n'a pas changé depuis le dernier enregistrement
+func (c *Combat) EnregistrerPositionMachine(position PositionMachineEtats) {
+	if c.positionMachine != nil && *c.positionMachine == position {
+		return
+	}
+	evt := NewMachineEtatsPositionneeEvent(c.id, c.tourActuel, position)
+	_ = c.Apply(evt)
+	c.RaiseEvent(evt)
+}
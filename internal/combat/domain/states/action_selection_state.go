@@ -12,18 +12,14 @@ import (
 type ActionSelectionState struct {
 	BaseState
 	currentUnit *domain.Unite
+	imposee     *actionImposee // Action imposée par un statut (Sommeil, Charme, Confusion, Berserk)
+	ouvert      bool           // Tour déjà ouvert: une reprise après pause ne le réinitialise pas
 }
 
 // NewActionSelectionState crée un nouvel état ActionSelection
 func NewActionSelectionState(currentUnit *domain.Unite) *ActionSelectionState {
 	return &ActionSelectionState{
-		BaseState: BaseState{
-			name: "ActionSelection",
-			allowedTransitions: map[string]bool{
-				"Validating": true,
-				"TurnEnd":    true, // Wait command passe directement à TurnEnd
-			},
-		},
+		BaseState:   newBaseState("ActionSelection"),
 		currentUnit: currentUnit,
 	}
 }
@@ -32,15 +28,52 @@ func NewActionSelectionState(currentUnit *domain.Unite) *ActionSelectionState {
 func (s *ActionSelectionState) Enter(ctx *CombatContext) error {
 	fmt.Printf("[State] Entrée dans état: %s pour %s\n", s.Name(), s.currentUnit.Nom())
 
-	// Si c'est une IA, déclencher automatiquement la sélection d'action
+	// Reprise après une pause: le tour continue là où il s'était arrêté (délai restauré par Combat.Reprendre)
+	if s.ouvert {
+		return nil
+	}
+	s.ouvert = true
+
+	// Suivre ce que l'unité fait de son tour (part de jauge ATB conservée en fin de tour)
+	ctx.Combat.OuvrirActiviteTour(s.currentUnit.ID())
+
+	// Un statut de comportement impose l'action et remplace l'input du joueur
+	if imposee := choisirActionImposee(ctx, s.currentUnit); imposee != nil {
+		s.imposee = imposee
+		ctx.PendingCommand = imposee.commande
+		fmt.Printf("[Statut] %s est %s\n", s.currentUnit.Nom(), imposee.raison)
+		ctx.Combat.RaiseEvent(imposee.event(ctx, s.currentUnit))
+		return nil
+	}
+
+	// Si c'est une IA, préparer automatiquement sa commande
+	// Elle sera confirmée via EventCommandSelected (sans données)
 	if s.currentUnit.EstIA() {
-		// L'IA sélectionnera automatiquement une action via EventCommandSelected
-		s.currentUnit.IAChoisirAction(ctx.Combat)
+		ctx.PendingCommand = s.choisirCommandeIA(ctx)
+		return nil
+	}
+
+	// Un joueur dispose d'un temps limité (si configuré) avant EventTimeout
+	if echeance := ctx.Combat.FixerEcheanceTour(s.currentUnit.ID(), ctx.Now()); echeance != nil {
+		fmt.Printf("[Délai] %s doit jouer avant %s\n", s.currentUnit.Nom(), echeance.Echeance.Format("15:04:05"))
 	}
 
 	return nil
 }
 
+// choisirCommandeIA construit la commande de l'IA
+// La cible respecte provocation et table de menace (Combat.ChoisirCibleIA), l'IA attend si l'attaque est impossible
+func (s *ActionSelectionState) choisirCommandeIA(ctx *CombatContext) commands.Command {
+	cible := ctx.Combat.ChoisirCibleIA(s.currentUnit)
+	commande := attaqueOuAttente(ctx, s.currentUnit, cible)
+	if commande.GetType() == commands.CommandTypeAttack {
+		fmt.Printf("[IA] %s attaque %s\n", s.currentUnit.Nom(), cible.Nom())
+	} else {
+		fmt.Printf("[IA] %s attend\n", s.currentUnit.Nom())
+	}
+	return commande
+}
+
 // Exit est appelé lors de la sortie
 func (s *ActionSelectionState) Exit(ctx *CombatContext) error {
 	fmt.Printf("[State] Sortie de l'état: %s\n", s.Name())
@@ -52,25 +85,61 @@ func (s *ActionSelectionState) Handle(ctx *CombatContext, event StateEvent) (Com
 	switch event.Type {
 	case EventCommandSelected:
 		// Le joueur ou l'IA a choisi une commande
-		// Stocker la commande dans le contexte
-		if cmd, ok := event.Data.(commands.Command); ok {
-			ctx.PendingCommand = cmd
-
-			// Si c'est Wait, passer directement à TurnEnd
-			if cmd.GetType() == commands.CommandTypeWait {
-				return NewTurnEndState(), nil
+		// Sans données, la commande préparée par l'IA est utilisée
+		data := event.Data
+		if s.imposee != nil {
+			// L'action imposée par un statut remplace la commande reçue
+			if data != nil {
+				fmt.Printf("[Statut] Commande ignorée pour %s: %s\n", s.currentUnit.Nom(), s.imposee.raison)
 			}
+			data = s.imposee.commande
+		} else if data == nil {
+			data = ctx.PendingCommand
+		}
+
+		cmd, ok := data.(commands.Command)
+		if !ok {
+			return nil, fmt.Errorf("données de commande invalides")
+		}
+		ctx.Combat.LeverEcheanceTour(s.currentUnit.ID())
+		return s.selectionner(ctx, cmd), nil
+
+	case EventTimeout:
+		// Le délai du tour est écoulé: l'action par défaut remplace l'input du joueur
+		if uniteID, ok := event.Data.(domain.UnitID); ok && uniteID != s.currentUnit.ID() {
+			return nil, fmt.Errorf("expiration reçue pour %s pendant le tour de %s", uniteID, s.currentUnit.ID())
+		}
+		action := ctx.Combat.ReglesDelaiTour().ActionParDefaut
+		if err := ctx.Combat.ExpirerTour(s.currentUnit.ID(), action); err != nil {
+			return nil, err
+		}
+		fmt.Printf("[Délai] Tour de %s expiré, action par défaut: %s\n", s.currentUnit.Nom(), action)
 
-			// Sinon, passer à la validation
-			return NewValidatingState(), nil
+		var cmd commands.Command = commands.NewWaitCommand(s.currentUnit, ctx.Combat)
+		if action == domain.ActionExpirationIA {
+			cmd = s.choisirCommandeIA(ctx)
 		}
-		return nil, fmt.Errorf("données de commande invalides")
+		return s.selectionner(ctx, cmd), nil
+
+	case EventPause:
+		// Le MJ suspend le combat pendant le tour de l'unité
+		return NewPausedState(s), nil
+
+	case EventCancel:
+		return NewCancelledState(), nil
 
 	default:
 		return nil, fmt.Errorf("événement %s non géré dans l'état %s", event.Type, s.Name())
 	}
 }
 
+// selectionner stocke la commande choisie et passe à sa validation
+// Wait suit le même chemin que les autres commandes (chaîne de validation, exécution)
+func (s *ActionSelectionState) selectionner(ctx *CombatContext, cmd commands.Command) CombatState {
+	ctx.PendingCommand = cmd
+	return NewValidatingState()
+}
+
 // CurrentUnit retourne l'unité active
 func (s *ActionSelectionState) CurrentUnit() *domain.Unite {
 	return s.currentUnit
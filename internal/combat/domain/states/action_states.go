@@ -2,6 +2,8 @@ package states
 
 import (
 	"fmt"
+
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
 )
 
 // ConfirmedState représente une action confirmée prête à être exécutée
@@ -12,12 +14,7 @@ type ConfirmedState struct {
 // NewConfirmedState crée un nouvel état Confirmed
 func NewConfirmedState() *ConfirmedState {
 	return &ConfirmedState{
-		BaseState: BaseState{
-			name: "Confirmed",
-			allowedTransitions: map[string]bool{
-				"Executing": true,
-			},
-		},
+		BaseState: newBaseState("Confirmed"),
 	}
 }
 
@@ -65,12 +62,7 @@ type ActionRejectedState struct {
 // NewActionRejectedState crée un nouvel état ActionRejected
 func NewActionRejectedState() *ActionRejectedState {
 	return &ActionRejectedState{
-		BaseState: BaseState{
-			name: "ActionRejected",
-			allowedTransitions: map[string]bool{
-				"ActionSelection": true, // Retour à la sélection d'action
-			},
-		},
+		BaseState: newBaseState("ActionRejected"),
 	}
 }
 
@@ -101,9 +93,11 @@ func (s *ActionRejectedState) Exit(ctx *CombatContext) error {
 func (s *ActionRejectedState) Handle(ctx *CombatContext, event StateEvent) (CombatState, error) {
 	switch event.Type {
 	case EventRetryAction:
-		// Retourner à la sélection d'action
-		// Récupérer l'acteur depuis Combat ou créer un nouvel état générique
-		return NewActionSelectionState(nil), nil
+		// Rendre la main à l'acteur de la commande rejetée
+		if cmd, ok := ctx.PendingCommand.(commands.Command); ok {
+			return NewActionSelectionState(cmd.GetActor()), nil
+		}
+		return nil, fmt.Errorf("aucune commande rejetée à reprendre")
 
 	default:
 		return nil, fmt.Errorf("événement %s non géré dans l'état %s", event.Type, s.Name())
@@ -125,12 +119,7 @@ type StunnedState struct {
 // NewStunnedState crée un nouvel état Stunned
 func NewStunnedState() *StunnedState {
 	return &StunnedState{
-		BaseState: BaseState{
-			name: "Stunned",
-			allowedTransitions: map[string]bool{
-				"TurnEnd": true,
-			},
-		},
+		BaseState: newBaseState("Stunned"),
 	}
 }
 
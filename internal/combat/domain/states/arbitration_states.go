@@ -0,0 +1,77 @@
+package states
+
+import "fmt"
+
+// PausedState représente un combat suspendu par le MJ pendant le tour d'un joueur
+// Aucune action n'est acceptée, les jauges ATB ne progressent pas et le délai du tour est gelé
+type PausedState struct {
+	BaseState
+	interrompu *ActionSelectionState // Tour repris tel quel à la fin de la pause
+}
+
+// NewPausedState crée un nouvel état Paused
+func NewPausedState(interrompu *ActionSelectionState) *PausedState {
+	return &PausedState{
+		BaseState:  newBaseState("Paused"),
+		interrompu: interrompu,
+	}
+}
+
+// Enter suspend le combat
+func (s *PausedState) Enter(ctx *CombatContext) error {
+	fmt.Printf("[State] Entrée dans état: %s\n", s.Name())
+	if pause := ctx.Combat.Pause(); pause != nil {
+		fmt.Printf("[Arbitrage] Combat en pause: %s\n", pause.Motif)
+	}
+	return nil
+}
+
+// Exit est appelé lors de la sortie
+func (s *PausedState) Exit(ctx *CombatContext) error {
+	fmt.Printf("[State] Sortie de l'état: %s\n", s.Name())
+	return nil
+}
+
+// Handle gère les événements dans Paused
+func (s *PausedState) Handle(ctx *CombatContext, event StateEvent) (CombatState, error) {
+	switch event.Type {
+	case EventResume:
+		// Le tour interrompu reprend sans être réinitialisé
+		return s.interrompu, nil
+
+	case EventCancel:
+		return NewCancelledState(), nil
+
+	default:
+		return nil, fmt.Errorf("événement %s non géré dans l'état %s", event.Type, s.Name())
+	}
+}
+
+// CancelledState représente un combat annulé (incident serveur): ni vainqueur ni récompenses
+type CancelledState struct {
+	BaseState
+}
+
+// NewCancelledState crée un nouvel état Cancelled
+func NewCancelledState() *CancelledState {
+	return &CancelledState{
+		BaseState: newBaseState("Cancelled"),
+	}
+}
+
+// Enter constate l'annulation
+func (s *CancelledState) Enter(ctx *CombatContext) error {
+	fmt.Printf("[State] Entrée dans état: %s\n", s.Name())
+	fmt.Printf("[Arbitrage] Combat annulé: %s\n", ctx.Combat.MotifAnnulation())
+	return nil
+}
+
+// Exit est appelé lors de la sortie (ne devrait jamais arriver)
+func (s *CancelledState) Exit(ctx *CombatContext) error {
+	return nil
+}
+
+// Handle ne gère aucun événement (état terminal)
+func (s *CancelledState) Handle(ctx *CombatContext, event StateEvent) (CombatState, error) {
+	return nil, fmt.Errorf("état Cancelled est terminal, aucune transition possible")
+}
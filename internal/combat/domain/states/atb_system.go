@@ -1,28 +1,161 @@
 package states
 
 import (
+	"fmt"
+	"sort"
+
 	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
 )
 
+// limiteTicksATB borne l'avance des jauges sans tour ni résolution
+// Une jauge à la vitesse minimale se remplit en 100 ticks: la borne couvre les longues incantations
+const limiteTicksATB = 10000
+
 // ATBSystem gère le système de jauge ATB (Active Time Battle)
 // Système de gestion du temps actif pour déterminer l'ordre des tours
 type ATBSystem struct {
-	gauges map[domain.UnitID]*ATBGauge
+	gauges  map[domain.UnitID]*ATBGauge
+	charges map[domain.UnitID]*ATBCharge
+
+	// Part de jauge conservée par les tours simulés de la prévision (tour complet)
+	forecastRetention int
 }
 
 // ATBGauge représente la jauge ATB d'une unité
 type ATBGauge struct {
+	UnitID   domain.UnitID
+	Value    int // 0-100
+	Overflow int // Excédent au-delà de 100 lors du dernier remplissage
+	Speed    int // Vitesse de remplissage (basée sur SPD stat)
+	SPD      int // SPD effective de l'unité (départage des égalités)
+	Active   bool
+	Frozen   bool // Jauge gelée (Stop): ne se remplit pas et ne donne pas de tour
+}
+
+// ATBCharge représente une action en cours de chargement (temps d'incantation)
+type ATBCharge struct {
+	UnitID    domain.UnitID
+	SkillID   domain.CompetenceID // Compétence résolue au terme de la charge (vide: action sans compétence)
+	Targets   []domain.UnitID
+	Label     string
+	Remaining int // Ticks restants avant la résolution
+}
+
+// ForecastType distingue les entrées de la prévision d'ordre des tours
+type ForecastType string
+
+const (
+	ForecastTurn   ForecastType = "TURN"   // Tour d'une unité
+	ForecastCharge ForecastType = "CHARGE" // Résolution d'une action chargée
+)
+
+// TurnForecast représente une entrée de la prévision d'ordre des tours
+type TurnForecast struct {
+	Rank   int // Position dans la prévision (1 = prochaine)
 	UnitID domain.UnitID
-	Value  int // 0-100
-	Speed  int // Vitesse de remplissage (basée sur SPD stat)
-	Active bool
+	Type   ForecastType
+	Label  string // Libellé de l'action chargée (vide pour un tour)
+	Ticks  int    // Ticks ATB (ou rounds/phases écoulés) avant l'entrée
 }
 
 // NewATBSystem crée un nouveau système ATB
 func NewATBSystem() *ATBSystem {
 	return &ATBSystem{
-		gauges: make(map[domain.UnitID]*ATBGauge),
+		gauges:  make(map[domain.UnitID]*ATBGauge),
+		charges: make(map[domain.UnitID]*ATBCharge),
+	}
+}
+
+// CalculateATBSpeed calcule la vitesse de remplissage ATB basée sur la SPD effective
+// Hâte et Lenteur mettent la vitesse à l'échelle, Stop la gèle (0)
+func CalculateATBSpeed(unite *domain.Unite) int {
+	if unite.EstStop() {
+		return 0
+	}
+
+	// Formule: Speed = SPD / 10 (min 1, max 10)
+	speed := unite.SPDEffective() / 10
+
+	if speed < 1 {
+		speed = 1
+	}
+	if speed > 10 {
+		speed = 10
+	}
+
+	if unite.EstHate() {
+		speed = speed * domain.PourcentageVitesseHate / 100
 	}
+	if unite.EstRalentie() {
+		speed = speed * domain.PourcentageVitesseLenteur / 100
+	}
+	if speed < 1 {
+		speed = 1
+	}
+
+	return speed
+}
+
+// Mode retourne le mode de tour implémenté (TurnScheduler)
+func (atb *ATBSystem) Mode() domain.ModeTour {
+	return domain.ModeTourATB
+}
+
+// InitializeUnit enregistre une unité dans l'ordonnanceur (TurnScheduler)
+func (atb *ATBSystem) InitializeUnit(unite *domain.Unite) {
+	atb.InitializeUnitGauge(unite)
+}
+
+// AdvanceUntilReady fait progresser les jauges jusqu'à ce qu'une unité soit prête
+// ou qu'une action chargée arrive à terme (TurnScheduler)
+// Retourne une erreur si plus rien ne progresse (unités mortes ou sous Stop) ou après limiteTicksATB ticks
+func (atb *ATBSystem) AdvanceUntilReady() error {
+	for ticks := 0; ticks < limiteTicksATB; ticks++ {
+		if _, ok := atb.NextReadyUnit(); ok {
+			return nil
+		}
+		if len(atb.GetResolvedCharges()) > 0 {
+			return nil
+		}
+		if !atb.canProgress() {
+			return fmt.Errorf("aucune jauge ATB ne progresse")
+		}
+		atb.Tick()
+	}
+	return fmt.Errorf("aucune unité prête après %d ticks ATB", limiteTicksATB)
+}
+
+// EndTurn remet la jauge de l'unité à 0 (TurnScheduler)
+func (atb *ATBSystem) EndTurn(unitID domain.UnitID) {
+	atb.ResetGauge(unitID)
+}
+
+// RetainGauge rend à l'unité une part de sa jauge en fin de tour (TurnScheduler)
+// La jauge, remise à 0 au début du tour, repart de percent (0-100)
+func (atb *ATBSystem) RetainGauge(unitID domain.UnitID, percent int) {
+	gauge, exists := atb.gauges[unitID]
+	if !exists {
+		return
+	}
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	gauge.Value = percent
+	gauge.Overflow = 0
+}
+
+// SetForecastRetention fixe la part de jauge conservée par les tours simulés de la prévision
+// (ReglesReinitialisationATB.ConservationComplete: chaque unité est supposée se déplacer et agir)
+func (atb *ATBSystem) SetForecastRetention(percent int) {
+	atb.forecastRetention = percent
+}
+
+// DeactivateUnit désactive la jauge d'une unité (TurnScheduler)
+func (atb *ATBSystem) DeactivateUnit(unitID domain.UnitID) {
+	atb.DeactivateGauge(unitID)
 }
 
 // InitializeGauge initialise la jauge d'une unité
@@ -35,41 +168,102 @@ func (atb *ATBSystem) InitializeGauge(unitID domain.UnitID, speed int) {
 	}
 }
 
-// Tick fait progresser toutes les jauges actives
+// InitializeUnitGauge initialise la jauge d'une unité à partir de sa SPD
+func (atb *ATBSystem) InitializeUnitGauge(unite *domain.Unite) {
+	atb.InitializeGauge(unite.ID(), 0)
+	atb.SyncUnitSpeed(unite)
+}
+
+// SyncUnitSpeed recalcule la vitesse de remplissage d'une unité (SPD effective, Hâte, Lenteur, Stop)
+func (atb *ATBSystem) SyncUnitSpeed(unite *domain.Unite) {
+	gauge, exists := atb.gauges[unite.ID()]
+	if !exists {
+		return
+	}
+	gauge.Speed = CalculateATBSpeed(unite)
+	gauge.SPD = unite.SPDEffective()
+	gauge.Frozen = unite.EstStop()
+}
+
+// SyncSpeeds recalcule la vitesse de remplissage de toutes les unités du combat
+func (atb *ATBSystem) SyncSpeeds(combat *domain.Combat) {
+	for _, equipe := range combat.Equipes() {
+		for _, unite := range equipe.Membres() {
+			atb.SyncUnitSpeed(unite)
+		}
+	}
+}
+
+// Tick fait progresser toutes les jauges actives et les actions chargées
 func (atb *ATBSystem) Tick() {
 	for _, gauge := range atb.gauges {
-		if gauge.Active && gauge.Value < 100 {
+		if gauge.Active && !gauge.Frozen && gauge.Value < 100 {
 			gauge.Value += gauge.Speed
 			if gauge.Value > 100 {
+				gauge.Overflow = gauge.Value - 100
 				gauge.Value = 100
 			}
 		}
 	}
+
+	for _, charge := range atb.charges {
+		if charge.Remaining > 0 && !atb.isFrozen(charge.UnitID) {
+			charge.Remaining--
+		}
+	}
 }
 
-// GetReadyUnits retourne les unités avec ATB >= 100
+// GetReadyUnits retourne les unités avec ATB >= 100, dans l'ordre d'initiative
+// Départage: SPD décroissante, puis excédent décroissant, puis ID d'unité
 func (atb *ATBSystem) GetReadyUnits() []domain.UnitID {
-	ready := make([]domain.UnitID, 0)
+	ready := make([]*ATBGauge, 0)
 	for _, gauge := range atb.gauges {
-		if gauge.Active && gauge.Value >= 100 {
-			ready = append(ready, gauge.UnitID)
+		if gauge.Active && !gauge.Frozen && gauge.Value >= 100 {
+			ready = append(ready, gauge)
 		}
 	}
-	return ready
+
+	sort.Slice(ready, func(i, j int) bool {
+		a, b := ready[i], ready[j]
+		if a.SPD != b.SPD {
+			return a.SPD > b.SPD
+		}
+		if a.Overflow != b.Overflow {
+			return a.Overflow > b.Overflow
+		}
+		return a.UnitID < b.UnitID
+	})
+
+	ids := make([]domain.UnitID, len(ready))
+	for i, gauge := range ready {
+		ids[i] = gauge.UnitID
+	}
+	return ids
+}
+
+// NextReadyUnit retourne la prochaine unité prête selon l'ordre d'initiative
+func (atb *ATBSystem) NextReadyUnit() (domain.UnitID, bool) {
+	ready := atb.GetReadyUnits()
+	if len(ready) == 0 {
+		return "", false
+	}
+	return ready[0], true
 }
 
 // ResetGauge remet la jauge d'une unité à 0
 func (atb *ATBSystem) ResetGauge(unitID domain.UnitID) {
 	if gauge, exists := atb.gauges[unitID]; exists {
 		gauge.Value = 0
+		gauge.Overflow = 0
 	}
 }
 
-// DeactivateGauge désactive la jauge d'une unité (morte, KO)
+// DeactivateGauge désactive la jauge d'une unité définitivement morte
 func (atb *ATBSystem) DeactivateGauge(unitID domain.UnitID) {
 	if gauge, exists := atb.gauges[unitID]; exists {
 		gauge.Active = false
 	}
+	delete(atb.charges, unitID)
 }
 
 // GetGaugeValue retourne la valeur actuelle de la jauge
@@ -79,3 +273,197 @@ func (atb *ATBSystem) GetGaugeValue(unitID domain.UnitID) int {
 	}
 	return 0
 }
+
+// StartCharge démarre le chargement d'une action qui se résoudra après N ticks
+func (atb *ATBSystem) StartCharge(unitID domain.UnitID, label string, ticks int) {
+	if ticks < 0 {
+		ticks = 0
+	}
+	atb.charges[unitID] = &ATBCharge{
+		UnitID:    unitID,
+		Label:     label,
+		Remaining: ticks,
+	}
+}
+
+// StartSkillCharge démarre l'incantation d'une compétence, résolue après son temps d'incantation
+func (atb *ATBSystem) StartSkillCharge(unitID domain.UnitID, competence *domain.Competence, targets []domain.UnitID) {
+	atb.StartCharge(unitID, competence.Nom(), competence.TempsIncantation())
+	charge := atb.charges[unitID]
+	charge.SkillID = competence.ID()
+	charge.Targets = append([]domain.UnitID(nil), targets...)
+}
+
+// CancelCharge annule l'action en cours de chargement d'une unité
+func (atb *ATBSystem) CancelCharge(unitID domain.UnitID) {
+	delete(atb.charges, unitID)
+}
+
+// GetResolvedCharges retourne les actions chargées arrivées à terme, triées par ID d'unité
+func (atb *ATBSystem) GetResolvedCharges() []*ATBCharge {
+	resolved := make([]*ATBCharge, 0)
+	for _, charge := range atb.charges {
+		if charge.Remaining == 0 {
+			resolved = append(resolved, charge)
+		}
+	}
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].UnitID < resolved[j].UnitID
+	})
+	return resolved
+}
+
+// Clone retourne une copie indépendante du système ATB
+func (atb *ATBSystem) Clone() *ATBSystem {
+	clone := NewATBSystem()
+	clone.forecastRetention = atb.forecastRetention
+	for id, gauge := range atb.gauges {
+		copie := *gauge
+		clone.gauges[id] = &copie
+	}
+	for id, charge := range atb.charges {
+		copie := *charge
+		copie.Targets = append([]domain.UnitID(nil), charge.Targets...)
+		clone.charges[id] = &copie
+	}
+	return clone
+}
+
+// Forecast simule les N prochaines entrées de l'ordre des tours sans modifier l'état
+// Reproduit la boucle WaitingATB → TurnBegin → TurnEnd: résolution des actions chargées
+// arrivées à terme, puis tour de la prochaine unité prête, sinon un tick de plus
+func (atb *ATBSystem) Forecast(n int) []TurnForecast {
+	forecast := make([]TurnForecast, 0, n)
+	sim := atb.Clone()
+
+	ticks := 0
+	for len(forecast) < n {
+		for _, charge := range sim.GetResolvedCharges() {
+			if len(forecast) == n {
+				break
+			}
+			forecast = append(forecast, TurnForecast{
+				Rank:   len(forecast) + 1,
+				UnitID: charge.UnitID,
+				Type:   ForecastCharge,
+				Label:  charge.Label,
+				Ticks:  ticks,
+			})
+			sim.CancelCharge(charge.UnitID)
+		}
+
+		if unitID, ok := sim.NextReadyUnit(); ok {
+			if len(forecast) < n {
+				forecast = append(forecast, TurnForecast{
+					Rank:   len(forecast) + 1,
+					UnitID: unitID,
+					Type:   ForecastTurn,
+					Ticks:  ticks,
+				})
+			}
+			sim.ResetGauge(unitID)
+			sim.RetainGauge(unitID, sim.forecastRetention)
+			continue
+		}
+
+		if !sim.canProgress() {
+			break
+		}
+		sim.Tick()
+		ticks++
+	}
+
+	return forecast
+}
+
+// CanProgress indique si les jauges peuvent encore produire un tour
+// (fausse si toutes les unités sont mortes ou figées)
+func (atb *ATBSystem) CanProgress() bool {
+	return atb.canProgress()
+}
+
+// Snapshot retourne l'état sérialisable des jauges, trié par ID d'unité
+func (atb *ATBSystem) Snapshot() []domain.JaugeATB {
+	jauges := make([]domain.JaugeATB, 0, len(atb.gauges))
+	for _, gauge := range atb.gauges {
+		jauges = append(jauges, domain.JaugeATB{
+			UniteID:  gauge.UnitID,
+			Valeur:   gauge.Value,
+			Excedent: gauge.Overflow,
+			Vitesse:  gauge.Speed,
+			SPD:      gauge.SPD,
+			Active:   gauge.Active,
+			Gelee:    gauge.Frozen,
+		})
+	}
+	sort.Slice(jauges, func(i, j int) bool { return jauges[i].UniteID < jauges[j].UniteID })
+	return jauges
+}
+
+// Restore remplace les jauges par un état enregistré (voir Snapshot)
+func (atb *ATBSystem) Restore(jauges []domain.JaugeATB) {
+	atb.gauges = make(map[domain.UnitID]*ATBGauge, len(jauges))
+	for _, jauge := range jauges {
+		atb.gauges[jauge.UniteID] = &ATBGauge{
+			UnitID:   jauge.UniteID,
+			Value:    jauge.Valeur,
+			Overflow: jauge.Excedent,
+			Speed:    jauge.Vitesse,
+			SPD:      jauge.SPD,
+			Active:   jauge.Active,
+			Frozen:   jauge.Gelee,
+		}
+	}
+}
+
+// SnapshotCharges retourne l'état sérialisable des actions chargées, trié par ID d'unité
+func (atb *ATBSystem) SnapshotCharges() []domain.ChargeATB {
+	charges := make([]domain.ChargeATB, 0, len(atb.charges))
+	for _, charge := range atb.charges {
+		charges = append(charges, domain.ChargeATB{
+			UniteID:      charge.UnitID,
+			CompetenceID: charge.SkillID,
+			Libelle:      charge.Label,
+			Cibles:       append([]domain.UnitID(nil), charge.Targets...),
+			Restant:      charge.Remaining,
+		})
+	}
+	sort.Slice(charges, func(i, j int) bool { return charges[i].UniteID < charges[j].UniteID })
+	return charges
+}
+
+// RestoreCharges remplace les actions chargées par un état enregistré (voir SnapshotCharges)
+func (atb *ATBSystem) RestoreCharges(charges []domain.ChargeATB) {
+	atb.charges = make(map[domain.UnitID]*ATBCharge, len(charges))
+	for _, charge := range charges {
+		atb.charges[charge.UniteID] = &ATBCharge{
+			UnitID:    charge.UniteID,
+			SkillID:   charge.CompetenceID,
+			Targets:   append([]domain.UnitID(nil), charge.Cibles...),
+			Label:     charge.Libelle,
+			Remaining: charge.Restant,
+		}
+	}
+}
+
+// isFrozen indique si la jauge de l'unité est gelée (Stop): son incantation est suspendue
+func (atb *ATBSystem) isFrozen(unitID domain.UnitID) bool {
+	gauge, exists := atb.gauges[unitID]
+	return exists && gauge.Frozen
+}
+
+// canProgress indique si un tick peut encore produire un tour ou une résolution
+// Une action chargée arrivée à terme ou suspendue par Stop ne progresse plus
+func (atb *ATBSystem) canProgress() bool {
+	for _, charge := range atb.charges {
+		if charge.Remaining > 0 && !atb.isFrozen(charge.UnitID) {
+			return true
+		}
+	}
+	for _, gauge := range atb.gauges {
+		if gauge.Active && !gauge.Frozen && gauge.Speed > 0 {
+			return true
+		}
+	}
+	return false
+}
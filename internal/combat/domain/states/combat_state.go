@@ -2,6 +2,7 @@ package states
 
 import (
 	"fmt"
+	"time"
 
 	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
 )
@@ -93,6 +94,11 @@ const (
 	// Événements d'erreur
 	EventCriticalError EventType = "CRITICAL_ERROR"
 	EventErrorHandled  EventType = "ERROR_HANDLED"
+
+	// Événements d'arbitrage (MJ)
+	EventPause  EventType = "PAUSE"
+	EventResume EventType = "RESUME"
+	EventCancel EventType = "CANCEL"
 )
 
 // CombatContext contient toutes les données nécessaires pour les états
@@ -113,11 +119,25 @@ type CombatContext struct {
 	PendingResult   interface{} // *commands.CommandResult
 	ValidationError error
 
-	// ATB System
+	// ATB System (jauges, actions chargées)
 	ATBSystem *ATBSystem
 
+	// Ordonnanceur des tours selon le mode du combat (ATBSystem en mode ATB)
+	Scheduler TurnScheduler
+
 	// Observers (sera complété avec le Observer Pattern)
 	Observers []interface{}
+
+	// Horloge des échéances de tour (time.Now si nil)
+	Clock func() time.Time
+}
+
+// Now retourne l'heure courante selon l'horloge du contexte
+func (ctx *CombatContext) Now() time.Time {
+	if ctx.Clock != nil {
+		return ctx.Clock()
+	}
+	return time.Now()
 }
 
 // StateTransition représente une transition d'état pour l'historique
@@ -141,13 +161,18 @@ func NewCombatStateMachine(combat *domain.Combat) *CombatStateMachine {
 		StateHistory: make([]StateTransition, 0),
 		ATBSystem:    NewATBSystem(),
 	}
+	mode := domain.ModeTourATB
+	if combat != nil {
+		mode = combat.ModeTour()
+	}
+	ctx.Scheduler = NewTurnScheduler(mode, ctx.ATBSystem)
 
 	sm := &CombatStateMachine{
 		context: ctx,
 	}
 
-	// État initial : Idle
-	sm.TransitionTo(&IdleState{})
+	// État initial : Idle (sans restriction de transition, les tests positionnent la machine directement)
+	sm.TransitionTo(&IdleState{BaseState: BaseState{name: "Idle"}})
 
 	return sm
 }
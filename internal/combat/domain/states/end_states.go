@@ -2,6 +2,8 @@ package states
 
 import (
 	"fmt"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
 )
 
 // CheckVictoryState vérifie les conditions de victoire/défaite
@@ -12,13 +14,7 @@ type CheckVictoryState struct {
 // NewCheckVictoryState crée un nouvel état CheckVictory
 func NewCheckVictoryState() *CheckVictoryState {
 	return &CheckVictoryState{
-		BaseState: BaseState{
-			name: "CheckVictory",
-			allowedTransitions: map[string]bool{
-				"TurnEnd":     true,
-				"BattleEnded": true,
-			},
-		},
+		BaseState: newBaseState("CheckVictory"),
 	}
 }
 
@@ -75,12 +71,7 @@ type TurnEndState struct {
 // NewTurnEndState crée un nouvel état TurnEnd
 func NewTurnEndState() *TurnEndState {
 	return &TurnEndState{
-		BaseState: BaseState{
-			name: "TurnEnd",
-			allowedTransitions: map[string]bool{
-				"WaitingATB": true,
-			},
-		},
+		BaseState: newBaseState("TurnEnd"),
 	}
 }
 
@@ -93,6 +84,13 @@ func (s *TurnEndState) Enter(ctx *CombatContext) error {
 	ctx.PendingResult = nil
 	ctx.ValidationError = nil
 
+	// Rendre à l'unité la part de jauge conservée selon son activité (attendre fait rejouer plus tôt)
+	if activite := ctx.Combat.ActiviteTour(); activite != nil {
+		conservation := ctx.Combat.ConclureActiviteTour(activite.UniteID)
+		ctx.Scheduler.RetainGauge(activite.UniteID, conservation)
+		enregistrerJauges(ctx)
+	}
+
 	// Notifier la fin du tour
 	fmt.Printf("[State] Notification: TurnEnd\n")
 
@@ -125,12 +123,7 @@ type WaitingATBState struct {
 // NewWaitingATBState crée un nouvel état WaitingATB
 func NewWaitingATBState() *WaitingATBState {
 	return &WaitingATBState{
-		BaseState: BaseState{
-			name: "WaitingATB",
-			allowedTransitions: map[string]bool{
-				"TurnBegin": true,
-			},
-		},
+		BaseState: newBaseState("WaitingATB"),
 	}
 }
 
@@ -138,16 +131,29 @@ func NewWaitingATBState() *WaitingATBState {
 func (s *WaitingATBState) Enter(ctx *CombatContext) error {
 	fmt.Printf("[State] Entrée dans état: %s\n", s.Name())
 
-	// Faire progresser les jauges jusqu'à ce qu'une unité soit prête
-	for {
-		ctx.ATBSystem.Tick()
+	// Les statuts ont pu changer pendant le tour précédent (Paralysie, Hâte, Lenteur, Stop)
+	ctx.Scheduler.SyncSpeeds(ctx.Combat)
 
-		readyUnits := ctx.ATBSystem.GetReadyUnits()
-		if len(readyUnits) > 0 {
-			// Une unité est prête
-			fmt.Printf("[State] Unité prête: %s\n", readyUnits[0])
+	// Faire avancer le temps (jauges ATB, rounds ou phases) jusqu'à ce qu'une unité soit prête
+	// En mode ATB, les incantations arrivées à terme en chemin sont résolues; si l'une d'elles
+	// termine le combat, la machine enchaîne sur EventVictoryOrDefeat
+	for {
+		if err := ctx.Scheduler.AdvanceUntilReady(); err != nil {
+			return err
+		}
+		atb, ok := ctx.Scheduler.(*ATBSystem)
+		if !ok || len(atb.GetResolvedCharges()) == 0 {
 			break
 		}
+		resoudreIncantations(ctx, atb)
+		if ctx.Combat.VerifierConditionsVictoire() != "CONTINUE" {
+			return nil
+		}
+		ctx.Combat.DebuterAction()
+		atb.SyncSpeeds(ctx.Combat)
+	}
+	if unitID, ok := ctx.Scheduler.NextReadyUnit(); ok {
+		fmt.Printf("[State] Unité prête: %s\n", unitID)
 	}
 
 	return nil
@@ -166,6 +172,10 @@ func (s *WaitingATBState) Handle(ctx *CombatContext, event StateEvent) (CombatSt
 		// Une unité est prête, commencer son tour
 		return NewTurnBeginState(), nil
 
+	case EventVictoryOrDefeat:
+		// Une incantation résolue a terminé le combat
+		return NewBattleEndedState(), nil
+
 	default:
 		return nil, fmt.Errorf("événement %s non géré dans l'état %s", event.Type, s.Name())
 	}
@@ -179,12 +189,7 @@ type BattleEndedState struct {
 // NewBattleEndedState crée un nouvel état BattleEnded
 func NewBattleEndedState() *BattleEndedState {
 	return &BattleEndedState{
-		BaseState: BaseState{
-			name: "BattleEnded",
-			allowedTransitions: map[string]bool{
-				"Finalizing": true,
-			},
-		},
+		BaseState: newBaseState("BattleEnded"),
 	}
 }
 
@@ -196,6 +201,13 @@ func (s *BattleEndedState) Enter(ctx *CombatContext) error {
 	result := ctx.Combat.ObtenirResultat()
 	fmt.Printf("[State] Résultat du combat: %s\n", result)
 
+	// Enregistrer la fin du combat et son vainqueur dans le flux d'événements
+	if ctx.Combat.Etat() == domain.EtatEnCours {
+		if err := ctx.Combat.Terminer(); err != nil {
+			return err
+		}
+	}
+
 	// Notifier les observateurs
 	fmt.Printf("[State] Notification: BattleEnded\n")
 
@@ -228,12 +240,7 @@ type FinalizingState struct {
 // NewFinalizingState crée un nouvel état Finalizing
 func NewFinalizingState() *FinalizingState {
 	return &FinalizingState{
-		BaseState: BaseState{
-			name:               "Finalizing",
-			allowedTransitions: map[string]bool{
-				// État terminal
-			},
-		},
+		BaseState: newBaseState("Finalizing"),
 	}
 }
 
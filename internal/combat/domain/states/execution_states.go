@@ -14,13 +14,7 @@ type ExecutingState struct {
 // NewExecutingState crée un nouvel état Executing
 func NewExecutingState() *ExecutingState {
 	return &ExecutingState{
-		BaseState: BaseState{
-			name: "Executing",
-			allowedTransitions: map[string]bool{
-				"ApplyingEffects": true,
-				"ExecutionFailed": true,
-			},
-		},
+		BaseState: newBaseState("Executing"),
 	}
 }
 
@@ -38,11 +32,21 @@ func (s *ExecutingState) Enter(ctx *CombatContext) error {
 		return fmt.Errorf("type de commande invalide")
 	}
 
+	// En mode ATB, une compétence à temps d'incantation est chargée: elle se résout
+	// quand la charge arrive à terme (WaitingATB)
+	ctx.ValidationError = nil
+	if result, charge := demarrerIncantation(ctx, cmd); charge {
+		ctx.PendingResult = result
+		fmt.Printf("[State] %s\n", result.Message)
+		return nil
+	}
+
 	// Exécuter la commande
+	// Un échec est stocké, la machine enchaîne sur EventExecutionError (rollback)
 	result, err := cmd.Execute()
 	if err != nil {
 		ctx.ValidationError = err
-		return err
+		return nil
 	}
 
 	// Stocker le résultat pour l'état suivant
@@ -83,12 +87,7 @@ type ExecutionFailedState struct {
 // NewExecutionFailedState crée un nouvel état ExecutionFailed
 func NewExecutionFailedState() *ExecutionFailedState {
 	return &ExecutionFailedState{
-		BaseState: BaseState{
-			name: "ExecutionFailed",
-			allowedTransitions: map[string]bool{
-				"ActionSelection": true, // Retourner à la sélection
-			},
-		},
+		BaseState: newBaseState("ExecutionFailed"),
 	}
 }
 
@@ -146,12 +145,7 @@ type ApplyingEffectsState struct {
 // NewApplyingEffectsState crée un nouvel état ApplyingEffects
 func NewApplyingEffectsState() *ApplyingEffectsState {
 	return &ApplyingEffectsState{
-		BaseState: BaseState{
-			name: "ApplyingEffects",
-			allowedTransitions: map[string]bool{
-				"CheckVictory": true,
-			},
-		},
+		BaseState: newBaseState("ApplyingEffects"),
 	}
 }
 
@@ -169,8 +163,11 @@ func (s *ApplyingEffectsState) Enter(ctx *CombatContext) error {
 		return fmt.Errorf("type de résultat invalide")
 	}
 
-	// Les effets sont déjà appliqués dans Execute()
-	// Ici on peut ajouter des effets secondaires, animations, etc.
+	// Les effets sont déjà appliqués (et leurs événements levés) dans Execute()
+	// L'action jouée est enregistrée dans le flux d'événements
+	if cmd, ok := ctx.PendingCommand.(commands.Command); ok {
+		ctx.Combat.RaiseEvent(commands.NewActionExecutedEvent(ctx.Combat, cmd, result))
+	}
 
 	// Les effets sont appliqués
 	fmt.Printf("[State] Effets appliqués: %s\n", result.Message)
@@ -0,0 +1,180 @@
+package states
+
+import (
+	"fmt"
+	"sort"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// actionImposee décrit l'action imposée à une unité par un statut de comportement
+// Elle remplace la commande du joueur (ou de l'IA) dans ActionSelectionState
+type actionImposee struct {
+	statut   shared.TypeStatut
+	commande commands.Command
+	cible    *domain.Unite
+	raison   string
+}
+
+// choisirActionImposee retourne l'action imposée par les statuts de l'unité (nil si elle agit librement)
+// Priorité: Sommeil, Charme, Confusion, Berserk
+func choisirActionImposee(ctx *CombatContext, unite *domain.Unite) *actionImposee {
+	switch {
+	case unite.EstEndormie():
+		return &actionImposee{
+			statut:   shared.TypeStatutSommeil,
+			commande: commands.NewWaitCommand(unite, ctx.Combat),
+			raison:   "endormie, passe son tour",
+		}
+
+	case unite.EstCharmee():
+		cible := ctx.Combat.ChoisirCibleIA(unite)
+		return newActionImposee(ctx, unite, shared.TypeStatutCharme, cible,
+			fmt.Sprintf("charmée, combat pour l'équipe %s", ctx.Combat.EquipeEffective(unite)))
+
+	case unite.EstConfuse():
+		return actionConfuse(ctx, unite)
+
+	case unite.EstBerserk():
+		cible := ctx.Combat.EnnemiLePlusProche(unite)
+		return newActionImposee(ctx, unite, shared.TypeStatutBerserk, cible, "berserk, attaque l'ennemi le plus proche")
+	}
+
+	return nil
+}
+
+// actionsConfusion sont les types d'action tirés au hasard pour une unité confuse
+var actionsConfusion = []commands.CommandType{commands.CommandTypeAttack, commands.CommandTypeMove, commands.CommandTypeWait}
+
+// actionConfuse tire au hasard le type d'action puis sa cible (générateur du combat, rejouable):
+// attaque d'une unité au contact (alliées comprises), déplacement d'une case ou attente
+// Une action tirée mais impossible (personne au contact, cases bloquées) devient une attente
+func actionConfuse(ctx *CombatContext, unite *domain.Unite) *actionImposee {
+	imposee := &actionImposee{
+		statut: shared.TypeStatutConfusion,
+		raison: "confuse, action et cible tirées au hasard",
+	}
+
+	switch actionsConfusion[ctx.Combat.TirerAleatoire(len(actionsConfusion))] {
+	case commands.CommandTypeAttack:
+		candidats := make([]*domain.Unite, 0)
+		for _, autre := range ctx.Combat.ObtenirUnitesDansZone(unite.Position(), domain.PorteeAttaqueMelee) {
+			if autre.ID() != unite.ID() && !autre.EstEliminee() {
+				candidats = append(candidats, autre)
+			}
+		}
+		if len(candidats) > 0 {
+			cible := candidats[ctx.Combat.TirerAleatoire(len(candidats))]
+			if attaque := commands.NewAttackCommand(unite, ctx.Combat, cible); attaque.Validate() == nil {
+				imposee.commande, imposee.cible = attaque, cible
+				return imposee
+			}
+		}
+
+	case commands.CommandTypeMove:
+		cases := ctx.Combat.Grille().PositionsAdjacentes(unite.Position())
+		if len(cases) > 0 {
+			destination := cases[ctx.Combat.TirerAleatoire(len(cases))]
+			if deplacement := commands.NewMoveCommand(unite, ctx.Combat, destination); deplacement.Validate() == nil {
+				imposee.commande = deplacement
+				return imposee
+			}
+		}
+
+	case commands.CommandTypeWait:
+		imposee.commande = commands.NewWaitCommand(unite, ctx.Combat)
+		return imposee
+	}
+
+	imposee.commande = commands.NewWaitCommand(unite, ctx.Combat)
+	imposee.raison += " (action tirée impossible, attend)"
+	return imposee
+}
+
+// newActionImposee construit une attaque sur la cible; hors de portée, l'unité se rapproche
+// (puis attaque si elle arrive au contact), sinon elle attend
+func newActionImposee(ctx *CombatContext, unite *domain.Unite, statut shared.TypeStatut, cible *domain.Unite, raison string) *actionImposee {
+	commande := attaqueOuApproche(ctx, unite, cible)
+	switch commande.GetType() {
+	case commands.CommandTypeWait:
+		cible = nil
+		raison += " (aucune attaque possible, attend)"
+	case commands.CommandTypeMove:
+		raison += fmt.Sprintf(" (se rapproche de %s)", cible.Nom())
+	case commands.CommandTypeComposite:
+		raison += fmt.Sprintf(" (se rapproche de %s puis attaque)", cible.Nom())
+	}
+	return &actionImposee{
+		statut:   statut,
+		commande: commande,
+		cible:    cible,
+		raison:   raison,
+	}
+}
+
+// attaqueOuAttente retourne une attaque valide sur la cible, ou une attente
+func attaqueOuAttente(ctx *CombatContext, unite *domain.Unite, cible *domain.Unite) commands.Command {
+	if cible != nil {
+		attaque := commands.NewAttackCommand(unite, ctx.Combat, cible)
+		if err := attaque.Validate(); err == nil {
+			return attaque
+		}
+	}
+	return commands.NewWaitCommand(unite, ctx.Combat)
+}
+
+// attaqueOuApproche retourne une attaque valide sur la cible; hors de portée, le déplacement
+// vers la case atteignable la plus proche de la cible, suivi de l'attaque si elle devient possible
+// (commande composite). Attend si la cible est absente ou inapprochable
+func attaqueOuApproche(ctx *CombatContext, unite *domain.Unite, cible *domain.Unite) commands.Command {
+	commande := attaqueOuAttente(ctx, unite, cible)
+	if cible == nil || commande.GetType() != commands.CommandTypeWait {
+		return commande
+	}
+
+	for _, destination := range casesDApproche(ctx, unite, cible) {
+		deplacement := commands.NewMoveCommand(unite, ctx.Combat, destination)
+		if deplacement.Validate() != nil {
+			continue
+		}
+		suite := commands.NewCompositeCommand(unite, ctx.Combat, deplacement, commands.NewAttackCommand(unite, ctx.Combat, cible))
+		if suite.Validate() == nil {
+			return suite
+		}
+		return deplacement
+	}
+	return commande
+}
+
+// casesDApproche retourne les cases à portée de déplacement plus proches de la cible que l'unité,
+// de la plus proche de la cible à la plus lointaine (égalité: la moins éloignée de l'unité)
+func casesDApproche(ctx *CombatContext, unite *domain.Unite, cible *domain.Unite) []*shared.Position {
+	depart := unite.Position()
+	distance := depart.Distance(cible.Position())
+
+	cases := make([]*shared.Position, 0)
+	for _, position := range ctx.Combat.Grille().PositionsADansPortee(depart, unite.PorteeDeplacement()) {
+		if d := position.Distance(cible.Position()); d > 0 && d < distance {
+			cases = append(cases, position)
+		}
+	}
+	sort.SliceStable(cases, func(i, j int) bool {
+		di, dj := cases[i].Distance(cible.Position()), cases[j].Distance(cible.Position())
+		if di != dj {
+			return di < dj
+		}
+		return depart.Distance(cases[i]) < depart.Distance(cases[j])
+	})
+	return cases
+}
+
+// event construit l'événement de domaine traçant l'action imposée
+func (a *actionImposee) event(ctx *CombatContext, unite *domain.Unite) *domain.ActionImposeeEvent {
+	var cibleID domain.UnitID
+	if a.cible != nil {
+		cibleID = a.cible.ID()
+	}
+	return domain.NewActionImposeeEvent(ctx.Combat.ID(), ctx.Combat.TourActuel(), unite.ID(), a.statut, string(a.commande.GetType()), cibleID, a.raison)
+}
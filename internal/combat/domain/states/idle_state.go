@@ -15,12 +15,7 @@ func init() {
 // NewIdleState crée un nouvel état Idle
 func NewIdleState() *IdleState {
 	return &IdleState{
-		BaseState: BaseState{
-			name: "Idle",
-			allowedTransitions: map[string]bool{
-				"Initializing": true,
-			},
-		},
+		BaseState: newBaseState("Idle"),
 	}
 }
 
@@ -47,6 +42,10 @@ func (s *IdleState) Handle(ctx *CombatContext, event StateEvent) (CombatState, e
 		// Transition vers Initializing
 		return NewInitializingState(), nil
 
+	case EventCancel:
+		// Combat annulé avant d'avoir commencé
+		return NewCancelledState(), nil
+
 	default:
 		return nil, fmt.Errorf("événement %s non géré dans l'état %s", event.Type, s.Name())
 	}
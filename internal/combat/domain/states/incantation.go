@@ -0,0 +1,88 @@
+package states
+
+import (
+	"fmt"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+)
+
+// demarrerIncantation charge une compétence à temps d'incantation au lieu de la résoudre (mode ATB)
+// Retourne false si la commande se résout immédiatement: autre commande, incantation nulle ou hors ATB
+func demarrerIncantation(ctx *CombatContext, cmd commands.Command) (*commands.CommandResult, bool) {
+	skill, ok := cmd.(*commands.SkillCommand)
+	if !ok || skill.GetSkill().TempsIncantation() <= 0 {
+		return nil, false
+	}
+	atb, ok := ctx.Scheduler.(*ATBSystem)
+	if !ok {
+		return nil, false
+	}
+
+	lanceur := skill.GetActor()
+	competence := skill.GetSkill()
+	cibles := make([]domain.UnitID, 0, len(skill.GetTargets()))
+	for _, cible := range skill.GetTargets() {
+		cibles = append(cibles, cible.ID())
+	}
+
+	atb.StartSkillCharge(lanceur.ID(), competence, cibles)
+	ctx.Combat.DemarrerIncantation(lanceur.ID(), competence.ID(), cibles, competence.TempsIncantation())
+
+	return &commands.CommandResult{
+		Success: true,
+		Message: fmt.Sprintf("%s commence à incanter %s (%d ticks)", lanceur.Nom(), competence.Nom(), competence.TempsIncantation()),
+		Effects: make([]commands.CommandEffect, 0),
+	}, true
+}
+
+// resoudreIncantations résout les actions chargées arrivées à terme, dans l'ordre des IDs d'unités
+// Chaque résolution ouvre sa propre corrélation; une incantation qui ne passe plus la validation
+// (lanceur hors d'état, cible éliminée ou hors de portée...) est interrompue sans effet
+func resoudreIncantations(ctx *CombatContext, atb *ATBSystem) {
+	for _, charge := range atb.GetResolvedCharges() {
+		atb.CancelCharge(charge.UnitID)
+		ctx.Combat.DebuterAction()
+		resoudreIncantation(ctx, charge)
+	}
+}
+
+// resoudreIncantation valide puis exécute la compétence d'une action chargée
+func resoudreIncantation(ctx *CombatContext, charge *ATBCharge) {
+	lanceur := ctx.Combat.TrouverUnite(charge.UnitID)
+	if lanceur == nil || charge.SkillID == "" {
+		return
+	}
+	competence := lanceur.ObtenirCompetence(charge.SkillID)
+	if competence == nil {
+		ctx.Combat.InterrompreIncantation(lanceur.ID(), charge.SkillID, "compétence inconnue du lanceur")
+		return
+	}
+
+	cibles := make([]*domain.Unite, 0, len(charge.Targets))
+	for _, cibleID := range charge.Targets {
+		if cible := ctx.Combat.TrouverUnite(cibleID); cible != nil {
+			cibles = append(cibles, cible)
+		}
+	}
+
+	cmd := commands.NewSkillCommand(lanceur, ctx.Combat, competence, cibles)
+	cmd.MarkChargeResolution()
+
+	if err := validerCommande(ctx, cmd); err != nil {
+		fmt.Printf("[State] Incantation de %s interrompue: %v\n", lanceur.Nom(), err)
+		ctx.Combat.InterrompreIncantation(lanceur.ID(), competence.ID(), err.Error())
+		return
+	}
+
+	result, err := cmd.Execute()
+	if err != nil {
+		if rollbackErr := cmd.Rollback(); rollbackErr != nil {
+			fmt.Printf("[State] Erreur lors du rollback: %v\n", rollbackErr)
+		}
+		ctx.Combat.InterrompreIncantation(lanceur.ID(), competence.ID(), err.Error())
+		return
+	}
+	ctx.Combat.RaiseEvent(commands.NewActionExecutedEvent(ctx.Combat, cmd, result))
+	fmt.Printf("[State] Incantation résolue: %s\n", result.Message)
+}
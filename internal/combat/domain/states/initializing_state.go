@@ -18,13 +18,7 @@ type InitializingState struct {
 // NewInitializingState crée un nouvel état Initializing
 func NewInitializingState() *InitializingState {
 	return &InitializingState{
-		BaseState: BaseState{
-			name: "Initializing",
-			allowedTransitions: map[string]bool{
-				"Ready":  true,
-				"Failed": true,
-			},
-		},
+		BaseState: newBaseState("Initializing"),
 	}
 }
 
@@ -98,35 +92,23 @@ func (s *InitializingState) validateTeams(ctx *CombatContext) error {
 	return nil
 }
 
-// initializeATB initialise les jauges ATB de toutes les unités
+// initializeATB enregistre toutes les unités dans l'ordonnanceur des tours
 func (s *InitializingState) initializeATB(ctx *CombatContext) error {
 	equipes := ctx.Combat.Equipes()
 
 	for _, equipe := range equipes {
 		for _, unite := range equipe.Membres() {
-			// Vitesse de remplissage basée sur SPD
-			speed := s.calculateATBSpeed(unite)
-			ctx.ATBSystem.InitializeGauge(unite.ID(), speed)
+			// Jauge ATB (vitesse basée sur SPD) ou place dans le round selon le mode
+			ctx.Scheduler.InitializeUnit(unite)
 		}
 	}
 
-	return nil
-}
-
-// calculateATBSpeed calcule la vitesse de remplissage ATB basée sur SPD
-func (s *InitializingState) calculateATBSpeed(unite *domain.Unite) int {
-	// Formule: Speed = SPD / 10 (min 1, max 10)
-	spd := unite.Stats().SPD
-	speed := spd / 10
-
-	if speed < 1 {
-		speed = 1
-	}
-	if speed > 10 {
-		speed = 10
+	// La prévision suppose que chaque unité se déplace et agit à son tour
+	if atb, ok := ctx.Scheduler.(*ATBSystem); ok {
+		atb.SetForecastRetention(ctx.Combat.ReglesReinitialisationATB().ConservationComplete)
 	}
 
-	return speed
+	return nil
 }
 
 // validateGrid vérifie que la grille de combat est valide
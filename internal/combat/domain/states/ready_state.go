@@ -11,12 +11,7 @@ type ReadyState struct {
 // NewReadyState crée un nouvel état Ready
 func NewReadyState() *ReadyState {
 	return &ReadyState{
-		BaseState: BaseState{
-			name: "Ready",
-			allowedTransitions: map[string]bool{
-				"TurnBegin": true,
-			},
-		},
+		BaseState: newBaseState("Ready"),
 	}
 }
 
@@ -39,7 +34,11 @@ func (s *ReadyState) Exit(ctx *CombatContext) error {
 func (s *ReadyState) Handle(ctx *CombatContext, event StateEvent) (CombatState, error) {
 	switch event.Type {
 	case EventFirstUnitReady:
-		// Une unité est prête, commencer le combat
+		// Faire progresser les jauges ATB jusqu'au premier acteur, puis commencer le combat
+		ctx.Scheduler.SyncSpeeds(ctx.Combat)
+		if err := ctx.Scheduler.AdvanceUntilReady(); err != nil {
+			return nil, err
+		}
 		return NewTurnBeginState(), nil
 
 	default:
@@ -56,12 +55,7 @@ type FailedState struct {
 // NewFailedState crée un nouvel état Failed
 func NewFailedState() *FailedState {
 	return &FailedState{
-		BaseState: BaseState{
-			name:               "Failed",
-			allowedTransitions: map[string]bool{
-				// État terminal, aucune transition autorisée
-			},
-		},
+		BaseState: newBaseState("Failed"),
 	}
 }
 
@@ -0,0 +1,256 @@
+package states
+
+import (
+	"fmt"
+	"sort"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+)
+
+// RoundScheduler ordonnance les tours par groupes d'unités jouant chacune une fois
+// - CTB: un seul groupe, chaque unité joue une fois par round, par SPD décroissante
+// - Phases d'équipes: un groupe par équipe, toute l'équipe joue avant l'équipe suivante
+type RoundScheduler struct {
+	mode    domain.ModeTour
+	units   map[domain.UnitID]*roundEntry
+	current domain.TeamID // Groupe en cours (vide en CTB)
+	started bool
+	elapsed int // Rounds/phases écoulés depuis la création (prévision)
+}
+
+// roundEntry représente une unité dans l'ordonnanceur par rounds
+type roundEntry struct {
+	UnitID domain.UnitID
+	TeamID domain.TeamID
+	SPD    int
+	Active bool
+	Frozen bool // Stop: l'unité passe ses tours jusqu'à expiration
+	Acted  bool // A déjà joué pendant le round/la phase en cours
+}
+
+// NewCTBScheduler crée un ordonnanceur en tourniquet strict par SPD
+func NewCTBScheduler() *RoundScheduler {
+	return &RoundScheduler{
+		mode:  domain.ModeTourCTB,
+		units: make(map[domain.UnitID]*roundEntry),
+	}
+}
+
+// NewTeamPhaseScheduler crée un ordonnanceur à phases d'équipes alternées
+func NewTeamPhaseScheduler() *RoundScheduler {
+	return &RoundScheduler{
+		mode:  domain.ModeTourPhaseEquipes,
+		units: make(map[domain.UnitID]*roundEntry),
+	}
+}
+
+// Mode retourne le mode de tour implémenté
+func (r *RoundScheduler) Mode() domain.ModeTour {
+	return r.mode
+}
+
+// InitializeUnit enregistre une unité dans l'ordonnanceur
+func (r *RoundScheduler) InitializeUnit(unite *domain.Unite) {
+	r.units[unite.ID()] = &roundEntry{
+		UnitID: unite.ID(),
+		TeamID: unite.TeamID(),
+		SPD:    unite.SPDEffective(),
+		Active: true,
+		Frozen: unite.EstStop(),
+	}
+}
+
+// SyncUnitSpeed met à jour la SPD effective et le Stop d'une unité
+func (r *RoundScheduler) SyncUnitSpeed(unite *domain.Unite) {
+	entry, exists := r.units[unite.ID()]
+	if !exists {
+		return
+	}
+	entry.SPD = unite.SPDEffective()
+	entry.Frozen = unite.EstStop()
+}
+
+// SyncSpeeds met à jour toutes les unités du combat
+func (r *RoundScheduler) SyncSpeeds(combat *domain.Combat) {
+	for _, equipe := range combat.Equipes() {
+		for _, unite := range equipe.Membres() {
+			r.SyncUnitSpeed(unite)
+		}
+	}
+}
+
+// AdvanceUntilReady passe aux rounds/phases suivants jusqu'à ce qu'une unité puisse jouer
+func (r *RoundScheduler) AdvanceUntilReady() error {
+	groups := r.groups()
+	if len(groups) == 0 {
+		return fmt.Errorf("aucune unité ne peut jouer")
+	}
+	if !r.started {
+		r.started = true
+		r.current = groups[0]
+	}
+
+	// Un tour complet de tous les groupes suffit à trouver une unité disponible
+	for i := 0; i <= len(groups); i++ {
+		if _, ok := r.NextReadyUnit(); ok {
+			return nil
+		}
+		r.nextGroup(groups)
+	}
+	return fmt.Errorf("aucune unité ne peut jouer")
+}
+
+// NextReadyUnit retourne la prochaine unité du groupe en cours (SPD décroissante, puis ID)
+func (r *RoundScheduler) NextReadyUnit() (domain.UnitID, bool) {
+	if !r.started {
+		return "", false
+	}
+
+	candidates := make([]*roundEntry, 0)
+	for _, entry := range r.units {
+		if entry.Active && !entry.Frozen && !entry.Acted && r.groupOf(entry) == r.current {
+			candidates = append(candidates, entry)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].SPD != candidates[j].SPD {
+			return candidates[i].SPD > candidates[j].SPD
+		}
+		return candidates[i].UnitID < candidates[j].UnitID
+	})
+	return candidates[0].UnitID, true
+}
+
+// EndTurn marque l'unité comme ayant joué pendant le round/la phase en cours
+func (r *RoundScheduler) EndTurn(unitID domain.UnitID) {
+	if entry, exists := r.units[unitID]; exists {
+		entry.Acted = true
+	}
+}
+
+// RetainGauge est sans effet: en CTB et en phases d'équipes, chaque unité joue une fois par round
+func (r *RoundScheduler) RetainGauge(unitID domain.UnitID, percent int) {}
+
+// DeactivateUnit retire définitivement une unité de l'ordre des tours
+func (r *RoundScheduler) DeactivateUnit(unitID domain.UnitID) {
+	if entry, exists := r.units[unitID]; exists {
+		entry.Active = false
+	}
+}
+
+// Forecast prévoit les N prochaines entrées de l'ordre des tours sans modifier l'état
+// Ticks compte les changements de round/phase avant l'entrée
+func (r *RoundScheduler) Forecast(n int) []TurnForecast {
+	forecast := make([]TurnForecast, 0, n)
+	sim := r.clone()
+	debut := sim.elapsed
+
+	for len(forecast) < n {
+		if err := sim.AdvanceUntilReady(); err != nil {
+			break
+		}
+		unitID, _ := sim.NextReadyUnit()
+		forecast = append(forecast, TurnForecast{
+			Rank:   len(forecast) + 1,
+			UnitID: unitID,
+			Type:   ForecastTurn,
+			Ticks:  sim.elapsed - debut,
+		})
+		sim.EndTurn(unitID)
+	}
+
+	return forecast
+}
+
+// Snapshot retourne la position du round/de la phase en cours (enregistrée dans le flux d'événements)
+func (r *RoundScheduler) Snapshot() domain.CurseurRound {
+	curseur := domain.CurseurRound{
+		Demarre: r.started,
+		Groupe:  r.current,
+		Joue:    make([]domain.UnitID, 0),
+	}
+	for id, entry := range r.units {
+		if entry.Acted {
+			curseur.Joue = append(curseur.Joue, id)
+		}
+	}
+	sort.Slice(curseur.Joue, func(i, j int) bool { return curseur.Joue[i] < curseur.Joue[j] })
+	return curseur
+}
+
+// Restore reprend le round/la phase d'une position enregistrée
+// Les unités doivent être enregistrées au préalable (InitializeUnit)
+func (r *RoundScheduler) Restore(curseur domain.CurseurRound) {
+	r.started = curseur.Demarre
+	r.current = curseur.Groupe
+	for _, entry := range r.units {
+		entry.Acted = false
+	}
+	for _, id := range curseur.Joue {
+		if entry, exists := r.units[id]; exists {
+			entry.Acted = true
+		}
+	}
+}
+
+// groupOf retourne le groupe d'une unité (une seule file en CTB)
+func (r *RoundScheduler) groupOf(entry *roundEntry) domain.TeamID {
+	if r.mode == domain.ModeTourPhaseEquipes {
+		return entry.TeamID
+	}
+	return ""
+}
+
+// groups retourne les groupes ayant encore des unités actives, triés par ID
+func (r *RoundScheduler) groups() []domain.TeamID {
+	seen := make(map[domain.TeamID]bool)
+	groups := make([]domain.TeamID, 0)
+	for _, entry := range r.units {
+		group := r.groupOf(entry)
+		if entry.Active && !seen[group] {
+			seen[group] = true
+			groups = append(groups, group)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+	return groups
+}
+
+// nextGroup démarre le round/la phase suivant: les unités du groupe rejouent
+func (r *RoundScheduler) nextGroup(groups []domain.TeamID) {
+	next := groups[0]
+	for _, group := range groups {
+		if group > r.current {
+			next = group
+			break
+		}
+	}
+
+	r.current = next
+	r.elapsed++
+	for _, entry := range r.units {
+		if r.groupOf(entry) == next {
+			entry.Acted = false
+		}
+	}
+}
+
+// clone retourne une copie indépendante de l'ordonnanceur
+func (r *RoundScheduler) clone() *RoundScheduler {
+	clone := &RoundScheduler{
+		mode:    r.mode,
+		units:   make(map[domain.UnitID]*roundEntry, len(r.units)),
+		current: r.current,
+		started: r.started,
+		elapsed: r.elapsed,
+	}
+	for id, entry := range r.units {
+		copie := *entry
+		clone.units[id] = &copie
+	}
+	return clone
+}
@@ -0,0 +1,125 @@
+package states
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RapportTransitions liste les défauts d'une table de transitions
+type RapportTransitions struct {
+	// EtatsInconnus sont référencés par la table sans être déclarés
+	EtatsInconnus []string
+	// EtatsInatteignables ne sont jamais atteints depuis l'état initial
+	EtatsInatteignables []string
+	// EtatsSansEvenement ne sont pas terminaux et ne gèrent aucun événement
+	EtatsSansEvenement []string
+	// ImpassesSansIssue gèrent des événements mais ne mènent jamais à un état terminal
+	ImpassesSansIssue []string
+}
+
+// Valide indique si la table ne présente aucun défaut
+func (r RapportTransitions) Valide() bool {
+	return len(r.EtatsInconnus) == 0 && len(r.EtatsInatteignables) == 0 &&
+		len(r.EtatsSansEvenement) == 0 && len(r.ImpassesSansIssue) == 0
+}
+
+// String résume les défauts du rapport
+func (r RapportTransitions) String() string {
+	if r.Valide() {
+		return "table des transitions valide"
+	}
+	defauts := make([]string, 0, 4)
+	ajouter := func(libelle string, etats []string) {
+		if len(etats) > 0 {
+			defauts = append(defauts, fmt.Sprintf("%s: %s", libelle, strings.Join(etats, ", ")))
+		}
+	}
+	ajouter("états inconnus", r.EtatsInconnus)
+	ajouter("états inatteignables", r.EtatsInatteignables)
+	ajouter("états sans événement", r.EtatsSansEvenement)
+	ajouter("impasses", r.ImpassesSansIssue)
+	return strings.Join(defauts, "; ")
+}
+
+// VerifierMachineEtats vérifie la table des transitions de la machine du combat
+func VerifierMachineEtats() RapportTransitions {
+	return VerifierTransitions(transitionTable, EtatInitial, etatsCombat, etatsTerminaux)
+}
+
+// VerifierTransitions vérifie une table de transitions
+// Atteignabilité depuis l'état initial, états sans événement et impasses (aucun chemin vers un état terminal)
+func VerifierTransitions(table []Transition, initial string, etats []string, terminaux []string) RapportTransitions {
+	rapport := RapportTransitions{}
+
+	declares := make(map[string]bool, len(etats))
+	for _, etat := range etats {
+		declares[etat] = true
+	}
+	terminal := make(map[string]bool, len(terminaux))
+	for _, etat := range terminaux {
+		terminal[etat] = true
+	}
+
+	suivants := make(map[string][]string)
+	precedents := make(map[string][]string)
+	inconnus := make(map[string]bool)
+	for _, transition := range table {
+		suivants[transition.From] = append(suivants[transition.From], transition.To)
+		precedents[transition.To] = append(precedents[transition.To], transition.From)
+		for _, etat := range []string{transition.From, transition.To} {
+			if !declares[etat] {
+				inconnus[etat] = true
+			}
+		}
+	}
+	rapport.EtatsInconnus = clesTriees(inconnus)
+
+	atteints := parcourir([]string{initial}, suivants)
+	versTerminal := parcourir(terminaux, precedents)
+
+	for _, etat := range etats {
+		switch {
+		case !atteints[etat]:
+			rapport.EtatsInatteignables = append(rapport.EtatsInatteignables, etat)
+		case terminal[etat]:
+			// Un état terminal n'a pas de sortie par définition
+		case len(suivants[etat]) == 0:
+			rapport.EtatsSansEvenement = append(rapport.EtatsSansEvenement, etat)
+		case !versTerminal[etat]:
+			rapport.ImpassesSansIssue = append(rapport.ImpassesSansIssue, etat)
+		}
+	}
+
+	return rapport
+}
+
+// parcourir retourne les états atteints depuis les départs en suivant le graphe (parcours en largeur)
+func parcourir(departs []string, graphe map[string][]string) map[string]bool {
+	atteints := make(map[string]bool)
+	file := append([]string(nil), departs...)
+	for _, depart := range departs {
+		atteints[depart] = true
+	}
+	for len(file) > 0 {
+		etat := file[0]
+		file = file[1:]
+		for _, voisin := range graphe[etat] {
+			if !atteints[voisin] {
+				atteints[voisin] = true
+				file = append(file, voisin)
+			}
+		}
+	}
+	return atteints
+}
+
+// clesTriees retourne les clés d'un ensemble dans l'ordre alphabétique
+func clesTriees(ensemble map[string]bool) []string {
+	cles := make([]string, 0, len(ensemble))
+	for cle := range ensemble {
+		cles = append(cles, cle)
+	}
+	sort.Strings(cles)
+	return cles
+}
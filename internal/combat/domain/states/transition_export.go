@@ -0,0 +1,84 @@
+package states
+
+import (
+	"fmt"
+	"strings"
+)
+
+// arete regroupe les événements d'une même transition From → To (ordre de la table)
+type arete struct {
+	from, to   string
+	evenements []string
+}
+
+// aretes regroupe la table par couple From → To
+func aretes(table []Transition) []*arete {
+	resultat := make([]*arete, 0, len(table))
+	index := make(map[[2]string]*arete)
+	for _, transition := range table {
+		cle := [2]string{transition.From, transition.To}
+		a, ok := index[cle]
+		if !ok {
+			a = &arete{from: transition.From, to: transition.To}
+			index[cle] = a
+			resultat = append(resultat, a)
+		}
+		a.evenements = append(a.evenements, string(transition.Event))
+	}
+	return resultat
+}
+
+// ExporterMermaid génère le diagramme d'états Mermaid de la machine du combat
+func ExporterMermaid() string {
+	return DiagrammeMermaid(transitionTable, EtatInitial, etatsTerminaux)
+}
+
+// ExporterDOT génère le graphe Graphviz (DOT) de la machine du combat
+func ExporterDOT() string {
+	return DiagrammeDOT(transitionTable, EtatInitial, etatsTerminaux)
+}
+
+// DiagrammeMermaid génère un stateDiagram-v2 Mermaid depuis une table de transitions
+func DiagrammeMermaid(table []Transition, initial string, terminaux []string) string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	fmt.Fprintf(&b, "    [*] --> %s\n", initial)
+	for _, a := range aretes(table) {
+		fmt.Fprintf(&b, "    %s --> %s : %s\n", a.from, a.to, strings.Join(a.evenements, " / "))
+	}
+	for _, etat := range terminaux {
+		fmt.Fprintf(&b, "    %s --> [*]\n", etat)
+	}
+	return b.String()
+}
+
+// DiagrammeDOT génère un digraph Graphviz depuis une table de transitions
+// L'état initial est en gras, les états terminaux sont doublement cerclés
+func DiagrammeDOT(table []Transition, initial string, terminaux []string) string {
+	var b strings.Builder
+	b.WriteString("digraph CombatStateMachine {\n")
+	b.WriteString("    rankdir=TB;\n")
+	b.WriteString("    node [shape=box, style=rounded];\n")
+	fmt.Fprintf(&b, "    %q [style=\"rounded,bold\"];\n", initial)
+	for _, etat := range terminaux {
+		fmt.Fprintf(&b, "    %q [shape=doublecircle];\n", etat)
+	}
+	for _, a := range aretes(table) {
+		fmt.Fprintf(&b, "    %q -> %q [label=%q];\n", a.from, a.to, strings.Join(a.evenements, " / "))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// DocumentMachineEtats génère la page de documentation de la machine d'états (Markdown + Mermaid)
+// Fichier généré par cmd/diagrammes-etats, ne pas modifier à la main
+func DocumentMachineEtats() string {
+	var b strings.Builder
+	b.WriteString("# Machine d'états du combat\n\n")
+	b.WriteString("> Fichier généré depuis `internal/combat/domain/states/transition_table.go`\n")
+	b.WriteString("> par `go run ./cmd/diagrammes-etats`. Ne pas modifier à la main.\n\n")
+	b.WriteString("```mermaid\n")
+	b.WriteString(ExporterMermaid())
+	b.WriteString("```\n")
+	return b.String()
+}
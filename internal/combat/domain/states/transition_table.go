@@ -0,0 +1,103 @@
+package states
+
+// Transition décrit une transition autorisée: sur Event, l'état From passe à To
+type Transition struct {
+	From  string
+	Event EventType
+	To    string
+}
+
+// EtatInitial est l'état de départ de la machine
+const EtatInitial = "Idle"
+
+// transitionTable est la source de vérité des transitions de la machine d'états du combat
+// Les allowedTransitions de chaque état, le vérificateur et les diagrammes exportés en dérivent
+var transitionTable = []Transition{
+	// Initialisation
+	{From: "Idle", Event: EventStartBattle, To: "Initializing"},
+	{From: "Initializing", Event: EventSetupComplete, To: "Ready"},
+	{From: "Initializing", Event: EventValidationError, To: "Failed"},
+	{From: "Ready", Event: EventFirstUnitReady, To: "TurnBegin"},
+
+	// Début de tour
+	{From: "TurnBegin", Event: EventUnitCanAct, To: "ActionSelection"},
+	{From: "TurnBegin", Event: EventUnitCannotAct, To: "Stunned"},
+	{From: "Stunned", Event: EventSkipTurn, To: "TurnEnd"},
+
+	// Sélection et validation (Wait compris)
+	{From: "ActionSelection", Event: EventCommandSelected, To: "Validating"},
+	{From: "ActionSelection", Event: EventTimeout, To: "Validating"},
+	{From: "Validating", Event: EventValidationSuccess, To: "Confirmed"},
+	{From: "Validating", Event: EventValidationFailed, To: "ActionRejected"},
+	{From: "ActionRejected", Event: EventRetryAction, To: "ActionSelection"},
+
+	// Exécution
+	{From: "Confirmed", Event: EventBeginExecution, To: "Executing"},
+	{From: "Executing", Event: EventExecutionSuccess, To: "ApplyingEffects"},
+	{From: "Executing", Event: EventExecutionError, To: "ExecutionFailed"},
+	{From: "ExecutionFailed", Event: EventRetryAction, To: "ActionSelection"},
+	{From: "ApplyingEffects", Event: EventEffectsApplied, To: "CheckVictory"},
+
+	// Fin de tour et fin de combat
+	{From: "CheckVictory", Event: EventCombatContinue, To: "TurnEnd"},
+	{From: "CheckVictory", Event: EventVictoryOrDefeat, To: "BattleEnded"},
+	{From: "TurnEnd", Event: EventTurnComplete, To: "WaitingATB"},
+	{From: "WaitingATB", Event: EventNextUnitReady, To: "TurnBegin"},
+	{From: "WaitingATB", Event: EventVictoryOrDefeat, To: "BattleEnded"},
+	{From: "BattleEnded", Event: EventFinalizeCombat, To: "Finalizing"},
+
+	// Arbitrage: pause pendant le tour d'un joueur, annulation sans vainqueur
+	{From: "ActionSelection", Event: EventPause, To: "Paused"},
+	{From: "Paused", Event: EventResume, To: "ActionSelection"},
+	{From: "Idle", Event: EventCancel, To: "Cancelled"},
+	{From: "ActionSelection", Event: EventCancel, To: "Cancelled"},
+	{From: "Paused", Event: EventCancel, To: "Cancelled"},
+}
+
+// etatsCombat liste les états de la machine, dans l'ordre du déroulement d'un combat
+var etatsCombat = []string{
+	"Idle", "Initializing", "Ready", "Failed",
+	"TurnBegin", "Stunned", "ActionSelection", "Validating", "ActionRejected",
+	"Confirmed", "Executing", "ExecutionFailed", "ApplyingEffects", "CheckVictory",
+	"TurnEnd", "WaitingATB", "BattleEnded", "Finalizing",
+	"Paused", "Cancelled",
+}
+
+// etatsTerminaux sont les états où la machine s'arrête volontairement
+var etatsTerminaux = []string{"Failed", "Finalizing", "Cancelled"}
+
+// TransitionTable retourne une copie de la table des transitions
+func TransitionTable() []Transition {
+	table := make([]Transition, len(transitionTable))
+	copy(table, transitionTable)
+	return table
+}
+
+// EtatsCombat retourne les états de la machine
+func EtatsCombat() []string {
+	return append([]string(nil), etatsCombat...)
+}
+
+// EtatsTerminaux retourne les états terminaux de la machine
+func EtatsTerminaux() []string {
+	return append([]string(nil), etatsTerminaux...)
+}
+
+// transitionsDepuis construit les allowedTransitions d'un état depuis la table
+func transitionsDepuis(etat string) map[string]bool {
+	cibles := make(map[string]bool)
+	for _, transition := range transitionTable {
+		if transition.From == etat {
+			cibles[transition.To] = true
+		}
+	}
+	return cibles
+}
+
+// newBaseState crée la base d'un état dont les transitions autorisées viennent de la table
+func newBaseState(name string) BaseState {
+	return BaseState{
+		name:               name,
+		allowedTransitions: transitionsDepuis(name),
+	}
+}
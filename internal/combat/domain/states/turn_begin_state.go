@@ -19,13 +19,7 @@ type TurnBeginState struct {
 // NewTurnBeginState crée un nouvel état TurnBegin
 func NewTurnBeginState() *TurnBeginState {
 	return &TurnBeginState{
-		BaseState: BaseState{
-			name: "TurnBegin",
-			allowedTransitions: map[string]bool{
-				"Stunned":         true,
-				"ActionSelection": true,
-			},
-		},
+		BaseState: newBaseState("TurnBegin"),
 	}
 }
 
@@ -33,36 +27,70 @@ func NewTurnBeginState() *TurnBeginState {
 func (s *TurnBeginState) Enter(ctx *CombatContext) error {
 	fmt.Printf("[State] Entrée dans état: %s\n", s.Name())
 
-	// 1. Obtenir l'unité avec le plus haut ATB
-	readyUnits := ctx.ATBSystem.GetReadyUnits()
-	if len(readyUnits) == 0 {
+	// 1. Obtenir la prochaine unité prête selon l'ordre d'initiative
+	unitID, ok := ctx.Scheduler.NextReadyUnit()
+	if !ok {
 		return fmt.Errorf("aucune unité prête pour agir")
 	}
 
-	// Prendre la première unité prête (TODO: ordre d'initiative)
-	unitID := readyUnits[0]
 	s.currentUnit = ctx.Combat.TrouverUnite(unitID)
 	if s.currentUnit == nil {
 		return fmt.Errorf("unité %s non trouvée", unitID)
 	}
 
 	fmt.Printf("[State] Tour de l'unité: %s\n", s.currentUnit.Nom())
+	ctx.Combat.DemarrerTourUnite(unitID)
 
-	// 2. Déclencher OnTurnStart hooks
-	s.currentUnit.NouveauTour()
+	// Le Stop des unités figées s'écoule au rythme des tours des autres unités
+	for _, libereeID := range ctx.Combat.DecompterStop(unitID) {
+		fmt.Printf("[State] %s n'est plus figée\n", libereeID)
+		ctx.Scheduler.SyncUnitSpeed(ctx.Combat.TrouverUnite(libereeID))
+	}
+
+	// Une unité KO ne joue pas: son tour fait avancer le compte à rebours
+	if s.currentUnit.EstKO() {
+		ctx.Combat.AvancerCompteAReboursKO(s.currentUnit)
+		ctx.Scheduler.EndTurn(unitID)
+		if s.currentUnit.EstMorte() {
+			fmt.Printf("[State] %s est définitivement mort\n", s.currentUnit.Nom())
+			ctx.Scheduler.DeactivateUnit(unitID)
+		}
+		enregistrerOrdonnanceur(ctx)
+		return nil
+	}
 
-	// 3. Appliquer les effets de statut (Poison, Regen, etc.)
-	effets := s.currentUnit.TraiterStatuts()
+	// 2. Déclencher OnTurnStart hooks (régénération selon les règles du combat)
+	// et 3. appliquer les effets de statut (Poison, Regen, etc.), enregistrés par TourUniteEntameEvent
+	effets := ctx.Combat.EntamerTourUnite(s.currentUnit)
 	for _, effet := range effets {
 		fmt.Printf("[State] Effet de statut appliqué: %+v\n", effet)
 	}
 
-	// 4. Réinitialiser la jauge ATB de cette unité
-	ctx.ATBSystem.ResetGauge(unitID)
+	// 4. Consommer le tour de cette unité (jauge ATB remise à 0, unité marquée comme ayant joué
+	// son round) et enregistrer l'état de l'ordonnanceur
+	ctx.Scheduler.EndTurn(unitID)
+	enregistrerOrdonnanceur(ctx)
 
 	return nil
 }
 
+// enregistrerOrdonnanceur enregistre l'état de l'ordonnanceur dans le flux d'événements
+// Jauges en mode ATB, position du round/de la phase en CTB et en phases d'équipes
+func enregistrerOrdonnanceur(ctx *CombatContext) {
+	if round, ok := ctx.Scheduler.(*RoundScheduler); ok {
+		ctx.Combat.EnregistrerCurseurRound(round.Snapshot())
+		return
+	}
+	enregistrerJauges(ctx)
+}
+
+// enregistrerJauges enregistre l'état des jauges et des incantations en cours dans le flux d'événements (mode ATB uniquement)
+func enregistrerJauges(ctx *CombatContext) {
+	if atb, ok := ctx.Scheduler.(*ATBSystem); ok {
+		ctx.Combat.EnregistrerJaugesATB(atb.Snapshot(), atb.SnapshotCharges())
+	}
+}
+
 // Exit est appelé lors de la sortie
 func (s *TurnBeginState) Exit(ctx *CombatContext) error {
 	fmt.Printf("[State] Sortie de l'état: %s\n", s.Name())
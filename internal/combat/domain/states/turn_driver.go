@@ -0,0 +1,443 @@
+package states
+
+import (
+	"fmt"
+
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+)
+
+// limiteTransitionsAutomatiques borne les transitions enchaînées sans décision d'un joueur
+// Garde-fou contre une boucle: un combat entre IA se joue jusqu'au bout en une seule avance
+const limiteTransitionsAutomatiques = 10000
+
+// ErreurHorsTour est retournée quand une unité soumet une action alors que ce n'est pas son tour
+type ErreurHorsTour struct {
+	UniteID     domain.UnitID
+	UniteActive domain.UnitID
+}
+
+// Error implémente l'interface error
+func (e *ErreurHorsTour) Error() string {
+	return fmt.Sprintf("ce n'est pas le tour de %s (unité active: %s)", e.UniteID, e.UniteActive)
+}
+
+// ErreurEtatInvalide est retournée quand une action arrive alors que la machine n'attend aucune action
+type ErreurEtatInvalide struct {
+	Etat string
+}
+
+// Error implémente l'interface error
+func (e *ErreurEtatInvalide) Error() string {
+	return fmt.Sprintf("aucune action attendue dans l'état %s", e.Etat)
+}
+
+// UniteActive retourne l'unité dont l'action est attendue (nil hors ActionSelection)
+func (sm *CombatStateMachine) UniteActive() *domain.Unite {
+	if selection, ok := sm.context.CurrentState.(*ActionSelectionState); ok {
+		return selection.CurrentUnit()
+	}
+	return nil
+}
+
+// Demarrer lance le combat (Idle → Initializing → Ready) et avance jusqu'au premier tour d'un joueur
+func (sm *CombatStateMachine) Demarrer() error {
+	sm.context.Combat.DebuterAction()
+	defer sm.context.Combat.TerminerAction()
+
+	if err := sm.HandleEvent(StateEvent{Type: EventStartBattle}); err != nil {
+		return err
+	}
+	return sm.Avancer()
+}
+
+// SoumettreCommande fait passer l'action d'un joueur par les états du tour
+// ActionSelection → Validating → Confirmed → Executing → ApplyingEffects → CheckVictory → TurnEnd,
+// puis avance jusqu'à la prochaine unité qui attend une décision
+// Une action rejetée (validation ou exécution) rend la main à la même unité
+// Les événements de l'action, jusqu'à la fin du tour, partagent un identifiant de corrélation
+func (sm *CombatStateMachine) SoumettreCommande(cmd commands.Command) (*commands.CommandResult, error) {
+	// Combat suspendu ou annulé par le MJ: aucune action acceptée
+	if etat := sm.context.Combat.Etat(); etat == domain.EtatPause || etat == domain.EtatAnnule {
+		return nil, &ErreurEtatInvalide{Etat: etat.String()}
+	}
+
+	unite := sm.UniteActive()
+	if unite == nil {
+		return nil, &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	if cmd.GetActor() == nil || cmd.GetActor().ID() != unite.ID() {
+		acteurID := domain.UnitID("")
+		if cmd.GetActor() != nil {
+			acteurID = cmd.GetActor().ID()
+		}
+		return nil, &ErreurHorsTour{UniteID: acteurID, UniteActive: unite.ID()}
+	}
+
+	sm.context.Combat.DebuterAction()
+	defer sm.context.Combat.TerminerAction()
+
+	if err := sm.HandleEvent(StateEvent{Type: EventCommandSelected, Data: cmd}); err != nil {
+		return nil, err
+	}
+	result, err := sm.jouerAction(true)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, sm.Avancer()
+}
+
+// ExpirerTour joue l'action par défaut d'un tour dont le délai est écoulé, puis avance
+func (sm *CombatStateMachine) ExpirerTour(uniteID domain.UnitID) error {
+	if sm.UniteActive() == nil {
+		return &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	defer sm.context.Combat.TerminerAction()
+
+	if err := sm.jouerActionAutomatique(StateEvent{Type: EventTimeout, Data: uniteID}); err != nil {
+		return err
+	}
+	return sm.Avancer()
+}
+
+// MettreEnPause suspend le combat pendant le tour d'un joueur (litige arbitré par le MJ)
+// Le délai du tour est gelé et SoumettreCommande est refusée jusqu'à la reprise
+func (sm *CombatStateMachine) MettreEnPause(motif string) error {
+	if !sm.CanTransitionTo("Paused") {
+		return &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	sm.context.Combat.DebuterAction()
+	defer sm.context.Combat.TerminerAction()
+
+	if err := sm.context.Combat.MettreEnPause(motif, sm.context.Now()); err != nil {
+		return err
+	}
+	return sm.positionner(StateEvent{Type: EventPause, Data: motif})
+}
+
+// Reprendre relance le tour interrompu par la pause, avec le temps qu'il restait
+func (sm *CombatStateMachine) Reprendre() error {
+	if _, ok := sm.context.CurrentState.(*PausedState); !ok {
+		return &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	sm.context.Combat.DebuterAction()
+	defer sm.context.Combat.TerminerAction()
+
+	if err := sm.context.Combat.Reprendre(sm.context.Now()); err != nil {
+		return err
+	}
+	return sm.positionner(StateEvent{Type: EventResume})
+}
+
+// Annuler met fin au combat sans vainqueur ni récompenses (incident serveur)
+func (sm *CombatStateMachine) Annuler(motif string) error {
+	if !sm.CanTransitionTo("Cancelled") {
+		return &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	sm.context.Combat.DebuterAction()
+	defer sm.context.Combat.TerminerAction()
+
+	if err := sm.context.Combat.Annuler(motif); err != nil {
+		return err
+	}
+	return sm.positionner(StateEvent{Type: EventCancel, Data: motif})
+}
+
+// Finaliser clôt un combat terminé (BattleEnded → Finalizing)
+func (sm *CombatStateMachine) Finaliser() error {
+	if !sm.CanTransitionTo("Finalizing") {
+		return &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+	sm.context.Combat.DebuterAction()
+	defer sm.context.Combat.TerminerAction()
+
+	return sm.positionner(StateEvent{Type: EventFinalizeCombat})
+}
+
+// Restaurer repositionne la machine d'un combat rechargé depuis ses événements
+// Les unités sont réinscrites dans l'ordonnanceur et les jauges ATB reprennent leur état enregistré
+// au début du tour en cours; l'état est reconstruit sans rejouer ses effets d'entrée
+// (l'activité et le délai du tour sont déjà dans le flux d'événements)
+func (sm *CombatStateMachine) Restaurer(position domain.PositionMachineEtats) error {
+	if _, ok := sm.context.CurrentState.(*IdleState); !ok {
+		return &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+
+	etat, err := sm.etatRestaure(position)
+	if err != nil {
+		return err
+	}
+
+	sm.restaurerOrdonnanceur()
+	sm.context.CurrentState = etat
+	fmt.Printf("[State] Machine restaurée dans l'état: %s\n", etat.Name())
+	return nil
+}
+
+// PeutRembobiner vérifie que la machine est au repos: action d'un joueur attendue ou combat terminé
+// Une action n'est annulée ou refaite (entraînement) qu'entre deux décisions, jamais pendant une pause
+func (sm *CombatStateMachine) PeutRembobiner() bool {
+	switch sm.context.CurrentState.(type) {
+	case *ActionSelectionState, *BattleEndedState:
+		return true
+	default:
+		return false
+	}
+}
+
+// Rembobiner repositionne la machine sur le tour d'un combat remis à un état antérieur (annuler ou
+// refaire en entraînement). La position, les jauges ATB et le curseur du round sont ceux enregistrés dans
+// le combat restauré: la main revient à l'unité attendue, avec un nouveau délai de tour, ou le combat
+// refait jusqu'à sa fin reste terminé
+func (sm *CombatStateMachine) Rembobiner() error {
+	ctx := sm.context
+	position := ctx.Combat.PositionMachine()
+	if position == nil || (position.Etat != "ActionSelection" && position.Etat != "BattleEnded") {
+		return &ErreurEtatInvalide{Etat: sm.GetCurrentState()}
+	}
+
+	etat, err := sm.etatRestaure(*position)
+	if err != nil {
+		return err
+	}
+
+	sm.restaurerOrdonnanceur()
+	ctx.PendingCommand = nil
+	ctx.PendingResult = nil
+	ctx.ValidationError = nil
+	ctx.CurrentState = etat
+
+	unite := sm.UniteActive()
+	if unite == nil {
+		fmt.Printf("[State] Machine rembobinée dans l'état: %s\n", etat.Name())
+		return nil
+	}
+	ctx.Combat.FixerEcheanceTour(unite.ID(), ctx.Now())
+	fmt.Printf("[State] Machine rembobinée au tour de %s\n", unite.Nom())
+	return nil
+}
+
+// Avancer enchaîne les transitions qui ne demandent aucune décision d'un joueur
+// Fin de tour → jauges ATB → début de tour; les tours de l'IA, des unités étourdies
+// et les actions imposées par un statut sont joués. S'arrête sur l'ActionSelection d'un joueur
+// ou quand plus aucune transition automatique n'existe (BattleEnded, Failed...)
+// Chaque tour (jauges ATB, début de tour) et chaque action automatique ouvre sa propre corrélation
+func (sm *CombatStateMachine) Avancer() error {
+	defer sm.context.Combat.TerminerAction()
+
+	for i := 0; i < limiteTransitionsAutomatiques; i++ {
+		var event EventType
+
+		switch state := sm.context.CurrentState.(type) {
+		case *InitializingState:
+			event = EventSetupComplete
+		case *ReadyState:
+			event = EventFirstUnitReady
+			sm.context.Combat.DebuterAction()
+		case *TurnBeginState:
+			event = EventUnitCanAct
+			if !state.CurrentUnit().PeutAgir() {
+				event = EventUnitCannotAct
+			}
+		case *StunnedState:
+			event = EventSkipTurn
+		case *TurnEndState:
+			event = EventTurnComplete
+		case *WaitingATBState:
+			event = EventNextUnitReady
+			if sm.context.Combat.VerifierConditionsVictoire() != "CONTINUE" {
+				// Une incantation résolue en attendant les jauges a terminé le combat
+				event = EventVictoryOrDefeat
+			}
+			sm.context.Combat.DebuterAction()
+		case *ActionSelectionState:
+			if !state.CurrentUnit().EstIA() && state.imposee == nil {
+				// En attente de l'action du joueur
+				sm.enregistrerPosition()
+				return nil
+			}
+			// Sans données, la commande de l'IA ou l'action imposée est utilisée
+			if err := sm.jouerActionAutomatique(StateEvent{Type: EventCommandSelected}); err != nil {
+				return err
+			}
+			continue
+		default:
+			sm.enregistrerPosition()
+			return nil
+		}
+
+		if err := sm.HandleEvent(StateEvent{Type: event}); err != nil {
+			return err
+		}
+	}
+
+	return fmt.Errorf("plus de %d transitions automatiques sans action d'un joueur", limiteTransitionsAutomatiques)
+}
+
+// jouerAction conduit la commande sélectionnée jusqu'à la fin du tour (ou du combat)
+// L'action d'un joueur validée pose un point de restauration (entraînement), retiré si elle échoue.
+// Retourne le résultat de l'exécution
+func (sm *CombatStateMachine) jouerAction(joueur bool) (*commands.CommandResult, error) {
+	var result *commands.CommandResult
+	pointPose := false
+
+	for {
+		ctx := sm.context
+		var event EventType
+
+		switch ctx.CurrentState.(type) {
+		case *ValidatingState:
+			if rejet := ctx.ValidationError; rejet != nil {
+				if err := sm.reprendreAction(EventValidationFailed); err != nil {
+					return nil, err
+				}
+				return nil, fmt.Errorf("action rejetée: %w", rejet)
+			}
+			event = EventValidationSuccess
+		case *ConfirmedState:
+			if cmd, ok := ctx.PendingCommand.(commands.Command); ok && joueur && ctx.Combat.ModeEntrainement() {
+				ctx.Combat.PoserPointDeRestauration(cmd.GetActor().ID())
+				pointPose = true
+			}
+			event = EventBeginExecution
+		case *ExecutingState:
+			if echec := ctx.ValidationError; echec != nil {
+				if pointPose {
+					if err := ctx.Combat.AbandonnerPointDeRestauration(); err != nil {
+						return nil, err
+					}
+				}
+				if err := sm.reprendreAction(EventExecutionError); err != nil {
+					return nil, err
+				}
+				return nil, fmt.Errorf("échec d'exécution: %w", echec)
+			}
+			result, _ = ctx.PendingResult.(*commands.CommandResult)
+			event = EventExecutionSuccess
+		case *ApplyingEffectsState:
+			event = EventEffectsApplied
+		case *CheckVictoryState:
+			event = EventCombatContinue
+			if ctx.Combat.VerifierConditionsVictoire() != "CONTINUE" {
+				event = EventVictoryOrDefeat
+			}
+		default:
+			// TurnEnd ou BattleEnded: l'action est jouée
+			return result, nil
+		}
+
+		if err := sm.HandleEvent(StateEvent{Type: event}); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// reprendreAction sort d'un état d'échec (ActionRejected, ExecutionFailed) et rend la main à l'unité
+func (sm *CombatStateMachine) reprendreAction(echec EventType) error {
+	if err := sm.HandleEvent(StateEvent{Type: echec}); err != nil {
+		return err
+	}
+	return sm.HandleEvent(StateEvent{Type: EventRetryAction})
+}
+
+// jouerActionAutomatique joue l'action choisie sans le joueur (IA, statut, expiration du délai)
+// Une action automatique rejetée ne redonne pas la main: l'unité passe son tour
+func (sm *CombatStateMachine) jouerActionAutomatique(event StateEvent) error {
+	unite := sm.UniteActive()
+	sm.context.Combat.DebuterAction()
+	if err := sm.HandleEvent(event); err != nil {
+		return err
+	}
+	if _, err := sm.jouerAction(false); err != nil {
+		fmt.Printf("[State] Action automatique de %s rejetée, tour passé: %v\n", unite.Nom(), err)
+		sm.context.Combat.LeverEcheanceTour(unite.ID())
+		return sm.TransitionTo(NewTurnEndState())
+	}
+	return nil
+}
+
+// positionner déclenche un événement du MJ ou de fin de combat et enregistre la position atteinte
+func (sm *CombatStateMachine) positionner(event StateEvent) error {
+	if err := sm.HandleEvent(event); err != nil {
+		return err
+	}
+	sm.enregistrerPosition()
+	return nil
+}
+
+// enregistrerPosition enregistre la position stable de la machine dans le flux d'événements
+// Les jauges ATB n'ont pas bougé depuis leur enregistrement au début du tour (TurnBeginState)
+func (sm *CombatStateMachine) enregistrerPosition() {
+	position := domain.PositionMachineEtats{Etat: sm.GetCurrentState()}
+	if unite := sm.uniteEnAttente(); unite != nil {
+		position.UniteID = unite.ID()
+	}
+	sm.context.Combat.EnregistrerPositionMachine(position)
+}
+
+// uniteEnAttente retourne l'unité dont le tour est en cours, y compris pendant une pause
+func (sm *CombatStateMachine) uniteEnAttente() *domain.Unite {
+	if paused, ok := sm.context.CurrentState.(*PausedState); ok {
+		return paused.interrompu.CurrentUnit()
+	}
+	return sm.UniteActive()
+}
+
+// etatRestaure reconstruit l'état d'une position enregistrée
+func (sm *CombatStateMachine) etatRestaure(position domain.PositionMachineEtats) (CombatState, error) {
+	switch position.Etat {
+	case "Idle":
+		return sm.context.CurrentState, nil
+	case "ActionSelection", "Paused":
+		unite := sm.context.Combat.TrouverUnite(position.UniteID)
+		if unite == nil {
+			return nil, fmt.Errorf("unité %s introuvable pour restaurer l'état %s", position.UniteID, position.Etat)
+		}
+		// Le tour était déjà ouvert: il n'est pas réinitialisé
+		selection := NewActionSelectionState(unite)
+		selection.ouvert = true
+		if position.Etat == "Paused" {
+			return NewPausedState(selection), nil
+		}
+		return selection, nil
+	case "BattleEnded":
+		return NewBattleEndedState(), nil
+	case "Finalizing":
+		return NewFinalizingState(), nil
+	case "Cancelled":
+		return NewCancelledState(), nil
+	case "Failed":
+		return NewFailedState(), nil
+	default:
+		return nil, fmt.Errorf("position %s non restaurable", position.Etat)
+	}
+}
+
+// restaurerOrdonnanceur réinscrit les unités dans l'ordonnanceur des tours
+// En mode ATB les jauges et les incantations enregistrées sont restaurées; en CTB et en phases d'équipes,
+// le round/la phase reprend à la position enregistrée
+func (sm *CombatStateMachine) restaurerOrdonnanceur() {
+	ctx := sm.context
+	for _, equipe := range ctx.Combat.Equipes() {
+		for _, unite := range equipe.Membres() {
+			ctx.Scheduler.InitializeUnit(unite)
+			if unite.EstMorte() {
+				ctx.Scheduler.DeactivateUnit(unite.ID())
+			}
+		}
+	}
+
+	if atb, ok := ctx.Scheduler.(*ATBSystem); ok {
+		if jauges := ctx.Combat.JaugesATB(); len(jauges) > 0 {
+			atb.Restore(jauges)
+		}
+		atb.RestoreCharges(ctx.Combat.ChargesATB())
+		atb.SetForecastRetention(ctx.Combat.ReglesReinitialisationATB().ConservationComplete)
+	}
+	if round, ok := ctx.Scheduler.(*RoundScheduler); ok {
+		if curseur := ctx.Combat.CurseurRound(); curseur != nil {
+			round.Restore(*curseur)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package states
+
+import (
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
+)
+
+// TurnScheduler est la stratégie d'ordonnancement des tours (Strategy Pattern)
+// Choisie à la création du combat (domain.ModeTour) et pilotée par WaitingATB → TurnBegin
+type TurnScheduler interface {
+	// Mode retourne le mode de tour implémenté
+	Mode() domain.ModeTour
+
+	// InitializeUnit enregistre une unité dans l'ordonnanceur
+	InitializeUnit(unite *domain.Unite)
+
+	// SyncUnitSpeed met à jour une unité après un changement de statuts (SPD, Hâte, Lenteur, Stop)
+	SyncUnitSpeed(unite *domain.Unite)
+
+	// SyncSpeeds met à jour toutes les unités du combat
+	SyncSpeeds(combat *domain.Combat)
+
+	// AdvanceUntilReady fait avancer le temps jusqu'à ce qu'une unité puisse jouer
+	AdvanceUntilReady() error
+
+	// NextReadyUnit retourne la prochaine unité qui joue, sans faire avancer le temps
+	NextReadyUnit() (domain.UnitID, bool)
+
+	// EndTurn consomme le tour d'une unité
+	EndTurn(unitID domain.UnitID)
+
+	// RetainGauge rend à l'unité une part de son tour en fin de tour (% de jauge conservé)
+	RetainGauge(unitID domain.UnitID, percent int)
+
+	// DeactivateUnit retire définitivement une unité de l'ordre des tours
+	DeactivateUnit(unitID domain.UnitID)
+
+	// Forecast prévoit les N prochaines entrées de l'ordre des tours sans modifier l'état
+	Forecast(n int) []TurnForecast
+}
+
+// NewTurnScheduler crée l'ordonnanceur correspondant au mode de tour
+// En mode ATB, le système ATB fourni est utilisé (créé s'il est nil)
+func NewTurnScheduler(mode domain.ModeTour, atb *ATBSystem) TurnScheduler {
+	switch mode {
+	case domain.ModeTourCTB:
+		return NewCTBScheduler()
+	case domain.ModeTourPhaseEquipes:
+		return NewTeamPhaseScheduler()
+	default:
+		if atb == nil {
+			atb = NewATBSystem()
+		}
+		return atb
+	}
+}
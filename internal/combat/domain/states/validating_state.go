@@ -15,13 +15,7 @@ type ValidatingState struct {
 // NewValidatingState crée un nouvel état Validating
 func NewValidatingState() *ValidatingState {
 	return &ValidatingState{
-		BaseState: BaseState{
-			name: "Validating",
-			allowedTransitions: map[string]bool{
-				"Confirmed":      true,
-				"ActionRejected": true,
-			},
-		},
+		BaseState: newBaseState("Validating"),
 	}
 }
 
@@ -39,16 +33,24 @@ func (s *ValidatingState) Enter(ctx *CombatContext) error {
 		return fmt.Errorf("type de commande invalide")
 	}
 
-	// Valider la commande via son interface Command
-	if err := cmd.Validate(); err != nil {
-		// Stocker l'erreur de validation
-		ctx.ValidationError = err
-		return err
-	}
+	// Une commande invalide n'empêche pas d'entrer dans l'état:
+	// l'erreur est stockée et la machine enchaîne sur EventValidationFailed
+	ctx.ValidationError = validerCommande(ctx, cmd)
 
 	return nil
 }
 
+// validerCommande applique la chaîne de validation du combat (Status → Cost → Range → Target →
+// règles personnalisées), puis la validation propre à la commande (interface Command)
+func validerCommande(ctx *CombatContext, cmd commands.Command) error {
+	if chain := ctx.Combat.GetValidationChain(); chain != nil {
+		if err := chain.Validate(cmd); err != nil {
+			return err
+		}
+	}
+	return cmd.Validate()
+}
+
 // Exit est appelé lors de la sortie
 func (s *ValidatingState) Exit(ctx *CombatContext) error {
 	fmt.Printf("[State] Sortie de l'état: %s\n", s.Name())
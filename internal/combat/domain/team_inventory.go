@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"errors"
+
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// TeamInventory gère le sac d'objets partagé par une équipe
+// Responsabilités: Quantités d'objets possédées
+// Single Responsibility Principle - Les définitions sont dans ItemCatalogue
+type TeamInventory struct {
+	teamID     TeamID
+	quantities map[shared.ObjetID]int
+}
+
+// NewTeamInventory crée un inventaire vide pour une équipe
+func NewTeamInventory(teamID TeamID) *TeamInventory {
+	return &TeamInventory{
+		teamID:     teamID,
+		quantities: make(map[shared.ObjetID]int),
+	}
+}
+
+// TeamID retourne l'équipe propriétaire
+func (inv *TeamInventory) TeamID() TeamID {
+	return inv.teamID
+}
+
+// Add ajoute des exemplaires d'un objet
+func (inv *TeamInventory) Add(itemID shared.ObjetID, quantity int) {
+	if quantity <= 0 {
+		return
+	}
+	inv.quantities[itemID] += quantity
+}
+
+// Remove retire des exemplaires d'un objet
+func (inv *TeamInventory) Remove(itemID shared.ObjetID, quantity int) error {
+	if quantity <= 0 {
+		return errors.New("quantité invalide")
+	}
+	if inv.quantities[itemID] < quantity {
+		return shared.NewDomainError("quantité insuffisante", "INSUFFICIENT_ITEMS")
+	}
+
+	inv.quantities[itemID] -= quantity
+	if inv.quantities[itemID] == 0 {
+		delete(inv.quantities, itemID)
+	}
+	return nil
+}
+
+// Count retourne le nombre d'exemplaires d'un objet
+func (inv *TeamInventory) Count(itemID shared.ObjetID) int {
+	return inv.quantities[itemID]
+}
+
+// Has vérifie si au moins un exemplaire est disponible
+func (inv *TeamInventory) Has(itemID shared.ObjetID) bool {
+	return inv.quantities[itemID] > 0
+}
+
+// Quantities retourne une copie des quantités restantes
+func (inv *TeamInventory) Quantities() map[shared.ObjetID]int {
+	copie := make(map[shared.ObjetID]int, len(inv.quantities))
+	for itemID, quantity := range inv.quantities {
+		copie[itemID] = quantity
+	}
+	return copie
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"sort"
+)
+
+// ThreatTable mémorise la menace générée par chaque unité aux yeux d'une unité IA
+// Responsabilités: Cumul de la menace (dégâts, soins, provocations) et choix de la cible prioritaire
+type ThreatTable struct {
+	ownerID UnitID
+	threat  map[UnitID]int
+}
+
+// NewThreatTable crée une table de menace vide pour une unité
+func NewThreatTable(ownerID UnitID) *ThreatTable {
+	return &ThreatTable{
+		ownerID: ownerID,
+		threat:  make(map[UnitID]int),
+	}
+}
+
+// OwnerID retourne l'unité propriétaire de la table
+func (t *ThreatTable) OwnerID() UnitID {
+	return t.ownerID
+}
+
+// Add ajoute de la menace pour une unité source
+func (t *ThreatTable) Add(sourceID UnitID, amount int) {
+	if amount <= 0 || sourceID == t.ownerID {
+		return
+	}
+	t.threat[sourceID] += amount
+}
+
+// Get retourne la menace cumulée d'une unité
+func (t *ThreatTable) Get(sourceID UnitID) int {
+	return t.threat[sourceID]
+}
+
+// Remove oublie la menace d'une unité (morte, retirée du combat)
+func (t *ThreatTable) Remove(sourceID UnitID) {
+	delete(t.threat, sourceID)
+}
+
+// Reset vide la table
+func (t *ThreatTable) Reset() {
+	t.threat = make(map[UnitID]int)
+}
+
+// Highest retourne l'unité la plus menaçante parmi les candidates
+// En cas d'égalité, l'ID le plus petit l'emporte (ordre déterministe)
+// Retourne false si aucune candidate n'a généré de menace
+func (t *ThreatTable) Highest(candidats []UnitID) (UnitID, bool) {
+	tries := make([]UnitID, 0, len(candidats))
+	for _, id := range candidats {
+		if t.threat[id] > 0 {
+			tries = append(tries, id)
+		}
+	}
+	if len(tries) == 0 {
+		return "", false
+	}
+
+	sort.Slice(tries, func(i, j int) bool {
+		if t.threat[tries[i]] != t.threat[tries[j]] {
+			return t.threat[tries[i]] > t.threat[tries[j]]
+		}
+		return tries[i] < tries[j]
+	})
+	return tries[0], true
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ActionExpiration est l'action jouée à la place d'une unité dont le tour expire
+type ActionExpiration string
+
+const (
+	// ActionExpirationAttente fait passer son tour à l'unité (Wait)
+	ActionExpirationAttente ActionExpiration = "ATTENTE"
+
+	// ActionExpirationIA confie le tour à l'IA
+	ActionExpirationIA ActionExpiration = "IA"
+)
+
+// ReglesDelaiTour définit le temps accordé à chaque tour d'un joueur
+// Un joueur absent ne bloque plus le combat: à l'échéance, l'action par défaut est jouée
+type ReglesDelaiTour struct {
+	Duree           time.Duration    // Temps accordé par tour (0 = pas de limite)
+	ActionParDefaut ActionExpiration // Action jouée à l'expiration
+}
+
+// NewReglesDelaiTourDefaut crée les règles par défaut (pas de limite de temps)
+func NewReglesDelaiTourDefaut() *ReglesDelaiTour {
+	return &ReglesDelaiTour{
+		Duree:           0,
+		ActionParDefaut: ActionExpirationAttente,
+	}
+}
+
+// Valider vérifie la cohérence des règles
+func (r *ReglesDelaiTour) Valider() error {
+	if r.Duree < 0 {
+		return errors.New("la durée d'un tour doit être >= 0")
+	}
+	switch r.ActionParDefaut {
+	case ActionExpirationAttente, ActionExpirationIA:
+		return nil
+	default:
+		return fmt.Errorf("action d'expiration inconnue: %s", r.ActionParDefaut)
+	}
+}
+
+// EstActif indique si les tours sont limités dans le temps
+func (r *ReglesDelaiTour) EstActif() bool {
+	return r.Duree > 0
+}
+
+// EcheanceTour représente l'échéance du tour en cours (persistée via EcheanceTourFixeeEvent)
+type EcheanceTour struct {
+	Tour     int
+	UniteID  UnitID
+	Echeance time.Time
+}
@@ -10,7 +10,9 @@ import (
 type UnitCombatBehavior struct {
 	baseStats    *shared.Stats
 	currentStats *shared.Stats
-	isEliminated bool
+	isEliminated bool // KO ou mort (hors de combat)
+	isDead       bool // Mort définitive (compte à rebours KO écoulé)
+	koCountdown  int  // Tours restants avant la mort définitive
 }
 
 // NewUnitCombatBehavior crée un nouveau comportement de combat
@@ -32,11 +34,42 @@ func (c *UnitCombatBehavior) CurrentStats() *shared.Stats {
 	return c.currentStats
 }
 
-// IsEliminated vérifie si l'unité est éliminée
+// IsEliminated vérifie si l'unité est éliminée (KO ou morte)
 func (c *UnitCombatBehavior) IsEliminated() bool {
 	return c.isEliminated
 }
 
+// IsKO vérifie si l'unité est KO (encore ressuscitable)
+func (c *UnitCombatBehavior) IsKO() bool {
+	return c.isEliminated && !c.isDead
+}
+
+// IsDead vérifie si l'unité est définitivement morte
+func (c *UnitCombatBehavior) IsDead() bool {
+	return c.isDead
+}
+
+// KOCountdown retourne le nombre de tours restants avant la mort définitive
+func (c *UnitCombatBehavior) KOCountdown() int {
+	return c.koCountdown
+}
+
+// TickKOCountdown décrémente le compte à rebours KO
+// Retourne true si l'unité vient de mourir définitivement
+func (c *UnitCombatBehavior) TickKOCountdown() bool {
+	if !c.IsKO() {
+		return false
+	}
+
+	c.koCountdown--
+	if c.koCountdown <= 0 {
+		c.koCountdown = 0
+		c.isDead = true
+		return true
+	}
+	return false
+}
+
 // TakeDamage applique des dégâts à l'unité
 func (c *UnitCombatBehavior) TakeDamage(damage int) {
 	if c.isEliminated {
@@ -48,6 +81,7 @@ func (c *UnitCombatBehavior) TakeDamage(damage int) {
 	if c.currentStats.HP <= 0 {
 		c.currentStats.HP = 0
 		c.isEliminated = true
+		c.koCountdown = CompteAReboursKO
 	}
 }
 
@@ -65,13 +99,14 @@ func (c *UnitCombatBehavior) Heal(healing int) {
 	}
 }
 
-// Revive ressuscite l'unité avec un montant de HP
+// Revive ressuscite l'unité KO avec un montant de HP
 func (c *UnitCombatBehavior) Revive(hp int) {
-	if !c.isEliminated {
-		return // Déjà vivant
+	if !c.IsKO() {
+		return // Déjà vivant ou définitivement mort
 	}
 
 	c.isEliminated = false
+	c.koCountdown = 0
 	c.currentStats.HP = hp
 
 	// Cap aux HP max
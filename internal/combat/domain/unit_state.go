@@ -0,0 +1,68 @@
+package domain
+
+import (
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
+)
+
+// EtatUnite est une copie de l'état mutable d'une unité en combat
+// Utilisé pour le rollback des commandes: HP, MP, Stamina et modificateurs, position,
+// statuts, cooldowns, KO/mort et ressources du tour
+type EtatUnite struct {
+	Stats              *shared.Stats // Stats actuelles
+	Position           *shared.Position
+	Statuts            []shared.Statut // Copies: la durée d'un statut évolue après la capture
+	Cooldowns          map[CompetenceID]int
+	Eliminee           bool
+	Morte              bool
+	CompteAReboursKO   int
+	DeplacementRestant int
+	ActionsRestantes   int
+}
+
+// CapturerEtat retourne une copie de l'état actuel de l'unité
+func (u *Unite) CapturerEtat() *EtatUnite {
+	statuts := make([]shared.Statut, 0, len(u.statuses.Statuses()))
+	for _, statut := range u.statuses.Statuses() {
+		statuts = append(statuts, *statut)
+	}
+
+	cooldowns := make(map[CompetenceID]int, len(u.Competences()))
+	for _, competence := range u.Competences() {
+		cooldowns[competence.ID()] = competence.CooldownActuel()
+	}
+
+	return &EtatUnite{
+		Stats:              u.combat.currentStats.Clone(),
+		Position:           u.position,
+		Statuts:            statuts,
+		Cooldowns:          cooldowns,
+		Eliminee:           u.combat.isEliminated,
+		Morte:              u.combat.isDead,
+		CompteAReboursKO:   u.combat.koCountdown,
+		DeplacementRestant: u.deplacementRestant,
+		ActionsRestantes:   u.actionsRestantes,
+	}
+}
+
+// RestaurerEtat remet l'unité dans un état capturé (voir CapturerEtat)
+// Une unité KO depuis la capture est relevée, une unité ranimée retombe KO avec son compte à rebours
+func (u *Unite) RestaurerEtat(etat *EtatUnite) {
+	*u.combat.currentStats = *etat.Stats.Clone()
+	u.combat.isEliminated = etat.Eliminee
+	u.combat.isDead = etat.Morte
+	u.combat.koCountdown = etat.CompteAReboursKO
+
+	u.position = etat.Position
+	u.deplacementRestant = etat.DeplacementRestant
+	u.actionsRestantes = etat.ActionsRestantes
+
+	u.statuses.ClearAllStatuses()
+	for i := range etat.Statuts {
+		statut := etat.Statuts[i]
+		_ = u.statuses.AddStatus(&statut)
+	}
+
+	for _, competence := range u.Competences() {
+		competence.SetCooldownActuel(etat.Cooldowns[competence.ID()])
+	}
+}
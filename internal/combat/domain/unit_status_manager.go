@@ -127,6 +127,74 @@ func (m *UnitStatusManager) IsPoisoned() bool {
 	return m.HasStatus(shared.TypeStatutPoison)
 }
 
+// IsTaunted vérifie si l'unité est provoquée
+func (m *UnitStatusManager) IsTaunted() bool {
+	return m.HasStatus(shared.TypeStatutProvocation)
+}
+
+// IsAsleep vérifie si l'unité est endormie
+func (m *UnitStatusManager) IsAsleep() bool {
+	return m.HasStatus(shared.TypeStatutSommeil)
+}
+
+// IsConfused vérifie si l'unité est confuse
+func (m *UnitStatusManager) IsConfused() bool {
+	return m.HasStatus(shared.TypeStatutConfusion)
+}
+
+// IsCharmed vérifie si l'unité est charmée
+func (m *UnitStatusManager) IsCharmed() bool {
+	return m.HasStatus(shared.TypeStatutCharme)
+}
+
+// IsBerserk vérifie si l'unité est en Berserk
+func (m *UnitStatusManager) IsBerserk() bool {
+	return m.HasStatus(shared.TypeStatutBerserk)
+}
+
+// IsReflecting vérifie si l'unité renvoie les sorts
+func (m *UnitStatusManager) IsReflecting() bool {
+	return m.HasStatus(shared.TypeStatutReflet)
+}
+
+// HasSpellCounter vérifie si l'unité annulera le prochain sort hostile
+func (m *UnitStatusManager) HasSpellCounter() bool {
+	return m.HasStatus(shared.TypeStatutContreSort)
+}
+
+// IsHasted vérifie si l'unité est sous Hâte
+func (m *UnitStatusManager) IsHasted() bool {
+	return m.HasStatus(shared.TypeStatutHate)
+}
+
+// IsSlowed vérifie si l'unité est ralentie
+func (m *UnitStatusManager) IsSlowed() bool {
+	return m.HasStatus(shared.TypeStatutLenteur)
+}
+
+// IsStopped vérifie si l'unité est figée (Stop)
+func (m *UnitStatusManager) IsStopped() bool {
+	return m.HasStatus(shared.TypeStatutStop)
+}
+
+// StatModifier retourne la somme des modificateurs des statuts actifs pour une stat
+func (m *UnitStatusManager) StatModifier(stat string) int {
+	total := 0
+	for _, status := range m.statuses {
+		for _, mod := range status.Modificateurs() {
+			if mod.Stat == stat {
+				total += mod.Valeur
+			}
+		}
+	}
+	return total
+}
+
+// IsExhausted vérifie si l'unité est épuisée
+func (m *UnitStatusManager) IsExhausted() bool {
+	return m.HasStatus(shared.TypeStatutEpuisement)
+}
+
 // BlocksActions vérifie si un statut bloque les actions
 func (m *UnitStatusManager) BlocksActions() bool {
 	for _, status := range m.statuses {
@@ -29,6 +29,12 @@ type Unite struct {
 	// État du tour
 	deplacementRestant int
 	actionsRestantes   int
+
+	// Régénération de Stamina (% de la Stamina max par tour, voir ReglesStamina)
+	tauxRegenStamina int
+
+	// Contrôle par l'IA (hérité de l'équipe, voir Equipe.AjouterMembre)
+	estIA bool
 }
 
 // NewUnite crée une nouvelle unité
@@ -48,6 +54,8 @@ func NewUnite(id UnitID, nom string, teamID TeamID, stats *shared.Stats, positio
 		// État initial du tour
 		deplacementRestant: stats.MOV,
 		actionsRestantes:   1,
+
+		tauxRegenStamina: TauxRegenStaminaDefaut,
 	}
 }
 
@@ -64,6 +72,9 @@ func (u *Unite) HPActuels() int                { return u.combat.CurrentHP() }
 func (u *Unite) Competences() []*Competence    { return u.inventory.Skills() }
 func (u *Unite) Statuts() []*shared.Statut     { return u.statuses.Statuses() }
 func (u *Unite) EstEliminee() bool             { return u.combat.IsEliminated() }
+func (u *Unite) EstKO() bool                   { return u.combat.IsKO() }
+func (u *Unite) EstMorte() bool                { return u.combat.IsDead() }
+func (u *Unite) CompteAReboursKO() int         { return u.combat.KOCountdown() }
 
 // PeutAgir vérifie si l'unité peut effectuer une action
 func (u *Unite) PeutAgir() bool {
@@ -109,8 +120,12 @@ func (u *Unite) DeplacerVers(nouvellePosition *shared.Position) {
 }
 
 // RecevoirDegats applique des dégâts à l'unité (délègue au composant combat)
+// Les dégâts réveillent une unité endormie
 func (u *Unite) RecevoirDegats(degats int) {
 	u.combat.TakeDamage(degats)
+	if degats > 0 && !u.combat.IsEliminated() {
+		u.statuses.RemoveStatus(shared.TypeStatutSommeil)
+	}
 }
 
 // RecevoirSoin applique un soin à l'unité (délègue au composant combat)
@@ -138,6 +153,11 @@ func (u *Unite) RetirerStatut(typeStatut shared.TypeStatut) {
 	u.statuses.RemoveStatus(typeStatut)
 }
 
+// ObtenirStatut retourne un statut actif par type (nil si absent)
+func (u *Unite) ObtenirStatut(typeStatut shared.TypeStatut) *shared.Statut {
+	return u.statuses.GetStatus(typeStatut)
+}
+
 // TraiterStatuts traite tous les statuts actifs (délègue au composant)
 func (u *Unite) TraiterStatuts() []shared.EffetStatut {
 	// Déléguer au gestionnaire de statuts
@@ -170,6 +190,11 @@ func (u *Unite) PeutUtiliserCompetence(compID CompetenceID) bool {
 		return false
 	}
 
+	// Une unité épuisée ne peut pas utiliser de compétence physique
+	if comp.EstPhysique() && u.EstEpuise() {
+		return false
+	}
+
 	// Vérifier le cooldown via le composant inventory
 	return u.inventory.IsSkillReady(compID)
 }
@@ -189,7 +214,7 @@ func (u *Unite) UtiliserCompetence(compID CompetenceID) error {
 	if err := u.combat.ConsumeMP(comp.CoutMP()); err != nil {
 		return err
 	}
-	if err := u.combat.ConsumeStamina(comp.CoutStamina()); err != nil {
+	if err := u.ConsommerStamina(comp.CoutStamina()); err != nil {
 		return err
 	}
 
@@ -226,14 +251,32 @@ func (u *Unite) RegenererStatut() {
 	regenMP := baseStats.MP / RegenMPDiviseur
 	u.combat.RestoreMP(regenMP)
 
-	// Régénération Stamina (exemple: 20% par tour)
-	// Note: Stamina sera géré via combat component
-	regenStamina := baseStats.Stamina / RegenStaminaDiviseur
+	// Régénération Stamina (taux configurable via ReglesStamina)
+	u.RegenererStamina()
+}
+
+// RegenererStamina régénère la Stamina selon le taux de l'unité
+// Retourne la quantité réellement régénérée
+func (u *Unite) RegenererStamina() int {
+	baseStats := u.combat.BaseStats()
 	currentStats := u.combat.CurrentStats()
-	currentStats.Stamina += regenStamina
+
+	avant := currentStats.Stamina
+	currentStats.Stamina += baseStats.Stamina * u.tauxRegenStamina / 100
 	if currentStats.Stamina > baseStats.Stamina {
 		currentStats.Stamina = baseStats.Stamina
 	}
+	return currentStats.Stamina - avant
+}
+
+// TauxRegenStamina retourne le pourcentage de Stamina max régénéré par tour
+func (u *Unite) TauxRegenStamina() int {
+	return u.tauxRegenStamina
+}
+
+// SetTauxRegenStamina définit le pourcentage de Stamina max régénéré par tour
+func (u *Unite) SetTauxRegenStamina(taux int) {
+	u.tauxRegenStamina = taux
 }
 
 // NouveauTour réinitialise les compteurs de tour
@@ -322,8 +365,29 @@ func (u *Unite) ConsommerMP(montant int) error {
 }
 
 // ConsommerStamina consomme de l'endurance (délègue au composant)
+// Une unité dont la Stamina tombe à 0 devient épuisée
 func (u *Unite) ConsommerStamina(montant int) error {
-	return u.combat.ConsumeStamina(montant)
+	if err := u.combat.ConsumeStamina(montant); err != nil {
+		return err
+	}
+
+	if montant > 0 && u.combat.CurrentStats().Stamina == SeuilRessourceMinimum && !u.EstEpuise() {
+		return u.statuses.AddStatus(shared.NewStatut(shared.TypeStatutEpuisement, DureeEpuisement, 0))
+	}
+	return nil
+}
+
+// EstEpuise vérifie si l'unité est épuisée (actions physiques impossibles)
+func (u *Unite) EstEpuise() bool {
+	return u.statuses.IsExhausted()
+}
+
+// PorteeDeplacement retourne le nombre de cases parcourables (réduit si épuisée)
+func (u *Unite) PorteeDeplacement() int {
+	if u.EstEpuise() {
+		return u.Stats().MOV / DiviseurDeplacementEpuise
+	}
+	return u.Stats().MOV
 }
 
 // ObtenirCompetenceParDefaut retourne l'attaque basique de l'unité
@@ -396,6 +460,20 @@ func (u *Unite) SetMP(mp int) {
 	}
 }
 
+// SetStamina définit la Stamina actuelle (utilisé pour rollback des commandes)
+func (u *Unite) SetStamina(stamina int) {
+	currentStats := u.combat.CurrentStats()
+	baseStats := u.combat.BaseStats()
+
+	currentStats.Stamina = stamina
+	if currentStats.Stamina < SeuilRessourceMinimum {
+		currentStats.Stamina = SeuilRessourceMinimum
+	}
+	if currentStats.Stamina > baseStats.Stamina {
+		currentStats.Stamina = baseStats.Stamina
+	}
+}
+
 // RestaurerMP restaure des points de magie
 func (u *Unite) RestaurerMP(montant int) {
 	currentStats := u.combat.CurrentStats()
@@ -407,10 +485,17 @@ func (u *Unite) RestaurerMP(montant int) {
 	}
 }
 
-// Ressusciter ressuscite l'unité avec un montant de HP
+// AvancerCompteARebours décrémente le compte à rebours KO (un tour de l'unité KO)
+// Retourne true si l'unité vient de mourir définitivement
+func (u *Unite) AvancerCompteARebours() bool {
+	return u.combat.TickKOCountdown()
+}
+
+// Ressusciter ressuscite l'unité KO avec un montant de HP
+// Une unité définitivement morte ne peut plus être ressuscitée
 func (u *Unite) Ressusciter(hp int) {
-	if !u.combat.IsEliminated() {
-		return // Déjà vivante
+	if !u.combat.IsKO() {
+		return // Déjà vivante ou définitivement morte
 	}
 
 	// Utiliser la méthode Revive du combat component
@@ -422,10 +507,12 @@ func (u *Unite) Ressusciter(hp int) {
 
 // EstIA vérifie si l'unité est contrôlée par l'IA
 func (u *Unite) EstIA() bool {
-	// TODO: Ajouter un champ isAI dans la structure Unite
-	// Pour l'instant, on peut considérer que toutes les unités ennemies sont IA
-	// ou utiliser une map externe dans Combat
-	return false // Placeholder
+	return u.estIA
+}
+
+// SetIA définit si l'unité est contrôlée par l'IA
+func (u *Unite) SetIA(estIA bool) {
+	u.estIA = estIA
 }
 
 // IAChoisirAction fait choisir une action à l'IA
@@ -455,6 +542,70 @@ func (u *Unite) EstEmpoisonne() bool {
 	return u.statuses.IsPoisoned()
 }
 
+// EstEndormie vérifie si l'unité est sous l'effet Sommeil (réveillée par les dégâts)
+func (u *Unite) EstEndormie() bool {
+	return u.statuses.IsAsleep()
+}
+
+// EstConfuse vérifie si l'unité est sous l'effet Confusion (action et cible aléatoires)
+func (u *Unite) EstConfuse() bool {
+	return u.statuses.IsConfused()
+}
+
+// EstCharmee vérifie si l'unité est sous l'effet Charme (voir Combat.EquipeEffective)
+func (u *Unite) EstCharmee() bool {
+	return u.statuses.IsCharmed()
+}
+
+// EstBerserk vérifie si l'unité est sous l'effet Berserk (attaque automatique)
+func (u *Unite) EstBerserk() bool {
+	return u.statuses.IsBerserk()
+}
+
+// EstReflet vérifie si l'unité est sous l'effet Reflet (renvoie les sorts à cible unique)
+func (u *Unite) EstReflet() bool {
+	return u.statuses.IsReflecting()
+}
+
+// AContreSort vérifie si l'unité annulera le prochain sort hostile
+func (u *Unite) AContreSort() bool {
+	return u.statuses.HasSpellCounter()
+}
+
+// ATKEffective retourne l'ATK de base augmentée des modificateurs de statuts actifs (Berserk...)
+func (u *Unite) ATKEffective() int {
+	return u.Stats().ATK + u.statuses.StatModifier("ATK")
+}
+
+// SPDEffective retourne la SPD de base modifiée par les statuts actifs (Paralysie...), minimum 1
+func (u *Unite) SPDEffective() int {
+	spd := u.Stats().SPD + u.statuses.StatModifier("SPD")
+	if spd < 1 {
+		spd = 1
+	}
+	return spd
+}
+
+// EstHate vérifie si l'unité est sous l'effet Hâte (jauge ATB accélérée)
+func (u *Unite) EstHate() bool {
+	return u.statuses.IsHasted()
+}
+
+// EstRalentie vérifie si l'unité est sous l'effet Lenteur (jauge ATB ralentie)
+func (u *Unite) EstRalentie() bool {
+	return u.statuses.IsSlowed()
+}
+
+// EstStop vérifie si l'unité est sous l'effet Stop (jauge ATB gelée)
+func (u *Unite) EstStop() bool {
+	return u.statuses.IsStopped()
+}
+
+// EstProvoquee vérifie si l'unité est sous l'effet Provocation (ciblage forcé, voir Combat.CibleForcee)
+func (u *Unite) EstProvoquee() bool {
+	return u.statuses.IsTaunted()
+}
+
 // SkillEstPret vérifie si une compétence est prête (pas en cooldown et ressources suffisantes)
 func (u *Unite) SkillEstPret(skillID CompetenceID) bool {
 	return u.PeutUtiliserCompetence(skillID)
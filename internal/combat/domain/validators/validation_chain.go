@@ -3,7 +3,9 @@ package validators
 import (
 	"fmt"
 
+	domain "github.com/aether-engine/aether-engine/internal/combat/domain"
 	"github.com/aether-engine/aether-engine/internal/combat/domain/commands"
+	shared "github.com/aether-engine/aether-engine/internal/shared/domain"
 )
 
 // Chain of Responsibility Pattern
@@ -14,6 +16,9 @@ type Validator interface {
 	SetNext(validator Validator) Validator
 	// Validate valide la commande
 	Validate(cmd commands.Command) error
+	// Clone retourne une copie non chaînée du validateur, sans état partagé avec l'original
+	// (chaîne d'un combat cloné pour la prévisualisation)
+	Clone() Validator
 }
 
 // BaseValidator implémentation de base pour la chaîne
@@ -38,6 +43,7 @@ func (v *BaseValidator) CallNext(cmd commands.Command) error {
 // CostValidator vérifie le coût en MP/HP/Stamina
 type CostValidator struct {
 	BaseValidator
+	registry *commands.CommandRegistry // Types personnalisés (nil: commandes standard uniquement)
 }
 
 // NewCostValidator crée un nouveau validateur de coût
@@ -45,22 +51,60 @@ func NewCostValidator() *CostValidator {
 	return &CostValidator{}
 }
 
+// Clone implémente Validator
+func (v *CostValidator) Clone() Validator {
+	return &CostValidator{registry: v.registry}
+}
+
 // Validate vérifie que l'acteur a assez de ressources
 func (v *CostValidator) Validate(cmd commands.Command) error {
 	actor := cmd.GetActor()
 
 	// Selon le type de commande, vérifier les coûts
-	switch cmd.GetType() {
-	case commands.CommandTypeSkill:
-		// Vérifier MP pour les skills (déjà fait dans SkillCommand)
-		// Cette validation est redondante mais permet une architecture propre
+	switch c := cmd.(type) {
+	case *commands.SkillCommand:
+		skill := c.GetSkill()
+		if skill == nil {
+			return fmt.Errorf("aucune compétence spécifiée")
+		}
+		if actor.StatsActuelles().MP < skill.CoutMP() {
+			return fmt.Errorf("MP insuffisant pour %s (coût: %d, disponible: %d)", skill.Nom(), skill.CoutMP(), actor.StatsActuelles().MP)
+		}
+		if !actor.SkillEstPret(skill.ID()) {
+			return fmt.Errorf("compétence %s en cooldown", skill.Nom())
+		}
+		if skill.EstPhysique() && actor.EstEpuise() {
+			return fmt.Errorf("l'unité %s est épuisée, impossible d'utiliser %s", actor.Nom(), skill.Nom())
+		}
 
-	case commands.CommandTypeMove:
-		// Vérifier stamina pour le déplacement (si applicable)
-		// TODO: implémenter système de stamina
+	case *commands.AttackCommand:
+		if actor.EstEpuise() {
+			return fmt.Errorf("l'unité %s est épuisée", actor.Nom())
+		}
+
+	case *commands.ItemCommand:
+		// L'objet est consommé dans l'inventaire de l'équipe
+		item := c.GetItem()
+		if item == nil {
+			return fmt.Errorf("aucun objet spécifié")
+		}
+		if c.GetCombat().ObtenirQuantiteObjet(actor.TeamID(), item.GetID()) <= 0 {
+			return fmt.Errorf("objet %s non trouvé dans l'inventaire", item.GetName())
+		}
+
+	default:
+		// Une commande personnalisée physique est bloquée par l'épuisement comme une attaque
+		if definition, ok := v.registry.Lookup(cmd.GetType()); ok && definition.Physical && actor.EstEpuise() {
+			return fmt.Errorf("l'unité %s est épuisée", actor.Nom())
+		}
+	}
 
-	case commands.CommandTypeItem:
-		// Vérifier l'inventaire (fait dans ItemCommand)
+	// Vérifier la Stamina des commandes physiques (déplacement, attaque, compétence)
+	if consumer, ok := cmd.(commands.StaminaConsumer); ok {
+		cout := consumer.GetStaminaCost()
+		if actor.StatsActuelles().Stamina < cout {
+			return fmt.Errorf("Stamina insuffisante pour %s (coût: %d, disponible: %d)", actor.Nom(), cout, actor.StatsActuelles().Stamina)
+		}
 	}
 
 	// Log de validation
@@ -80,12 +124,66 @@ func NewRangeValidator() *RangeValidator {
 	return &RangeValidator{}
 }
 
+// Clone implémente Validator
+func (v *RangeValidator) Clone() Validator {
+	return &RangeValidator{}
+}
+
 // Validate vérifie que les cibles sont à portée
+// Distance de Manhattan depuis l'acteur; le chemin exact d'un déplacement est calculé par MoveCommand
 func (v *RangeValidator) Validate(cmd commands.Command) error {
 	actor := cmd.GetActor()
 
-	// La validation de portée est déjà faite dans chaque commande
-	// Cette validation globale permet de centraliser la logique
+	switch c := cmd.(type) {
+	case *commands.AttackCommand:
+		for _, target := range c.GetTargets() {
+			if target == nil {
+				continue // Cible absente: signalée par le TargetValidator
+			}
+			if err := verifierPortee(actor, target.Position(), domain.PorteeAttaqueMelee); err != nil {
+				return err
+			}
+		}
+
+	case *commands.SkillCommand:
+		if skill := c.GetSkill(); skill != nil {
+			for _, target := range c.GetTargets() {
+				if target == nil {
+					continue
+				}
+				if err := verifierPortee(actor, target.Position(), skill.Portee()); err != nil {
+					return fmt.Errorf("%s: %w", target.Nom(), err)
+				}
+			}
+		}
+
+	case *commands.ItemCommand:
+		if item := c.GetItem(); item != nil && c.GetTargetPosition() != nil {
+			if err := verifierPortee(actor, c.GetTargetPosition(), item.GetRange()); err != nil {
+				return err
+			}
+		}
+
+	case *commands.MoveCommand:
+		if c.GetTargetPosition() != nil {
+			if err := verifierPortee(actor, c.GetTargetPosition(), actor.PorteeDeplacement()); err != nil {
+				return fmt.Errorf("déplacement impossible: %w", err)
+			}
+		}
+
+	case commands.RangedCommand:
+		// Commande personnalisée à distance: chaque cible doit être à portée
+		if targeting, ok := cmd.(commands.TargetingCommand); ok {
+			for _, target := range targeting.GetTargets() {
+				if target == nil {
+					continue
+				}
+				if err := verifierPortee(actor, target.Position(), c.GetRange()); err != nil {
+					return err
+				}
+			}
+		}
+	}
 
 	fmt.Printf("[Validator] RangeValidator: OK pour %s\n", actor.Nom())
 
@@ -93,9 +191,22 @@ func (v *RangeValidator) Validate(cmd commands.Command) error {
 	return v.CallNext(cmd)
 }
 
+// verifierPortee vérifie qu'une case est à portée de l'acteur
+func verifierPortee(actor *domain.Unite, position *shared.Position, portee int) error {
+	if position == nil {
+		return fmt.Errorf("aucune cible spécifiée")
+	}
+	distance := actor.Position().Distance(position)
+	if distance > portee {
+		return fmt.Errorf("cible hors de portée (distance: %d, portée max: %d)", distance, portee)
+	}
+	return nil
+}
+
 // TargetValidator vérifie la validité des cibles
 type TargetValidator struct {
 	BaseValidator
+	registry *commands.CommandRegistry // Types personnalisés (nil: commandes standard uniquement)
 }
 
 // NewTargetValidator crée un nouveau validateur de cible
@@ -103,18 +214,51 @@ func NewTargetValidator() *TargetValidator {
 	return &TargetValidator{}
 }
 
+// Clone implémente Validator
+func (v *TargetValidator) Clone() Validator {
+	return &TargetValidator{registry: v.registry}
+}
+
 // Validate vérifie que les cibles sont valides
 func (v *TargetValidator) Validate(cmd commands.Command) error {
 	actor := cmd.GetActor()
 
-	switch cmd.GetType() {
-	case commands.CommandTypeAttack, commands.CommandTypeSkill:
-		// Vérifier que les cibles ne sont pas déjà mortes
-		// (déjà fait dans chaque commande)
+	switch c := cmd.(type) {
+	case *commands.AttackCommand, *commands.SkillCommand:
+		if err := validerCibles(actor, c.(commands.TargetingCommand), cmd.GetType() == commands.CommandTypeAttack); err != nil {
+			return err
+		}
 
-	case commands.CommandTypeMove:
-		// Vérifier que la position cible est libre
-		// (déjà fait dans MoveCommand)
+	case *commands.MoveCommand:
+		// La case de destination doit être sur la grille et libre
+		destination := c.GetTargetPosition()
+		if destination == nil {
+			return fmt.Errorf("position cible non spécifiée")
+		}
+		if !c.GetCombat().Grille().EstDansLimites(destination) {
+			return fmt.Errorf("position cible hors limites")
+		}
+		for _, occupant := range c.GetCombat().ObtenirUnitesDansZone(destination, 0) {
+			if occupant.ID() != actor.ID() {
+				return fmt.Errorf("la case (%d,%d) est occupée par %s", destination.X(), destination.Y(), occupant.Nom())
+			}
+		}
+
+	case *commands.ItemCommand:
+		if c.GetTargetPosition() == nil {
+			return fmt.Errorf("aucune cible spécifiée")
+		}
+		if !c.GetCombat().Grille().EstDansLimites(c.GetTargetPosition()) {
+			return fmt.Errorf("point d'impact hors limites")
+		}
+
+	case commands.TargetingCommand:
+		// Commande personnalisée visant des unités: mêmes règles qu'une attaque si elle est hostile
+		if definition, ok := v.registry.Lookup(cmd.GetType()); ok {
+			if err := validerCibles(actor, c, definition.Hostile); err != nil {
+				return err
+			}
+		}
 	}
 
 	fmt.Printf("[Validator] TargetValidator: OK pour %s\n", actor.Nom())
@@ -123,9 +267,43 @@ func (v *TargetValidator) Validate(cmd commands.Command) error {
 	return v.CallNext(cmd)
 }
 
+// validerCibles vérifie les unités visées: présentes, debout, et autorisées par une provocation
+// Une commande hostile vise un ennemi (camp effectif en cas de Charme), jamais l'acteur lui-même;
+// une unité confuse peut frapper n'importe qui
+func validerCibles(actor *domain.Unite, targeting commands.TargetingCommand, hostile bool) error {
+	targets := targeting.GetTargets()
+	if len(targets) == 0 {
+		return fmt.Errorf("aucune cible spécifiée")
+	}
+
+	// Les cibles doivent être sur la grille et ne pas être déjà éliminées
+	for _, target := range targets {
+		if target == nil {
+			return fmt.Errorf("aucune cible spécifiée")
+		}
+		if target.EstEliminee() {
+			return fmt.Errorf("la cible %s est déjà éliminée", target.Nom())
+		}
+	}
+
+	if hostile {
+		target := targets[0]
+		if target.ID() == actor.ID() {
+			return fmt.Errorf("une unité ne peut pas s'attaquer elle-même")
+		}
+		if !actor.EstConfuse() && !targeting.GetCombat().SontEnnemis(actor, target) {
+			return fmt.Errorf("impossible d'attaquer un allié")
+		}
+	}
+
+	// Une unité provoquée ne peut viser aucun autre ennemi que son provocateur
+	return targeting.GetCombat().VerifierProvocation(actor, targets)
+}
+
 // StatusValidator vérifie les statuts bloquants
 type StatusValidator struct {
 	BaseValidator
+	registry *commands.CommandRegistry // Types personnalisés (nil: commandes standard uniquement)
 }
 
 // NewStatusValidator crée un nouveau validateur de statut
@@ -133,10 +311,20 @@ func NewStatusValidator() *StatusValidator {
 	return &StatusValidator{}
 }
 
+// Clone implémente Validator
+func (v *StatusValidator) Clone() Validator {
+	return &StatusValidator{registry: v.registry}
+}
+
 // Validate vérifie les statuts qui empêchent l'action
 func (v *StatusValidator) Validate(cmd commands.Command) error {
 	actor := cmd.GetActor()
 
+	// Attendre reste toujours possible
+	if cmd.GetType() == commands.CommandTypeWait {
+		return v.CallNext(cmd)
+	}
+
 	// Vérifier les statuts bloquants selon le type de commande
 	switch cmd.GetType() {
 	case commands.CommandTypeSkill:
@@ -153,6 +341,17 @@ func (v *StatusValidator) Validate(cmd commands.Command) error {
 		if actor.EstStun() {
 			return fmt.Errorf("impossible d'attaquer: unité Stunned")
 		}
+
+	default:
+		// Une commande personnalisée est bloquée comme une attaque (physique) ou une compétence (magique)
+		if definition, ok := v.registry.Lookup(cmd.GetType()); ok {
+			if definition.Magical && actor.EstSilence() {
+				return fmt.Errorf("impossible d'utiliser %s: unité Silencée", definition.Type)
+			}
+			if definition.Physical && actor.EstStun() {
+				return fmt.Errorf("impossible d'utiliser %s: unité Stunned", definition.Type)
+			}
+		}
 	}
 
 	// Vérifier que l'unité peut agir en général
@@ -166,31 +365,129 @@ func (v *StatusValidator) Validate(cmd commands.Command) error {
 	return v.CallNext(cmd)
 }
 
+// CommandTypeValidator applique la règle propre à chaque type de commande personnalisé
+// (CommandDefinition.Validate), découverte dans le registre des commandes
+type CommandTypeValidator struct {
+	BaseValidator
+	registry *commands.CommandRegistry
+}
+
+// NewCommandTypeValidator crée un validateur sur le registre donné
+func NewCommandTypeValidator(registry *commands.CommandRegistry) *CommandTypeValidator {
+	return &CommandTypeValidator{
+		registry: registry,
+	}
+}
+
+// Clone implémente Validator
+func (v *CommandTypeValidator) Clone() Validator {
+	return &CommandTypeValidator{registry: v.registry}
+}
+
+// Validate applique la règle du type de la commande s'il est enregistré
+func (v *CommandTypeValidator) Validate(cmd commands.Command) error {
+	if definition, ok := v.registry.Lookup(cmd.GetType()); ok && definition.Validate != nil {
+		if err := definition.Validate(cmd); err != nil {
+			return err
+		}
+		fmt.Printf("[Validator] CommandTypeValidator: OK pour %s\n", cmd.GetActor().Nom())
+	}
+
+	// Appeler le prochain validateur
+	return v.CallNext(cmd)
+}
+
+// RuleValidator adapte une règle personnalisée (règles de tournoi, etc.) en maillon de la chaîne
+type RuleValidator struct {
+	BaseValidator
+	name string
+	rule func(cmd commands.Command) error
+}
+
+// NewRuleValidator crée un validateur à partir d'une règle
+func NewRuleValidator(name string, rule func(cmd commands.Command) error) *RuleValidator {
+	return &RuleValidator{
+		name: name,
+		rule: rule,
+	}
+}
+
+// Clone implémente Validator
+// La règle est partagée: elle ne doit pas dépendre d'un état propre au combat
+func (v *RuleValidator) Clone() Validator {
+	return &RuleValidator{name: v.name, rule: v.rule}
+}
+
+// Validate applique la règle puis le validateur suivant
+func (v *RuleValidator) Validate(cmd commands.Command) error {
+	if err := v.rule(cmd); err != nil {
+		return fmt.Errorf("règle %s: %w", v.name, err)
+	}
+
+	fmt.Printf("[Validator] %s: OK pour %s\n", v.name, cmd.GetActor().Nom())
+
+	// Appeler le prochain validateur
+	return v.CallNext(cmd)
+}
+
 // ValidationChain gère la chaîne de validateurs
+// Les validateurs personnalisés (règles de tournoi, etc.) sont ajoutés en fin de chaîne
 type ValidationChain struct {
-	head Validator
+	head       Validator
+	tail       Validator
+	validators []Validator // Validateurs dans l'ordre de la chaîne (copiés par Cloner)
 }
 
 // NewValidationChain crée une nouvelle chaîne de validation
-func NewValidationChain() *ValidationChain {
-	// Construire la chaîne: Status → Cost → Range → Target
-	statusValidator := NewStatusValidator()
-	costValidator := NewCostValidator()
+// Les commandes personnalisées sont reconnues dans le registre donné (celui de la CommandFactory du combat)
+func NewValidationChain(registry *commands.CommandRegistry) *ValidationChain {
+	// Construire la chaîne: Status → Cost → Range → Target → règles des commandes personnalisées
+	statusValidator := &StatusValidator{registry: registry}
+	costValidator := &CostValidator{registry: registry}
 	rangeValidator := NewRangeValidator()
-	targetValidator := NewTargetValidator()
+	targetValidator := &TargetValidator{registry: registry}
+	commandTypeValidator := NewCommandTypeValidator(registry)
 
 	// Chaîner les validateurs
 	statusValidator.SetNext(costValidator)
 	costValidator.SetNext(rangeValidator)
 	rangeValidator.SetNext(targetValidator)
+	targetValidator.SetNext(commandTypeValidator)
 
 	return &ValidationChain{
-		head: statusValidator,
+		head:       statusValidator,
+		tail:       commandTypeValidator,
+		validators: []Validator{statusValidator, costValidator, rangeValidator, targetValidator, commandTypeValidator},
+	}
+}
+
+// Add ajoute un validateur en fin de chaîne (après les règles des commandes personnalisées)
+func (vc *ValidationChain) Add(validator Validator) {
+	if validator == nil {
+		return
 	}
+	vc.tail = vc.tail.SetNext(validator)
+	vc.validators = append(vc.validators, validator)
 }
 
-// Validate lance la validation complète
-func (vc *ValidationChain) Validate(cmd commands.Command) error {
+// Clone copie la chaîne et chacun de ses validateurs (Validator.Clone), re-chaînés dans le même ordre
+func (vc *ValidationChain) Clone() *ValidationChain {
+	clone := &ValidationChain{validators: make([]Validator, 0, len(vc.validators))}
+	for _, validator := range vc.validators {
+		copie := validator.Clone()
+		if clone.head == nil {
+			clone.head = copie
+		} else {
+			clone.tail.SetNext(copie)
+		}
+		clone.tail = copie
+		clone.validators = append(clone.validators, copie)
+	}
+	return clone
+}
+
+// ValidateCommand lance la validation complète
+func (vc *ValidationChain) ValidateCommand(cmd commands.Command) error {
 	fmt.Printf("[ValidationChain] Début de la validation pour: %s\n", cmd.GetType())
 
 	// Lancer la chaîne de validation
@@ -202,3 +499,26 @@ func (vc *ValidationChain) Validate(cmd commands.Command) error {
 	fmt.Printf("[ValidationChain] Validation réussie\n")
 	return nil
 }
+
+// Implémentation de ValidationProvider interface
+
+// Validate implémente ValidationProvider
+func (vc *ValidationChain) Validate(action interface{}) error {
+	cmd, ok := action.(commands.Command)
+	if !ok {
+		return fmt.Errorf("type de commande invalide")
+	}
+	return vc.ValidateCommand(cmd)
+}
+
+// AddValidator implémente ValidationProvider
+func (vc *ValidationChain) AddValidator(validator interface{}) {
+	if v, ok := validator.(Validator); ok {
+		vc.Add(v)
+	}
+}
+
+// Cloner implémente ValidationProvider
+func (vc *ValidationChain) Cloner() domain.ValidationProvider {
+	return vc.Clone()
+}
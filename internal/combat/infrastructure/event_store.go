@@ -72,9 +72,10 @@ func (s *PostgresEventStore) AppendEvents(aggregateID string, events []domain.Ev
 				version,
 				event_type,
 				payload,
-				created_at
-			) VALUES ($1, $2, $3, $4, $5)
-		`, aggregateID, evt.AggregateVersion(), evt.EventType(), payload, evt.Timestamp())
+				created_at,
+				correlation_id
+			) VALUES ($1, $2, $3, $4, $5, $6)
+		`, aggregateID, evt.AggregateVersion(), evt.EventType(), payload, evt.Timestamp(), evt.CorrelationID())
 
 		if err != nil {
 			return fmt.Errorf("erreur insertion événement: %w", err)
@@ -100,7 +101,7 @@ func (s *PostgresEventStore) LoadEventsFromVersion(aggregateID string, fromVersi
 
 	// Charger les événements depuis la version spécifiée
 	rows, err := s.pool.Query(ctx, `
-		SELECT event_type, payload, version, created_at
+		SELECT event_type, payload, version, created_at, COALESCE(correlation_id, '')
 		FROM events
 		WHERE aggregate_id = $1 AND version > $2
 		ORDER BY version ASC
@@ -118,8 +119,9 @@ func (s *PostgresEventStore) LoadEventsFromVersion(aggregateID string, fromVersi
 		var payload []byte
 		var version int
 		var createdAt time.Time
+		var correlationID string
 
-		if err := rows.Scan(&eventType, &payload, &version, &createdAt); err != nil {
+		if err := rows.Scan(&eventType, &payload, &version, &createdAt, &correlationID); err != nil {
 			return nil, fmt.Errorf("erreur scan événement: %w", err)
 		}
 
@@ -132,6 +134,7 @@ func (s *PostgresEventStore) LoadEventsFromVersion(aggregateID string, fromVersi
 		evt.SetAggregateID(aggregateID)
 		evt.SetAggregateVersion(version)
 		evt.SetTimestamp(createdAt)
+		evt.SetCorrelationID(correlationID)
 
 		events = append(events, evt)
 	}
@@ -143,6 +146,43 @@ func (s *PostgresEventStore) LoadEventsFromVersion(aggregateID string, fromVersi
 	return events, nil
 }
 
+// CombatsAvecEcheance liste les combats dont le dernier événement de délai est une échéance fixée
+// (ou une reprise, qui refixe le délai gelé par la pause)
+// Implémente application.IndexEcheances (reprogrammation des minuteurs au redémarrage)
+func (s *PostgresEventStore) CombatsAvecEcheance() ([]string, error) {
+	ctx := context.Background()
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT aggregate_id FROM (
+			SELECT DISTINCT ON (aggregate_id) aggregate_id, event_type
+			FROM events
+			WHERE event_type IN ('EcheanceTourFixee', 'EcheanceTourLevee', 'TourExpire', 'CombatTermine',
+				'CombatMisEnPause', 'CombatRepris', 'CombatAnnule')
+			ORDER BY aggregate_id, version DESC
+		) dernier
+		WHERE event_type IN ('EcheanceTourFixee', 'CombatRepris')
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("erreur chargement échéances: %w", err)
+	}
+	defer rows.Close()
+
+	combatIDs := make([]string, 0)
+	for rows.Next() {
+		var combatID string
+		if err := rows.Scan(&combatID); err != nil {
+			return nil, fmt.Errorf("erreur scan échéance: %w", err)
+		}
+		combatIDs = append(combatIDs, combatID)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("erreur itération échéances: %w", rows.Err())
+	}
+
+	return combatIDs, nil
+}
+
 // SaveSnapshot sauvegarde un snapshot
 func (s *PostgresEventStore) SaveSnapshot(aggregateID string, version int, data []byte) error {
 	ctx := context.Background()
@@ -199,14 +239,88 @@ func deserializeEvent(eventType string, payload []byte) (domain.Evenement, error
 		evt = &domain.SoinApliqueEvent{}
 	case "StatutApplique":
 		evt = &domain.StatutAppliqueEvent{}
-	case "UniteEliminee":
-		evt = &domain.UniteElimineeEvent{}
+	case "UniteKO", "UniteEliminee": // UniteEliminee: ancien nom, avant la séparation KO/mort
+		evt = &domain.UniteKOEvent{}
+	case "CompteAReboursKO":
+		evt = &domain.CompteAReboursKOEvent{}
+	case "UniteMorte":
+		evt = &domain.UniteMorteEvent{}
+	case "ButinDepose":
+		evt = &domain.ButinDeposeEvent{}
+	case "ButinRamasse":
+		evt = &domain.ButinRamasseEvent{}
 	case "UniteDeplacee":
 		evt = &domain.UniteDeplaceeEvent{}
+	case "DeplacementExecute":
+		evt = &domain.DeplacementExecuteEvent{}
 	case "CompetenceUtilisee":
 		evt = &domain.CompetenceUtiliseeEvent{}
 	case "CombatTermine":
 		evt = &domain.CombatTermineEvent{}
+	case "RecompensesDistribuees":
+		evt = &domain.RecompensesDistribueesEvent{}
+	case "ObjetConsomme":
+		evt = &domain.ObjetConsommeEvent{}
+	case "ObjetRestitue":
+		evt = &domain.ObjetRestitueEvent{}
+	case "ObjetUtilise":
+		evt = &domain.ObjetUtiliseEvent{}
+	case "MPRestaure":
+		evt = &domain.MPRestaureEvent{}
+	case "StatutRetire":
+		evt = &domain.StatutRetireEvent{}
+	case "UniteRessuscitee":
+		evt = &domain.UniteRessusciteeEvent{}
+	case "ActionImposee":
+		evt = &domain.ActionImposeeEvent{}
+	case "SortRenvoye":
+		evt = &domain.SortRenvoyeEvent{}
+	case "SortAnnule":
+		evt = &domain.SortAnnuleEvent{}
+	case "IncantationDemarree":
+		evt = &domain.IncantationDemarreeEvent{}
+	case "IncantationInterrompue":
+		evt = &domain.IncantationInterrompueEvent{}
+	case "JaugesATBEnregistrees":
+		evt = &domain.JaugesATBEnregistreesEvent{}
+	case "CurseurRoundEnregistre":
+		evt = &domain.CurseurRoundEnregistreEvent{}
+	case "MachineEtatsPositionnee":
+		evt = &domain.MachineEtatsPositionneeEvent{}
+	case "PointDeRestaurationPose":
+		evt = &domain.PointDeRestaurationPoseEvent{}
+	case "ActionAnnulee":
+		evt = &domain.ActionAnnuleeEvent{}
+	case "ActionRetablie":
+		evt = &domain.ActionRetablieEvent{}
+	case "EcheanceTourFixee":
+		evt = &domain.EcheanceTourFixeeEvent{}
+	case "EcheanceTourLevee":
+		evt = &domain.EcheanceTourLeveeEvent{}
+	case "TourExpire":
+		evt = &domain.TourExpireEvent{}
+	case "ActiviteTourEnregistree":
+		evt = &domain.ActiviteTourEnregistreeEvent{}
+	case "ActiviteTourConclue":
+		evt = &domain.ActiviteTourConclueEvent{}
+	case "CombatMisEnPause":
+		evt = &domain.CombatMisEnPauseEvent{}
+	case "CombatRepris":
+		evt = &domain.CombatReprisEvent{}
+	case "CombatAnnule":
+		evt = &domain.CombatAnnuleEvent{}
+	case "StaminaConsommee":
+		evt = &domain.StaminaConsommeeEvent{}
+	case "MenaceGeneree":
+		evt = &domain.MenaceGenereeEvent{}
+	case "TourUniteEntame":
+		evt = &domain.TourUniteEntameEvent{}
+	case "DureeStopDecomptee":
+		evt = &domain.DureeStopDecompteeEvent{}
+	case "UniteProvoquee":
+		evt = &domain.UniteProvoqueeEvent{}
+	case "UniteCharmee":
+		evt = &domain.UniteCharmeeEvent{}
 	default:
 		return nil, errors.New("type d'événement inconnu: " + eventType)
 	}
@@ -231,6 +345,7 @@ func InitSchema(pool *pgxpool.Pool) error {
 			event_type VARCHAR(100) NOT NULL,
 			payload JSONB NOT NULL,
 			created_at TIMESTAMP NOT NULL,
+			correlation_id VARCHAR(255),
 			UNIQUE (aggregate_id, version)
 		)
 	`)
@@ -238,6 +353,23 @@ func InitSchema(pool *pgxpool.Pool) error {
 		return fmt.Errorf("erreur création table events: %w", err)
 	}
 
+	// Identifiant de corrélation des actions (tables créées avant son introduction)
+	_, err = pool.Exec(ctx, `
+		ALTER TABLE events ADD COLUMN IF NOT EXISTS correlation_id VARCHAR(255)
+	`)
+	if err != nil {
+		return fmt.Errorf("erreur ajout colonne correlation_id: %w", err)
+	}
+
+	// Index sur correlation_id pour retrouver tous les événements d'une action
+	_, err = pool.Exec(ctx, `
+		CREATE INDEX IF NOT EXISTS idx_events_correlation_id
+		ON events (correlation_id)
+	`)
+	if err != nil {
+		return fmt.Errorf("erreur création index correlation_id: %w", err)
+	}
+
 	// Index sur aggregate_id
 	_, err = pool.Exec(ctx, `
 		CREATE INDEX IF NOT EXISTS idx_events_aggregate_id
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// Sérialisation JSON des Value Objects à champs privés
+// Utilisée par les événements du combat (Event Store, Kafka): sans elle, une position,
+// un statut ou une grille seraient enregistrés vides et perdus au rechargement
+
+// positionJSON est la forme JSON d'une Position
+type positionJSON struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// MarshalJSON implémente json.Marshaler
+func (p Position) MarshalJSON() ([]byte, error) {
+	return json.Marshal(positionJSON{X: p.x, Y: p.y})
+}
+
+// UnmarshalJSON implémente json.Unmarshaler
+func (p *Position) UnmarshalJSON(data []byte) error {
+	var v positionJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.X < 0 || v.Y < 0 {
+		return errors.New("les coordonnées doivent être positives")
+	}
+	p.x, p.y = v.X, v.Y
+	return nil
+}
+
+// statutJSON est la forme JSON d'un Statut
+type statutJSON struct {
+	Type              TypeStatut         `json:"type"`
+	Duree             int                `json:"duree"`
+	Puissance         int                `json:"puissance"`
+	Modificateurs     []ModificateurStat `json:"modificateurs,omitempty"`
+	BloqueActions     bool               `json:"bloqueActions,omitempty"`
+	BloqueDeplacement bool               `json:"bloqueDeplacement,omitempty"`
+}
+
+// MarshalJSON implémente json.Marshaler
+func (s Statut) MarshalJSON() ([]byte, error) {
+	return json.Marshal(statutJSON{
+		Type:              s.typeStatut,
+		Duree:             s.duree,
+		Puissance:         s.puissance,
+		Modificateurs:     s.modificateurs,
+		BloqueActions:     s.bloqueActions,
+		BloqueDeplacement: s.bloqueDeplacement,
+	})
+}
+
+// UnmarshalJSON implémente json.Unmarshaler
+func (s *Statut) UnmarshalJSON(data []byte) error {
+	var v statutJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	modificateurs := v.Modificateurs
+	if modificateurs == nil {
+		modificateurs = make([]ModificateurStat, 0)
+	}
+	*s = Statut{
+		typeStatut:        v.Type,
+		duree:             v.Duree,
+		puissance:         v.Puissance,
+		modificateurs:     modificateurs,
+		bloqueActions:     v.BloqueActions,
+		bloqueDeplacement: v.BloqueDeplacement,
+	}
+	return nil
+}
+
+// grilleJSON est la forme JSON d'une GrilleCombat (cellules ligne par ligne)
+type grilleJSON struct {
+	Largeur  int             `json:"largeur"`
+	Hauteur  int             `json:"hauteur"`
+	Cellules [][]TypeCellule `json:"cellules"`
+}
+
+// MarshalJSON implémente json.Marshaler
+func (g GrilleCombat) MarshalJSON() ([]byte, error) {
+	return json.Marshal(grilleJSON{Largeur: g.largeur, Hauteur: g.hauteur, Cellules: g.cellules})
+}
+
+// UnmarshalJSON implémente json.Unmarshaler
+func (g *GrilleCombat) UnmarshalJSON(data []byte) error {
+	var v grilleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	grille, err := NewGrilleCombat(v.Largeur, v.Hauteur)
+	if err != nil {
+		return err
+	}
+	if v.Cellules != nil {
+		if len(v.Cellules) != v.Hauteur {
+			return errors.New("nombre de lignes de cellules incohérent avec la hauteur")
+		}
+		for y, ligne := range v.Cellules {
+			if len(ligne) != v.Largeur {
+				return errors.New("nombre de cellules incohérent avec la largeur")
+			}
+			copy(grille.cellules[y], ligne)
+		}
+	}
+	*g = *grille
+	return nil
+}
@@ -16,14 +16,16 @@ type EffetStatut struct {
 type ObjetID string
 
 // Item représente un objet utilisable (Potion, Éther, etc.)
-// TODO: Implémenter complètement le système d'items
+// Définition statique référencée par le catalogue d'objets du combat
 type Item struct {
-	ID          string
-	Name        string
-	Description string
-	ItemType    string // "Potion", "Ether", "Antidote", "Revive", "Bomb"
-	EffectVal   int    // Renommé pour éviter conflit avec méthode
-	Range       int
+	ID           string
+	Name         string
+	Description  string
+	ItemType     string // "Potion", "Ether", "Antidote", "Revive", "Bomb"
+	EffectVal    int    // Renommé pour éviter conflit avec méthode
+	Range        int    // Portée de lancer (distance max entre l'utilisateur et le point d'impact)
+	AreaRadius   int    // Rayon de la zone d'effet autour du point d'impact (0 = cible unique)
+	FriendlyFire bool   // Les objets offensifs touchent aussi les alliés dans la zone
 }
 
 // Constantes pour les types d'items
@@ -35,12 +37,20 @@ const (
 	ItemTypeBomb     = "Bomb"
 )
 
-// Méthodes temporaires pour Item
-func (i *Item) GetID() string       { return i.ID }
-func (i *Item) GetName() string     { return i.Name }
-func (i *Item) GetItemType() string { return i.ItemType }
-func (i *Item) EffectValue() int    { return i.EffectVal }
-func (i *Item) GetRange() int       { return i.Range }
+// Accesseurs de Item
+func (i *Item) GetID() string         { return i.ID }
+func (i *Item) GetName() string       { return i.Name }
+func (i *Item) GetItemType() string   { return i.ItemType }
+func (i *Item) EffectValue() int      { return i.EffectVal }
+func (i *Item) GetRange() int         { return i.Range }
+func (i *Item) GetAreaRadius() int    { return i.AreaRadius }
+func (i *Item) HasFriendlyFire() bool { return i.FriendlyFire }
+
+// IsAreaOfEffect indique si l'objet touche une zone plutôt qu'une cible unique
+func (i *Item) IsAreaOfEffect() bool { return i.AreaRadius > 0 }
+
+// IsOffensive indique si l'objet vise les ennemis
+func (i *Item) IsOffensive() bool { return i.ItemType == ItemTypeBomb }
 
 // Competence représente une compétence (temporaire, utiliser domain.Competence à la place)
 // TODO: Supprimer ce type et utiliser domain.Competence partout
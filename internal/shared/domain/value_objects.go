@@ -242,8 +242,8 @@ func (g *GrilleCombat) PositionsAdjacentes(pos *Position) []*Position {
 	}
 
 	for _, dir := range directions {
-		nouvellePos, _ := NewPosition(pos.X()+dir.dx, pos.Y()+dir.dy)
-		if g.EstTraversable(nouvellePos) {
+		nouvellePos, err := NewPosition(pos.X()+dir.dx, pos.Y()+dir.dy)
+		if err == nil && g.EstTraversable(nouvellePos) {
 			adjacentes = append(adjacentes, nouvellePos)
 		}
 	}
@@ -299,17 +299,38 @@ const (
 	StatutDebuff
 	StatutRegeneration
 	StatutBouclier
-	StatutMort    // Ajouté pour Step C
-	StatutSilence // Ajouté pour Step C
+	StatutMort        // Ajouté pour Step C
+	StatutSilence     // Ajouté pour Step C
+	StatutEpuisement  // Stamina tombée à 0
+	StatutProvocation // Force le ciblage de l'unité provocatrice
+	StatutConfusion   // Action et cible tirées au hasard
+	StatutCharme      // Combat temporairement pour l'équipe adverse
+	StatutBerserk     // Attaque automatiquement l'ennemi le plus proche (ATK augmentée)
+	StatutReflet      // Renvoie les sorts à cible unique
+	StatutContreSort  // Annule le prochain sort hostile
+	StatutHate        // Accélère le remplissage de la jauge ATB
+	StatutLenteur     // Ralentit le remplissage de la jauge ATB
+	StatutStop        // Gèle la jauge ATB (aucun tour jusqu'à expiration)
 )
 
 // Alias pour compatibilité avec code Step C
 const (
-	TypeStatutMort    = StatutMort
-	TypeStatutSilence = StatutSilence
-	TypeStatutStun    = StatutStun
-	TypeStatutRoot    = StatutRoot
-	TypeStatutPoison  = StatutPoison
+	TypeStatutMort        = StatutMort
+	TypeStatutSilence     = StatutSilence
+	TypeStatutStun        = StatutStun
+	TypeStatutRoot        = StatutRoot
+	TypeStatutPoison      = StatutPoison
+	TypeStatutEpuisement  = StatutEpuisement
+	TypeStatutProvocation = StatutProvocation
+	TypeStatutSommeil     = StatutSommeil
+	TypeStatutConfusion   = StatutConfusion
+	TypeStatutCharme      = StatutCharme
+	TypeStatutBerserk     = StatutBerserk
+	TypeStatutReflet      = StatutReflet
+	TypeStatutContreSort  = StatutContreSort
+	TypeStatutHate        = StatutHate
+	TypeStatutLenteur     = StatutLenteur
+	TypeStatutStop        = StatutStop
 )
 
 // NewStatut crée un nouveau statut
@@ -333,11 +354,19 @@ func NewStatut(typeStatut TypeStatut, duree, puissance int) *Statut {
 	case StatutSommeil:
 		s.bloqueActions = true
 		s.bloqueDeplacement = true
+	case StatutStop:
+		s.bloqueActions = true
+		s.bloqueDeplacement = true
 	case StatutParalysie:
 		s.modificateurs = append(s.modificateurs, ModificateurStat{
 			Stat:   "SPD",
 			Valeur: -puissance,
 		})
+	case StatutBerserk:
+		s.modificateurs = append(s.modificateurs, ModificateurStat{
+			Stat:   "ATK",
+			Valeur: puissance,
+		})
 	}
 
 	return s
@@ -44,6 +44,7 @@ func (p *KafkaEventPublisher) Publish(event domain.Evenement) error {
 		Headers: []kafka.Header{
 			{Key: "event_type", Value: []byte(event.EventType())},
 			{Key: "aggregate_id", Value: []byte(event.AggregateID())},
+			{Key: "correlation_id", Value: []byte(event.CorrelationID())},
 		},
 	}
 